@@ -0,0 +1,148 @@
+package gofinance
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// AmendmentKind tells whether an [Amendment] changes, adds, or removes a flow.
+type AmendmentKind int
+
+const (
+	AmendmentChange AmendmentKind = iota
+	AmendmentAdd
+	AmendmentRemove
+)
+
+// Amendment is one entry of the amendment log of [AmendedCashFlows].
+// Entry identifies the flow: entries 0 to len(Original)-1 are the original
+// flows, added flows get the following numbers in the order they are added.
+// Original is the flow before the amendment, Amended after it, the zero
+// CashFlow standing in for a flow that does not exist.
+// Effective is when the amendment became known, which is usually later
+// than the dates of the flows it restates.
+type Amendment struct {
+	Kind      AmendmentKind
+	Entry     int
+	Original  CashFlow
+	Amended   CashFlow
+	Effective time.Time
+	Reason    string
+}
+
+// AmendedCashFlows keeps a set of reported cash-flows together with a log of
+// later restatements, so that analytics can be run both on the flows
+// "as originally reported" and "as restated", or as known at any date.
+//
+//	book := NewAmendedCashFlows(reported)
+//	book.Change(2, CashFlow{-1050, date}, time.Now(), "fee omitted")
+//	original, _ := book.AsOriginallyReported().IRR()
+//	restated, _ := book.AsRestated().IRR()
+type AmendedCashFlows struct {
+	original   CashFlows
+	added      int
+	amendments []Amendment
+}
+
+// NewAmendedCashFlows starts an amendment log over a copy of the reported flows.
+func NewAmendedCashFlows(original CashFlows) *AmendedCashFlows {
+	return &AmendedCashFlows{original: slices.Clone(original)}
+}
+
+// current returns the flows with all amendments applied, by entry,
+// and whether each entry exists.
+func (a *AmendedCashFlows) current() ([]CashFlow, []bool) {
+	return a.apply(func(Amendment) bool { return true })
+}
+
+// apply replays the amendments accepted by keep in effective-date order,
+// ties in log order.
+func (a *AmendedCashFlows) apply(keep func(Amendment) bool) ([]CashFlow, []bool) {
+	n := len(a.original) + a.added
+	flows := make([]CashFlow, n)
+	exists := make([]bool, n)
+	copy(flows, a.original)
+	for i := range a.original {
+		exists[i] = true
+	}
+	log := slices.Clone(a.amendments)
+	slices.SortStableFunc(log, func(x, y Amendment) int { return x.Effective.Compare(y.Effective) })
+	for _, am := range log {
+		if !keep(am) {
+			continue
+		}
+		switch am.Kind {
+		case AmendmentAdd:
+			flows[am.Entry], exists[am.Entry] = am.Amended, true
+		case AmendmentChange:
+			if exists[am.Entry] {
+				flows[am.Entry] = am.Amended
+			}
+		case AmendmentRemove:
+			exists[am.Entry] = false
+		}
+	}
+	return flows, exists
+}
+
+// Change restates an existing entry, keeping its previous value in the log.
+func (a *AmendedCashFlows) Change(entry int, amended CashFlow, effective time.Time, reason string) error {
+	flows, exists := a.current()
+	if entry < 0 || entry >= len(flows) || !exists[entry] {
+		return fmt.Errorf("AmendedCashFlows.Change: %w", ErrNoSuchEntry)
+	}
+	a.amendments = append(a.amendments, Amendment{AmendmentChange, entry, flows[entry], amended, effective, reason})
+	return nil
+}
+
+// Add records a flow missing from the original report, typically backdated,
+// and returns its entry number.
+func (a *AmendedCashFlows) Add(cf CashFlow, effective time.Time, reason string) int {
+	entry := len(a.original) + a.added
+	a.added++
+	a.amendments = append(a.amendments, Amendment{AmendmentAdd, entry, CashFlow{}, cf, effective, reason})
+	return entry
+}
+
+// Remove records that an existing entry should not have been reported.
+func (a *AmendedCashFlows) Remove(entry int, effective time.Time, reason string) error {
+	flows, exists := a.current()
+	if entry < 0 || entry >= len(flows) || !exists[entry] {
+		return fmt.Errorf("AmendedCashFlows.Remove: %w", ErrNoSuchEntry)
+	}
+	a.amendments = append(a.amendments, Amendment{AmendmentRemove, entry, flows[entry], CashFlow{}, effective, reason})
+	return nil
+}
+
+// Amendments returns the amendment log in the order it was recorded.
+func (a *AmendedCashFlows) Amendments() []Amendment {
+	return slices.Clone(a.amendments)
+}
+
+// AsOriginallyReported returns a copy of the flows before any amendment.
+func (a *AmendedCashFlows) AsOriginallyReported() CashFlows {
+	return slices.Clone(a.original)
+}
+
+// AsRestated returns the flows with every amendment applied, in entry order.
+func (a *AmendedCashFlows) AsRestated() CashFlows {
+	return existingFlows(a.current())
+}
+
+// AsOf returns the flows as they were known at asOf: with the amendments
+// effective on or before asOf applied, later ones ignored.
+func (a *AmendedCashFlows) AsOf(asOf time.Time) CashFlows {
+	return existingFlows(a.apply(func(am Amendment) bool { return !am.Effective.After(asOf) }))
+}
+
+// existingFlows keeps the entries that exist.
+func existingFlows(flows []CashFlow, exists []bool) CashFlows {
+	var cfs CashFlows
+	for i, cf := range flows {
+		if exists[i] {
+			cfs = append(cfs, cf)
+		}
+	}
+	return cfs
+}
@@ -0,0 +1,97 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// AmendedCashFlows
+// -----------------------------------------------------------------------------
+func TestAmendedCashFlows(t *testing.T) {
+	reported := CashFlows{
+		{-100, anchor},
+		{60, anchor.AddDate(1, 0, 0)},
+		{60, anchor.AddDate(2, 0, 0)},
+	}
+	book := NewAmendedCashFlows(reported)
+	reported[0].Value = -999 // the log keeps its own copy
+
+	q1, q2, q3 := anchor.AddDate(3, 0, 0), anchor.AddDate(3, 3, 0), anchor.AddDate(3, 6, 0)
+	if err := book.Change(0, CashFlow{-105, anchor}, q1, "fee omitted"); err != nil {
+		t.Fatalf("Change: %v", err)
+	}
+	extra := book.Add(CashFlow{10, anchor.AddDate(1, 6, 0)}, q2, "late distribution")
+	if extra != 3 {
+		t.Errorf("Add entry got %d, want 3", extra)
+	}
+	if err := book.Remove(2, q3, "reversed"); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+
+	sum := func(cfs CashFlows) float64 {
+		s := 0.0
+		for _, cf := range cfs {
+			s += cf.Value
+		}
+		return s
+	}
+	tests := []struct {
+		name string
+		got  CashFlows
+		want float64
+		n    int
+	}{
+		{"original", book.AsOriginallyReported(), 20, 3},
+		{"restated", book.AsRestated(), -35, 3},
+		{"before any amendment", book.AsOf(anchor), 20, 3},
+		{"after the change", book.AsOf(q1), 15, 3},
+		{"after the addition", book.AsOf(q2), 25, 4},
+		{"after the removal", book.AsOf(q3), -35, 3},
+	}
+	for _, tc := range tests {
+		if s := sum(tc.got); s != tc.want || len(tc.got) != tc.n {
+			t.Errorf("%s: got %d flows summing to %f, want %d summing to %f", tc.name, len(tc.got), s, tc.n, tc.want)
+		}
+	}
+
+	log := book.Amendments()
+	if len(log) != 3 || log[0].Original.Value != -100 || log[0].Amended.Value != -105 ||
+		log[1].Kind != AmendmentAdd || log[2].Kind != AmendmentRemove || log[2].Original.Value != 60 {
+		t.Errorf("Amendments log incorrect: %+v", log)
+	}
+
+	// IRR both ways
+	if _, err := book.AsOriginallyReported().IRR(); err != nil {
+		t.Errorf("IRR as reported: %v", err)
+	}
+	if _, err := book.AsRestated().IRR(); err != nil {
+		t.Errorf("IRR as restated: %v", err)
+	}
+}
+
+func TestAmendedCashFlowsOutOfOrder(t *testing.T) {
+	book := NewAmendedCashFlows(CashFlows{{1, anchor}})
+	late, early := anchor.AddDate(0, 2, 0), anchor.AddDate(0, 1, 0)
+	book.Change(0, CashFlow{3, anchor}, late, "second")
+	book.Change(0, CashFlow{2, anchor}, early, "first, logged late")
+
+	// replayed by effective date: the later-effective change wins
+	if got := book.AsRestated()[0].Value; got != 3 {
+		t.Errorf("restated value got %f, want 3", got)
+	}
+	if got := book.AsOf(early)[0].Value; got != 2 {
+		t.Errorf("as of early got %f, want 2", got)
+	}
+}
+
+func TestAmendedCashFlowsErrors(t *testing.T) {
+	book := NewAmendedCashFlows(CashFlows{{1, anchor}})
+	if err := book.Change(5, CashFlow{}, anchor, ""); err == nil {
+		t.Error("expected Change error for unknown entry")
+	}
+	if err := book.Remove(-1, anchor, ""); err == nil {
+		t.Error("expected Remove error for unknown entry")
+	}
+	book.Remove(0, anchor, "")
+	if err := book.Change(0, CashFlow{}, anchor, ""); err == nil {
+		t.Error("expected Change error for removed entry")
+	}
+}
@@ -0,0 +1,99 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// solveDecreasing finds the rate at which excess, a decreasing function of
+// the rate per period, is zero, bracketing it between -90% and a growing
+// upper bound.
+func solveDecreasing(excess func(float64) float64, opts SolverOptions) (float64, error) {
+	lo, hi := -0.9, 1.0
+	for excess(hi) > 0 && hi < 1e6 {
+		hi *= 2
+	}
+	return brent(excess, lo, hi, opts)
+}
+
+// DisclosureAPR returns the annual percentage rate to disclose for a
+// closed-end loan under US Regulation Z, by the actuarial method of its
+// Appendix J. The amount financed is the principal net of fees, the
+// prepaid finance charges. payments are the scheduled payments, one per
+// unit period of which there are periodsPerYear a year, the first falling
+// firstPeriod unit periods after the loan is made: 1 for a regular
+// schedule, e.g. 1.5 for a first payment 45 days into a monthly loan.
+// The fractional part of the odd first period earns simple interest.
+// Math details:
+//
+// Principal - Fees = \sum_k Payment_k / ((1 + f * i) * (1 + i)^{t_k}),   t_k + f = FirstPeriod + k - 1, f in [0, 1)
+//
+// APR = PeriodsPerYear * i
+func DisclosureAPR(principal, fees float64, payments []float64, periodsPerYear, firstPeriod float64, opts ...SolverOptions) (RateAnnualPercentage, error) {
+	financed := principal - fees
+	switch {
+	case len(payments) == 0:
+		return RateAnnualPercentage{}, fmt.Errorf("DisclosureAPR: %w", ErrEmptyCashFlows)
+	case financed <= 0:
+		return RateAnnualPercentage{}, errors.New("DisclosureAPR requires fees below the principal")
+	case !validPeriodsPerYear(periodsPerYear) || firstPeriod < 0:
+		return RateAnnualPercentage{}, errors.New("DisclosureAPR requires positive periods per year and a non-negative first period")
+	}
+	whole, f := math.Modf(firstPeriod)
+	excess := func(i float64) float64 {
+		pv := 0.0
+		for k, p := range payments {
+			pv += p * math.Pow(1+i, -(whole+float64(k)))
+		}
+		return pv/(1+f*i) - financed
+	}
+	i, err := solveDecreasing(excess, solverOptions(opts))
+	if err != nil {
+		return RateAnnualPercentage{}, fmt.Errorf("DisclosureAPR: %w", err)
+	}
+	return RateAnnualPercentage{periodsPerYear * i, periodsPerYear}, nil
+}
+
+// APRWithinTolerance reports whether a disclosed APR is accurate under
+// Regulation Z: within 1/8 of a percentage point of the actual APR for a
+// regular transaction and within 1/4 for an irregular one, with uneven
+// payments or periods.
+func APRWithinTolerance(disclosed, actual float64, regular bool) bool {
+	tolerance := 0.0025
+	if regular {
+		tolerance = 0.00125
+	}
+	return math.Abs(disclosed-actual) <= tolerance+1e-15
+}
+
+// APRC returns the annual percentage rate of charge of a consumer credit
+// under the EU Consumer Credit Directive, Annex I: the annual effective
+// rate that equates the drawdowns of credit with the repayments and
+// charges paid by the consumer. Time is counted in years from the first
+// drawdown, a year having 365 days, 366 in leap years.
+// The directive requires disclosure to at least one decimal place.
+// Math details:
+//
+// \sum_k Drawdown_k * (1 + X)^{-t_k} = \sum_l Repayment_l * (1 + X)^{-s_l}
+func APRC(drawdowns, repayments CashFlows, opts ...SolverOptions) (RateEffective, error) {
+	if len(drawdowns) == 0 || len(repayments) == 0 {
+		return RateEffective{}, fmt.Errorf("APRC: %w", ErrEmptyCashFlows)
+	}
+	start := drawdowns.SortedCopy()[0].Date
+	excess := func(x float64) float64 {
+		v := 0.0
+		for _, r := range repayments {
+			v += r.Value * math.Pow(1+x, -yearsBetween(start, r.Date))
+		}
+		for _, d := range drawdowns {
+			v -= d.Value * math.Pow(1+x, -yearsBetween(start, d.Date))
+		}
+		return v
+	}
+	x, err := solveDecreasing(excess, solverOptions(opts))
+	if err != nil {
+		return RateEffective{}, fmt.Errorf("APRC: %w", err)
+	}
+	return RateEffective{x, 1}, nil
+}
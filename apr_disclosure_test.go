@@ -0,0 +1,102 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Regulation Z APR
+// -----------------------------------------------------------------------------
+func TestDisclosureAPR(t *testing.T) {
+	level := func(financed, i float64, n int) []float64 {
+		p := financed * i / (1 - math.Pow(1+i, -float64(n)))
+		payments := make([]float64, n)
+		for k := range payments {
+			payments[k] = p
+		}
+		return payments
+	}
+	// 5,000 over 36 months at 1% a month is a 12% APR
+	payments := level(5000, 0.01, 36)
+	// a 45-day first period, t = 1 whole month plus f = 1/2 at simple
+	// interest, defers every payment by half a month
+	odd := 5000 / 1.005
+	tests := []struct {
+		name      string
+		principal float64
+		fees      float64
+		payments  []float64
+		ppy       float64
+		first     float64
+		want      float64
+	}{
+		{"regular monthly", 5000, 0, payments, 12, 1, 0.12},
+		{"prepaid fees", 5150, 150, payments, 12, 1, 0.12},
+		{"weekly", 2000, 0, level(2000, 0.003, 52), 52, 1, 0.003 * 52},
+		{"odd first period", odd, 0, payments, 12, 1.5, 0.12},
+	}
+	for _, tt := range tests {
+		got, err := DisclosureAPR(tt.principal, tt.fees, tt.payments, tt.ppy, tt.first)
+		if err != nil || !almostEq(got.Value, tt.want, 1e-10) || got.PeriodsPerYear != tt.ppy {
+			t.Errorf("%s: got %+v, %v, want %f", tt.name, got, err, tt.want)
+		}
+	}
+
+	// fees raise the APR above the note rate
+	withFees, _ := DisclosureAPR(5000, 150, payments, 12, 1)
+	if withFees.Value <= 0.12 {
+		t.Errorf("APR with fees got %f, want above 12%%", withFees.Value)
+	}
+	if _, err := DisclosureAPR(5000, 0, nil, 12, 1); !errors.Is(err, ErrEmptyCashFlows) {
+		t.Errorf("no payments got %v, want ErrEmptyCashFlows", err)
+	}
+	if _, err := DisclosureAPR(100, 100, payments, 12, 1); err == nil {
+		t.Error("DisclosureAPR accepted fees equal to the principal")
+	}
+}
+
+func TestAPRWithinTolerance(t *testing.T) {
+	tests := []struct {
+		disclosed, actual float64
+		regular           bool
+		want              bool
+	}{
+		{0.1200, 0.12125, true, true},
+		{0.1200, 0.1213, true, false},
+		{0.1200, 0.1225, false, true},
+		{0.1230, 0.1200, false, false},
+	}
+	for _, tt := range tests {
+		if got := APRWithinTolerance(tt.disclosed, tt.actual, tt.regular); got != tt.want {
+			t.Errorf("APRWithinTolerance(%f, %f, %v) got %v, want %v", tt.disclosed, tt.actual, tt.regular, got, tt.want)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// EU APRC
+// -----------------------------------------------------------------------------
+func TestAPRC(t *testing.T) {
+	drawdowns := CashFlows{{1000, anchor}}
+	got, err := APRC(drawdowns, CashFlows{{1100, anchor.AddDate(1, 0, 0)}})
+	if err != nil || !almostEq(got.Value, 0.10, epsilon) || got.PeriodsPerYear != 1 {
+		t.Errorf("one repayment got %+v, %v, want 10%%", got, err)
+	}
+
+	// two drawdowns repaid in instalments, built at 7% a year
+	x := 0.07
+	drawdowns = CashFlows{{600, anchor}, {400, anchor.AddDate(0, 6, 0)}}
+	repayments := CashFlows{{300, anchor.AddDate(1, 0, 0)}, {300, anchor.AddDate(2, 0, 0)}}
+	pv := 600 + 400*math.Pow(1+x, -yearsBetween(anchor, anchor.AddDate(0, 6, 0))) - 300/(1+x) - 300/((1+x)*(1+x))
+	repayments = append(repayments, CashFlow{pv * (1 + x) * (1 + x) * (1 + x), anchor.AddDate(3, 0, 0)})
+	got, err = APRC(drawdowns, repayments)
+	if err != nil || !almostEq(got.Value, x, 1e-10) {
+		t.Errorf("instalments got %+v, %v, want %f", got, err, x)
+	}
+
+	if _, err := APRC(nil, repayments); !errors.Is(err, ErrEmptyCashFlows) {
+		t.Errorf("no drawdowns got %v, want ErrEmptyCashFlows", err)
+	}
+}
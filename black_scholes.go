@@ -0,0 +1,71 @@
+package gofinance
+
+import "math"
+
+// blackScholesD returns d1 and d2 of the Black-Scholes formula
+// for the forward price forward.
+func blackScholesD(forward, strike, volatility, years float64) (float64, float64) {
+	s := volatility * math.Sqrt(years)
+	d1 := (math.Log(forward/strike) + s*s/2) / s
+	return d1, d1 - s
+}
+
+// BlackScholesCall prices a European call on an asset paying the continuous
+// yield, e.g. a dividend yield, with annual volatility, expiring in years.
+// Math details:
+//
+// Call = DiscountFactor(Years) * (F * N(d1) - Strike * N(d2)),   F = ForwardPrice(Spot, R, Yield, Years)
+//
+// d1 = (ln(F / Strike) + Volatility^2 * Years / 2) / (Volatility * \sqrt{Years}),   d2 = d1 - Volatility * \sqrt{Years}
+func BlackScholesCall(spot, strike, volatility float64, r, yield Rate, years float64) float64 {
+	f := ForwardPrice(spot, r, yield, years)
+	df := r.DiscountFactor(years)
+	if years <= 0 || volatility <= 0 {
+		return df * math.Max(f-strike, 0)
+	}
+	d1, d2 := blackScholesD(f, strike, volatility, years)
+	return df * (f*normCDF(d1) - strike*normCDF(d2))
+}
+
+// BlackScholesPut prices a European put like [BlackScholesCall].
+// Math details:
+//
+// Put = DiscountFactor(Years) * (Strike * N(-d2) - F * N(-d1))
+func BlackScholesPut(spot, strike, volatility float64, r, yield Rate, years float64) float64 {
+	f := ForwardPrice(spot, r, yield, years)
+	df := r.DiscountFactor(years)
+	if years <= 0 || volatility <= 0 {
+		return df * math.Max(strike-f, 0)
+	}
+	d1, d2 := blackScholesD(f, strike, volatility, years)
+	return df * (strike*normCDF(-d2) - f*normCDF(-d1))
+}
+
+// BlackScholesDelta returns the sensitivity of a European option's price
+// to the spot price of its underlying, see [BlackScholesCall],
+// for a call when call is true and for a put otherwise.
+// Math details:
+//
+// DeltaCall = DiscountFactor_yield(Years) * N(d1)
+//
+// DeltaPut = -DiscountFactor_yield(Years) * N(-d1)
+func BlackScholesDelta(spot, strike, volatility float64, r, yield Rate, years float64, call bool) float64 {
+	f := ForwardPrice(spot, r, yield, years)
+	dfq := yield.DiscountFactor(years)
+	var itm float64
+	if years <= 0 || volatility <= 0 {
+		if call && f > strike || !call && f < strike {
+			itm = 1
+		}
+	} else {
+		d1, _ := blackScholesD(f, strike, volatility, years)
+		itm = normCDF(d1)
+		if !call {
+			itm = normCDF(-d1)
+		}
+	}
+	if call {
+		return dfq * itm
+	}
+	return -dfq * itm
+}
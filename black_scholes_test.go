@@ -0,0 +1,57 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Black-Scholes
+// -----------------------------------------------------------------------------
+func TestBlackScholes(t *testing.T) {
+	// Hull, Options, Futures, and Other Derivatives, Example 15.6:
+	// S = 42, K = 40, r = 10%, sigma = 20%, T = 0.5
+	r := RateAnnualContinuous{0.10}
+	none := RateAnnualContinuous{0}
+	if got := BlackScholesCall(42, 40, 0.2, r, none, 0.5); !almostEq(got, 4.76, 5e-3) {
+		t.Errorf("BlackScholesCall got %f, want 4.76", got)
+	}
+	if got := BlackScholesPut(42, 40, 0.2, r, none, 0.5); !almostEq(got, 0.81, 5e-3) {
+		t.Errorf("BlackScholesPut got %f, want 0.81", got)
+	}
+
+	// put-call parity with a dividend yield
+	q := RateAnnualContinuous{0.03}
+	call := BlackScholesCall(100, 95, 0.3, r, q, 2)
+	put := BlackScholesPut(100, 95, 0.3, r, q, 2)
+	if want := 100*math.Exp(-0.06) - 95*math.Exp(-0.2); !almostEq(call-put, want, 1e-10) {
+		t.Errorf("put-call parity: C - P got %f, want %f", call-put, want)
+	}
+
+	// expiry: intrinsic value
+	if got := BlackScholesCall(42, 40, 0.2, r, none, 0); got != 2 {
+		t.Errorf("BlackScholesCall at expiry got %f, want 2", got)
+	}
+}
+
+func TestBlackScholesDelta(t *testing.T) {
+	r, q := RateAnnualContinuous{0.03}, RateAnnualContinuous{0.02}
+	const h = 1e-4
+	for _, call := range []bool{true, false} {
+		price := BlackScholesPut
+		if call {
+			price = BlackScholesCall
+		}
+		want := (price(100+h, 95, 0.25, r, q, 0.5) - price(100-h, 95, 0.25, r, q, 0.5)) / (2 * h)
+		if got := BlackScholesDelta(100, 95, 0.25, r, q, 0.5, call); !almostEq(got, want, 1e-7) {
+			t.Errorf("call %v: delta got %f, want %f", call, got, want)
+		}
+	}
+	// expired options are all or nothing
+	if got := BlackScholesDelta(100, 95, 0.25, r, q, 0, true); got != 1 {
+		t.Errorf("expired call delta got %f, want 1", got)
+	}
+	if got := BlackScholesDelta(100, 95, 0.25, r, q, 0, false); got != 0 {
+		t.Errorf("expired put delta got %f, want 0", got)
+	}
+}
@@ -0,0 +1,258 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Bond represents a fixed-rate, floating-rate, zero-coupon, or amortizing bond.
+//
+// Coupons are paid PeriodsPerYear times a year on the dates of [ScheduleStub]
+// from Issue to Maturity, the odd period placed by Stub, short first by
+// default, and accrue on the face outstanding at the start of each period
+// as CouponRate times the year fraction of the period, so that a short or
+// long stub pays its coupon prorated.
+// CouponRate is a simple annual rate, for example 0.05 for a 5% coupon.
+// Year fractions are on DayCount, actual/actual by default. A non-nil
+// Calendar moves every payment on a holiday or weekend to the next
+// business day; coupons still accrue between the scheduled dates.
+//
+// Structures:
+//
+//   - Bullet: CouponRate > 0, the whole Face is repaid at Maturity.
+//   - Zero-coupon: CouponRate == 0 and no IndexCurve, only Face is paid at Maturity.
+//     PeriodsPerYear still sets the compounding of the yield.
+//   - Floating-rate note: IndexCurve != nil, each period's coupon rate is the
+//     simple forward rate of IndexCurve for the period on DayCount plus
+//     QuotedMargin, CouponRate is ignored.
+//   - Amortizing / sinking-fund: Sinking lists the principal repaid before
+//     Maturity, the remaining face is repaid at Maturity.
+//   - Callable: Calls lists the dates and prices at which the issuer may
+//     redeem the bond early, see [Bond.YieldToWorst] and [Bond.OAS].
+type Bond struct {
+	Face           float64
+	CouponRate     float64
+	PeriodsPerYear int
+	DayCount       DayCount
+	Issue          time.Time
+	Maturity       time.Time
+	Stub           StubType
+	Calendar       *BusinessCalendar
+	Sinking        CashFlows
+	IndexCurve     YieldCurve
+	QuotedMargin   float64
+	Calls          []BondCall
+}
+
+// validate checks the bond terms that [Schedule] does not.
+func (b Bond) validate() error {
+	if b.Face <= 0 {
+		return errors.New("Bond: Face must be positive")
+	}
+	if b.PeriodsPerYear <= 0 || 12%b.PeriodsPerYear != 0 {
+		return errors.New("Bond: PeriodsPerYear must divide 12")
+	}
+	sunk := 0.0
+	for _, s := range b.Sinking {
+		if !s.Date.After(b.Issue) || !s.Date.Before(b.Maturity) {
+			return errors.New("Bond: sinking payments must fall between Issue and Maturity")
+		}
+		sunk += s.Value
+	}
+	if sunk > b.Face {
+		return errors.New("Bond: sinking payments exceed Face")
+	}
+	for _, c := range b.Calls {
+		if !c.Date.After(b.Issue) || c.Date.After(b.Maturity) {
+			return errors.New("Bond: call dates must fall between Issue and Maturity")
+		}
+	}
+	return nil
+}
+
+// outstanding returns the face not yet repaid just after date.
+func (b Bond) outstanding(date time.Time) float64 {
+	face := b.Face
+	for _, s := range b.Sinking {
+		if !s.Date.After(date) {
+			face -= s.Value
+		}
+	}
+	return face
+}
+
+// CashFlows returns the coupons and principal repayments of the bond paid
+// after settlement, in date order.
+// The coupons of a floating-rate note are projected off IndexCurve as seen
+// from settlement, for a period already in progress from settlement onward.
+func (b Bond) CashFlows(settlement time.Time) (CashFlows, error) {
+	return b.cashFlowsTo(settlement, b.Maturity, 1)
+}
+
+// cashFlowsTo returns the cash-flows after settlement of the bond redeemed
+// early on redemption at price times the outstanding face.
+// A coupon period cut short by the redemption pays the coupon accrued so far.
+func (b Bond) cashFlowsTo(settlement, redemption time.Time, price float64) (CashFlows, error) {
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	dates, err := ScheduleStub(b.Issue, b.Maturity, b.PeriodsPerYear, b.Stub)
+	if err != nil {
+		return nil, err
+	}
+
+	var flows CashFlows
+	prev := b.Issue
+	for _, d := range dates {
+		if !prev.Before(redemption) {
+			break
+		}
+		if d.After(redemption) {
+			d = redemption
+		}
+		if d.After(settlement) {
+			rate := b.CouponRate
+			if b.IndexCurve != nil {
+				rate = periodForward(b.IndexCurve, settlement, prev, d, b.DayCount) + b.QuotedMargin
+			}
+			if coupon := b.outstanding(prev) * rate * b.DayCount.YearFraction(prev, d); coupon != 0 {
+				flows = append(flows, CashFlow{coupon, d})
+			}
+		}
+		prev = d
+	}
+	for _, s := range b.Sinking {
+		if s.Date.After(settlement) && !s.Date.After(redemption) {
+			flows = append(flows, s)
+		}
+	}
+	if redemption.After(settlement) {
+		flows = append(flows, CashFlow{price * b.outstanding(redemption), redemption})
+	}
+	if b.Calendar != nil {
+		for i := range flows {
+			flows[i].Date = b.Calendar.AddBusinessDays(flows[i].Date, 0)
+		}
+	}
+	flows.Sort()
+	return flows, nil
+}
+
+// AccruedInterest returns the coupon accrued at settlement since the start
+// of the period in progress, the last coupon date or Issue in the first
+// period, on the face outstanding then. The dirty price less it is the
+// clean price.
+// Math details:
+//
+// AccruedInterest = Outstanding * CouponRate * YearFraction(PeriodStart, Settlement)
+func (b Bond) AccruedInterest(settlement time.Time) (float64, error) {
+	if err := b.validate(); err != nil {
+		return 0, err
+	}
+	if b.IndexCurve != nil {
+		return 0, errors.New("Bond.AccruedInterest requires a fixed-rate bond")
+	}
+	dates, err := ScheduleStub(b.Issue, b.Maturity, b.PeriodsPerYear, b.Stub)
+	if err != nil {
+		return 0, err
+	}
+	if settlement.Before(b.Issue) || !settlement.Before(b.Maturity) {
+		return 0, nil
+	}
+	prev := b.Issue
+	for _, d := range dates {
+		if d.After(settlement) {
+			break
+		}
+		prev = d
+	}
+	return b.outstanding(prev) * b.CouponRate * b.DayCount.YearFraction(prev, settlement), nil
+}
+
+// yieldRate returns the yield as an annual percentage rate compounded
+// PeriodsPerYear times a year, the usual quote for bonds.
+func (b Bond) yieldRate(yield float64) Rate {
+	return RateAnnualPercentage{Value: yield, PeriodsPerYear: float64(b.PeriodsPerYear)}
+}
+
+// PriceFromYield returns the dirty price of the bond at settlement, in the
+// currency of Face, given a yield compounded PeriodsPerYear times a year.
+// Math details:
+//
+// Price = \sum_i CashFlow_i * (1 + Yield / Periods)^{-Periods * Years_i}
+func (b Bond) PriceFromYield(yield float64, settlement time.Time) (float64, error) {
+	flows, err := b.CashFlows(settlement)
+	if err != nil {
+		return 0, err
+	}
+	return flows.NPV(b.yieldRate(yield), settlement), nil
+}
+
+// MacaulayDuration returns the present-value-weighted average time,
+// in years, to the cash-flows of the bond.
+// Math details:
+//
+// MacaulayDuration = \sum_i Years_i * PresentValue_i / Price
+func (b Bond) MacaulayDuration(yield float64, settlement time.Time) (float64, error) {
+	flows, err := b.CashFlows(settlement)
+	if err != nil {
+		return 0, err
+	}
+	r := b.yieldRate(yield)
+	price, weighted := 0.0, 0.0
+	for _, cf := range flows {
+		pv := cf.PresentValue(r, settlement)
+		price += pv
+		weighted += cf.YearsFrom(settlement) * pv
+	}
+	if price == 0 {
+		return math.NaN(), fmt.Errorf("Bond: %w after settlement", ErrEmptyCashFlows)
+	}
+	return weighted / price, nil
+}
+
+// ModifiedDuration returns the percentage change in price for a unit change
+// in yield, the Macaulay duration adjusted for periodic compounding.
+// Math details:
+//
+// ModifiedDuration = MacaulayDuration / (1 + Yield / Periods) = -(dPrice / dYield) / Price
+func (b Bond) ModifiedDuration(yield float64, settlement time.Time) (float64, error) {
+	mac, err := b.MacaulayDuration(yield, settlement)
+	if err != nil {
+		return math.NaN(), err
+	}
+	return mac / (1 + yield/float64(b.PeriodsPerYear)), nil
+}
+
+// yieldFromFlows solves for the yield, compounded periodsPerYear times a year,
+// at which the flows are worth price at settlement.
+// Price falls as yield rises, so a root is bracketed between a yield just
+// above −100% per period and an upper bound doubled until price is undershot.
+func yieldFromFlows(flows CashFlows, price float64, settlement time.Time, periodsPerYear int, opts SolverOptions) (float64, error) {
+	m := float64(periodsPerYear)
+	f := func(y float64) float64 {
+		return flows.NPV(RateAnnualPercentage{Value: y, PeriodsPerYear: m}, settlement) - price
+	}
+	lo, hi := -0.99*m, max(opts.InitialGuess, 0.01)
+	for f(hi) > 0 && hi < 1000 {
+		hi *= 2
+	}
+	if f(lo)*f(hi) > 0 {
+		return math.NaN(), fmt.Errorf("Bond yield: %w", ErrNoRootBracketed)
+	}
+	return brent(f, lo, hi, opts)
+}
+
+// YieldFromPrice returns the yield to maturity, compounded PeriodsPerYear
+// times a year, at which the bond is worth the given dirty price at settlement.
+// It is the inverse of [Bond.PriceFromYield].
+// The search can be tuned with an optional [SolverOptions].
+func (b Bond) YieldFromPrice(price float64, settlement time.Time, opts ...SolverOptions) (float64, error) {
+	flows, err := b.CashFlows(settlement)
+	if err != nil {
+		return math.NaN(), err
+	}
+	return yieldFromFlows(flows, price, settlement, b.PeriodsPerYear, solverOptions(opts))
+}
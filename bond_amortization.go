@@ -0,0 +1,71 @@
+package gofinance
+
+import (
+	"math"
+	"time"
+)
+
+// BondAmortizationPeriod is one row of a [Bond.Amortization] schedule,
+// ending at Date: the Coupon and Principal received, the Interest income
+// at the effective rate, the Amortization of the premium or discount,
+// Interest less Coupon, negative for a premium, and the closing Carrying
+// value.
+type BondAmortizationPeriod struct {
+	Date         time.Time
+	Coupon       float64
+	Principal    float64
+	Interest     float64
+	Amortization float64
+	Carrying     float64
+}
+
+// Amortization returns the effective interest method schedule of a bond
+// bought at the dirty price at settlement, as ASC 835-30 and IFRS 9
+// require: interest is the carrying value times the yield to maturity,
+// so a premium or discount to face is amortized over the life of the
+// bond and the carrying value ends at zero once all principal is repaid.
+// One row is returned per payment date after settlement, sinking fund
+// repayments included; the same schedule from the issuer's side gives
+// the interest expense.
+// Math details:
+//
+// Interest_i = Carrying_{i-1} * ((1 + Yield / Periods)^{Periods * (t_i - t_{i-1})} - 1),   Carrying_0 = Price
+//
+// Carrying_i = Carrying_{i-1} + Interest_i - Coupon_i - Principal_i
+func (b Bond) Amortization(price float64, settlement time.Time, opts ...SolverOptions) ([]BondAmortizationPeriod, error) {
+	flows, err := b.CashFlows(settlement)
+	if err != nil {
+		return nil, err
+	}
+	yield, err := yieldFromFlows(flows, price, settlement, b.PeriodsPerYear, solverOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	m := float64(b.PeriodsPerYear)
+
+	var rows []BondAmortizationPeriod
+	carrying, prev := price, 0.0
+	for i := 0; i < len(flows); {
+		date := flows[i].Date
+		cash := 0.0
+		for ; i < len(flows) && flows[i].Date.Equal(date); i++ {
+			cash += flows[i].Value
+		}
+		principal := 0.0
+		for _, s := range b.Sinking {
+			if s.Date.Equal(date) {
+				principal += s.Value
+			}
+		}
+		if date.Equal(b.Maturity) {
+			principal += b.outstanding(date)
+		}
+		t := yearsBetween(settlement, date)
+		interest := carrying * math.Expm1(m*(t-prev)*math.Log1p(yield/m))
+		coupon := cash - principal
+		carrying += interest - cash
+		rows = append(rows, BondAmortizationPeriod{date, coupon, principal, interest, interest - coupon, carrying})
+		prev = t
+	}
+	return rows, nil
+}
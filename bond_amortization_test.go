@@ -0,0 +1,83 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Effective interest amortization
+// -----------------------------------------------------------------------------
+func TestBondAmortization(t *testing.T) {
+	b := Bond{Face: 100, CouponRate: 0.05, PeriodsPerYear: 2, Issue: anchor, Maturity: anchor.AddDate(5, 0, 0)}
+	tests := []struct {
+		name  string
+		yield float64
+	}{
+		{"discount", 0.06},
+		{"premium", 0.04},
+		{"par", 0.05},
+	}
+	for _, tt := range tests {
+		price, _ := b.PriceFromYield(tt.yield, anchor)
+		rows, err := b.Amortization(price, anchor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != 10 {
+			t.Fatalf("%s: got %d rows, want 10", tt.name, len(rows))
+		}
+		// the first period earns the yield on the price
+		t1 := yearsBetween(anchor, rows[0].Date)
+		if want := price * (math.Pow(1+tt.yield/2, 2*t1) - 1); !almostEq(rows[0].Interest, want, 1e-9) {
+			t.Errorf("%s: first interest got %f, want %f", tt.name, rows[0].Interest, want)
+		}
+		interest, amortization := 0.0, 0.0
+		for i, r := range rows {
+			interest += r.Interest
+			amortization += r.Amortization
+			if i < 9 && r.Principal != 0 || !almostEq(r.Amortization, r.Interest-r.Coupon, epsilon) {
+				t.Errorf("%s: row %d got %+v", tt.name, i, r)
+			}
+			// the carrying value moves towards face, up to the day count wobble
+			if i < 9 && math.Abs(r.Carrying-100) > math.Abs(price-100)+1e-3 {
+				t.Errorf("%s: row %d carrying %f moved away from face", tt.name, i, r.Carrying)
+			}
+		}
+		last := rows[9]
+		if last.Principal != 100 || math.Abs(last.Carrying) > 1e-9 {
+			t.Errorf("%s: last row got %+v, want principal 100 and nothing left", tt.name, last)
+		}
+		// the premium or discount is amortized in full
+		if !almostEq(amortization, 100-price, 1e-9) {
+			t.Errorf("%s: total amortization got %f, want %f", tt.name, amortization, 100-price)
+		}
+		if tt.name == "premium" && rows[0].Amortization >= 0 || tt.name == "discount" && rows[0].Amortization <= 0 {
+			t.Errorf("%s: amortization has the wrong sign: %f", tt.name, rows[0].Amortization)
+		}
+	}
+}
+
+func TestBondAmortizationSinking(t *testing.T) {
+	b := Bond{Face: 100, CouponRate: 0.06, PeriodsPerYear: 1, Issue: anchor, Maturity: anchor.AddDate(3, 0, 0),
+		Sinking: CashFlows{{40, anchor.AddDate(1, 0, 0)}, {30, anchor.AddDate(2, 0, 0)}}}
+	rows, err := b.Amortization(97, anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantPrincipal := []float64{40, 30, 30}
+	wantCoupon := []float64{6, 0.06 * 60, 0.06 * 30}
+	for i, r := range rows {
+		if r.Principal != wantPrincipal[i] || !almostEq(r.Coupon, wantCoupon[i], 1e-9) {
+			t.Errorf("row %d got principal %f and coupon %f, want %f and %f", i, r.Principal, r.Coupon, wantPrincipal[i], wantCoupon[i])
+		}
+	}
+	if math.Abs(rows[2].Carrying) > 1e-9 {
+		t.Errorf("carrying value left at maturity: %f", rows[2].Carrying)
+	}
+	// the schedule from mid-life starts at the price paid then
+	rows, _ = b.Amortization(62, anchor.AddDate(1, 3, 0))
+	if len(rows) != 2 || math.Abs(rows[1].Carrying) > 1e-9 {
+		t.Errorf("mid-life schedule got %+v", rows)
+	}
+}
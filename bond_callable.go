@@ -0,0 +1,162 @@
+package gofinance
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// BondCall is one entry of the call schedule of a [Bond]: on Date the issuer
+// may redeem the outstanding face at Price, a fraction of the face,
+// for example 1.02 for a call at 102.
+type BondCall struct {
+	Date  time.Time
+	Price float64
+}
+
+// YieldsToCall returns, for every call in b.Calls, the yield at which the bond
+// is worth the given dirty price at settlement if it is called on that date.
+// Calls on or before settlement get NaN.
+// The search can be tuned with an optional [SolverOptions].
+func (b Bond) YieldsToCall(price float64, settlement time.Time, opts ...SolverOptions) ([]float64, error) {
+	o := solverOptions(opts)
+	yields := make([]float64, len(b.Calls))
+	for i, c := range b.Calls {
+		if !c.Date.After(settlement) {
+			yields[i] = math.NaN()
+			continue
+		}
+		flows, err := b.cashFlowsTo(settlement, c.Date, c.Price)
+		if err != nil {
+			return nil, err
+		}
+		yields[i], err = yieldFromFlows(flows, price, settlement, b.PeriodsPerYear, o)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return yields, nil
+}
+
+// YieldToWorst returns the lowest of the yield to maturity and the yields to
+// every call still ahead of settlement, the yield an investor can count on
+// whatever the issuer decides.
+// The search can be tuned with an optional [SolverOptions].
+func (b Bond) YieldToWorst(price float64, settlement time.Time, opts ...SolverOptions) (float64, error) {
+	worst, err := b.YieldFromPrice(price, settlement, opts...)
+	if err != nil {
+		return math.NaN(), err
+	}
+	toCall, err := b.YieldsToCall(price, settlement, opts...)
+	if err != nil {
+		return math.NaN(), err
+	}
+	for _, y := range toCall {
+		if y < worst { // NaN compares false
+			worst = y
+		}
+	}
+	return worst, nil
+}
+
+// latticePrice values the bond on a recombining binomial lattice of short
+// rates, with the issuer calling whenever that is cheaper than letting the
+// bond live on. Nodes sit at settlement and at every cash-flow and call date.
+//
+// This is a simple lattice, not an arbitrage-free calibrated model:
+// the central rate of each step is the continuous forward rate of the curve,
+// nodes spread lognormally around it with the given annual volatility.
+// Math details:
+//
+// Rate(k, j) = Forward_k * e^{Volatility * (2j - k) * \sqrt{dt_k}} + Spread
+//
+// Value(k, j) = CashFlow_k + min(CallAmount_k, e^{-Rate(k, j) * dt_k} * (Value(k+1, j) + Value(k+1, j+1)) / 2)
+func (b Bond) latticePrice(curve YieldCurve, volatility, spread float64, settlement time.Time) (float64, error) {
+	flows, err := b.CashFlows(settlement)
+	if err != nil {
+		return 0, err
+	}
+
+	// grid of dates: settlement, cash-flow dates, call dates
+	dates := []time.Time{settlement}
+	for _, cf := range flows {
+		dates = append(dates, cf.Date)
+	}
+	for _, c := range b.Calls {
+		if c.Date.After(settlement) {
+			dates = append(dates, c.Date)
+		}
+	}
+	slices.SortFunc(dates, time.Time.Compare)
+	dates = slices.CompactFunc(dates, time.Time.Equal)
+
+	n := len(dates)
+	years := make([]float64, n)
+	paid := make([]float64, n)
+	call := make([]float64, n)
+	for k, d := range dates {
+		years[k] = yearsBetween(settlement, d)
+		call[k] = math.Inf(1)
+		for _, cf := range flows {
+			if cf.Date.Equal(d) {
+				paid[k] += cf.Value
+			}
+		}
+	}
+	for _, c := range b.Calls {
+		if k := slices.IndexFunc(dates, c.Date.Equal); k > 0 && k < n-1 {
+			// coupons of the call date are paid either way,
+			// the remaining principal is replaced by the call price
+			call[k] = c.Price * b.outstanding(c.Date)
+		}
+	}
+
+	values := make([]float64, n) // node j of step k, k+1 nodes per step
+	for j := range values {
+		values[j] = paid[n-1]
+	}
+	for k := n - 2; k >= 0; k-- {
+		dt := years[k+1] - years[k]
+		forward := ForwardRate(curve, years[k], years[k+1], ConventionContinuous)
+		next := make([]float64, k+1)
+		for j := range next {
+			r := forward*math.Exp(volatility*float64(2*j-k)*math.Sqrt(dt)) + spread
+			hold := math.Exp(-r*dt) * (values[j] + values[j+1]) / 2
+			next[j] = paid[k] + math.Min(call[k], hold)
+		}
+		values = next
+	}
+	return values[0], nil
+}
+
+// OAS returns the option-adjusted spread of a callable bond: the constant
+// continuous spread over the short rates of a simple binomial lattice
+// (see the note below) at which the lattice value with the issuer's calls
+// matches the given dirty price at settlement.
+// volatility is the annual lognormal volatility of the short rate.
+//
+// Note this is a stub for quick relative-value work: the lattice is centred
+// on the forward rates of the curve and is not calibrated to be
+// arbitrage-free. With zero volatility and no calls the OAS equals the
+// continuous Z-spread over the curve, see [CashFlows.ZSpread].
+// The search can be tuned with an optional [SolverOptions].
+func (b Bond) OAS(price float64, curve YieldCurve, volatility float64, settlement time.Time, opts ...SolverOptions) (float64, error) {
+	var latticeErr error
+	f := func(spread float64) float64 {
+		p, err := b.latticePrice(curve, volatility, spread, settlement)
+		if err != nil {
+			latticeErr = err
+		}
+		return p - price
+	}
+	lo, hi := -0.5, 0.5
+	flo, fhi := f(lo), f(hi)
+	if latticeErr != nil {
+		return math.NaN(), latticeErr
+	}
+	if flo*fhi > 0 {
+		return math.NaN(), fmt.Errorf("Bond.OAS: %w", ErrNoRootBracketed)
+	}
+	return brent(f, lo, hi, solverOptions(opts))
+}
@@ -0,0 +1,146 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// callable copies the annual bullet and adds calls at par after years 3 and 4
+func callable() Bond {
+	b := bullet
+	b.Calls = []BondCall{
+		{Date: anchor.AddDate(3, 0, 0), Price: 1},
+		{Date: anchor.AddDate(4, 0, 0), Price: 1},
+	}
+	return b
+}
+
+// -----------------------------------------------------------------------------
+// YieldFromPrice
+// -----------------------------------------------------------------------------
+func TestBondYieldFromPrice(t *testing.T) {
+	for _, y := range []float64{-0.01, 0.02, 0.05, 0.12} {
+		price, _ := bullet.PriceFromYield(y, anchor)
+		got, err := bullet.YieldFromPrice(price, anchor)
+		if err != nil {
+			t.Fatalf("yield %v: unexpected error: %v", y, err)
+		}
+		if !almostEq(got, y, 1e-10) {
+			t.Errorf("YieldFromPrice round trip got %.12f, want %.12f", got, y)
+		}
+	}
+
+	if _, err := bullet.YieldFromPrice(-5, anchor); err == nil {
+		t.Error("expected error for a negative price")
+	}
+	if _, err := (Bond{}).YieldFromPrice(100, anchor); err == nil {
+		t.Error("expected error for an invalid bond")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Yield to call & yield to worst
+// -----------------------------------------------------------------------------
+func TestBondYieldToWorst(t *testing.T) {
+	b := callable()
+	price := 1050.0 // premium: calls hurt the investor
+
+	ytcs, err := b.YieldsToCall(price, anchor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// called after 3 years the bond is a 3-year 5% bullet
+	three := bullet
+	three.Maturity = anchor.AddDate(3, 0, 0)
+	want, _ := three.YieldFromPrice(price, anchor)
+	if !almostEq(ytcs[0], want, 1e-10) {
+		t.Errorf("yield to first call got %.10f, want %.10f", ytcs[0], want)
+	}
+
+	ytm, _ := b.YieldFromPrice(price, anchor)
+	ytw, err := b.YieldToWorst(price, anchor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ytw != ytcs[0] || ytw >= ytm {
+		t.Errorf("YieldToWorst got %f, want first call %f below YTM %f", ytw, ytcs[0], ytm)
+	}
+
+	// at a discount, holding to maturity is the worst case
+	if ytw, _ := b.YieldToWorst(950, anchor); !almostEq(ytw, mustYield(t, b, 950), epsilon) {
+		t.Errorf("discount YieldToWorst got %f, want YTM", ytw)
+	}
+
+	// calls already passed are NaN and ignored
+	later := anchor.AddDate(3, 6, 0)
+	ytcs, _ = b.YieldsToCall(1000, later)
+	if !math.IsNaN(ytcs[0]) || math.IsNaN(ytcs[1]) {
+		t.Errorf("YieldsToCall after first call got %v", ytcs)
+	}
+}
+
+func mustYield(t *testing.T, b Bond, price float64) float64 {
+	t.Helper()
+	y, err := b.YieldFromPrice(price, anchor)
+	if err != nil {
+		t.Fatalf("YieldFromPrice: %v", err)
+	}
+	return y
+}
+
+// -----------------------------------------------------------------------------
+// OAS
+// -----------------------------------------------------------------------------
+func TestBondOASWithoutOptionsIsZSpread(t *testing.T) {
+	curve, _ := NewYieldCurveZero([]float64{1, 5}, []float64{0.02, 0.04})
+	flows, _ := bullet.CashFlows(anchor)
+	price := flows.NPVCurve(YieldCurveShifted{curve, 0.015}, anchor)
+
+	oas, err := bullet.OAS(price, curve, 0, anchor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEq(oas, 0.015, 1e-9) {
+		t.Errorf("OAS without options got %.10f, want 0.015", oas)
+	}
+}
+
+func TestBondOASCallable(t *testing.T) {
+	curve := RateAnnualContinuous{Value: 0.04}
+	b := callable()
+
+	straight, _ := bullet.latticePrice(curve, 0.2, 0, anchor)
+	withCalls, _ := b.latticePrice(curve, 0.2, 0, anchor)
+	if withCalls >= straight {
+		t.Errorf("callable lattice price %f should be below straight %f", withCalls, straight)
+	}
+
+	// same market price: the call option eats part of the spread
+	price := 1000.0
+	oasStraight, err := bullet.OAS(price, curve, 0.2, anchor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	oasCallable, err := b.OAS(price, curve, 0.2, anchor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if oasCallable >= oasStraight {
+		t.Errorf("callable OAS %f should be below straight OAS %f", oasCallable, oasStraight)
+	}
+
+	if _, err := b.OAS(1e9, curve, 0.2, anchor); err == nil {
+		t.Error("expected error for an unreachable price")
+	}
+	bad := b
+	bad.Calls = []BondCall{{Date: anchor.AddDate(9, 0, 0), Price: 1}}
+	if _, err := bad.OAS(price, curve, 0.2, anchor); err == nil {
+		t.Error("expected error for a call after maturity")
+	}
+	if _, err := bad.YieldsToCall(price, anchor); err == nil {
+		t.Error("expected YieldsToCall error for a call after maturity")
+	}
+	if _, err := bad.YieldToWorst(price, anchor); err == nil {
+		t.Error("expected YieldToWorst error for a call after maturity")
+	}
+}
@@ -0,0 +1,93 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// parYield returns the par rate of the curve for the remaining life of the
+// bond, the [Swap.ParRate] of a swap from settlement to Maturity paying
+// on the bond's coupon dates.
+func (b Bond) parYield(curve YieldCurve, settlement time.Time) (float64, error) {
+	return b.assetSwap(settlement).ParRate(curve, settlement)
+}
+
+// assetSwap returns the swap on the outstanding face from settlement to
+// Maturity with both legs on the bond's coupon frequency, day count,
+// stub, and calendar.
+func (b Bond) assetSwap(settlement time.Time) Swap {
+	return Swap{
+		Notional:            b.outstanding(settlement),
+		Start:               settlement,
+		End:                 b.Maturity,
+		FixedPeriodsPerYear: b.PeriodsPerYear,
+		FloatPeriodsPerYear: b.PeriodsPerYear,
+		FixedDayCount:       b.DayCount,
+		FloatDayCount:       b.DayCount,
+		Stub:                b.Stub,
+		Calendar:            b.Calendar,
+	}
+}
+
+// GSpread returns the spread of the bond's yield, see [Bond.YieldFromPrice],
+// at the dirty price over the government curve: over its par yield for the
+// bond's remaining life and coupon frequency.
+// Math details:
+//
+// GSpread = YieldToMaturity - ParYield_government(Settlement, Maturity)
+func (b Bond) GSpread(price float64, government YieldCurve, settlement time.Time, opts ...SolverOptions) (float64, error) {
+	return b.spreadToPar(price, government, settlement, opts)
+}
+
+// ISpread returns the spread of the bond's yield at the dirty price over
+// the interpolated swap rate, the par rate of the swap curve for the bond's
+// remaining life and coupon frequency.
+// Math details:
+//
+// ISpread = YieldToMaturity - SwapRate(Settlement, Maturity)
+func (b Bond) ISpread(price float64, swapCurve YieldCurve, settlement time.Time, opts ...SolverOptions) (float64, error) {
+	return b.spreadToPar(price, swapCurve, settlement, opts)
+}
+
+// spreadToPar returns the yield of the bond at price less the par yield
+// of curve.
+func (b Bond) spreadToPar(price float64, curve YieldCurve, settlement time.Time, opts []SolverOptions) (float64, error) {
+	yield, err := b.YieldFromPrice(price, settlement, opts...)
+	if err != nil {
+		return math.NaN(), err
+	}
+	par, err := b.parYield(curve, settlement)
+	if err != nil {
+		return math.NaN(), err
+	}
+	return yield - par, nil
+}
+
+// AssetSwapSpread returns the par-par asset swap spread of the fixed-rate
+// bond at the dirty price: the spread over the floating rate of the swap
+// curve that a buyer paying par for the bond and swapping its coupons
+// receives, the bond's premium to the swap curve paid out over the annuity
+// of the remaining coupon dates.
+// Math details:
+//
+// Annuity = \sum_i Face * YearFraction_i * DiscountFactor_swap(t_i)
+//
+// AssetSwapSpread = (PresentValue_swap(CashFlows) - Price) / Annuity
+func (b Bond) AssetSwapSpread(price float64, swapCurve YieldCurve, settlement time.Time) (float64, error) {
+	if b.IndexCurve != nil || len(b.Sinking) > 0 {
+		return math.NaN(), errors.New("Bond.AssetSwapSpread requires a fixed-rate bullet")
+	}
+	flows, err := b.CashFlows(settlement)
+	if err != nil {
+		return math.NaN(), err
+	}
+	s := b.assetSwap(settlement)
+	s.FixedRate = 1
+	annuityLeg, err := s.FixedLeg()
+	if err != nil {
+		return math.NaN(), err
+	}
+	annuity := annuityLeg.NPVCurve(swapCurve, settlement)
+	return (flows.NPVCurve(swapCurve, settlement) - price) / annuity, nil
+}
@@ -0,0 +1,72 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Bond.GSpread, Bond.ISpread
+// -----------------------------------------------------------------------------
+func TestBondGISpread(t *testing.T) {
+	government, swaps := RateAnnualContinuous{Value: 0.03}, RateAnnualContinuous{Value: 0.04}
+	// annual par rates of flat continuous curves over the five coupon dates
+	par := func(r float64) float64 {
+		annuity := 0.0
+		for k := 1; k <= 5; k++ {
+			annuity += math.Exp(-r * float64(k))
+		}
+		return (1 - math.Exp(-5*r)) / annuity
+	}
+
+	// at par the bullet yields its coupon
+	g, err := bullet.GSpread(1000, government, anchor)
+	if err != nil || !almostEq(g, 0.05-par(0.03), 1e-10) {
+		t.Errorf("GSpread got %v, %v, want %v", g, err, 0.05-par(0.03))
+	}
+	i, err := bullet.ISpread(1000, swaps, anchor)
+	if err != nil || !almostEq(i, 0.05-par(0.04), 1e-10) {
+		t.Errorf("ISpread got %v, %v, want %v", i, err, 0.05-par(0.04))
+	}
+	// swaps above governments, the I-spread is the tighter
+	if i >= g {
+		t.Errorf("ISpread %v not below GSpread %v", i, g)
+	}
+
+	// a par bond paying the swap rate has no I-spread
+	atSwaps := Bond{Face: 1000, CouponRate: par(0.04), PeriodsPerYear: 1, Issue: anchor, Maturity: bullet.Maturity}
+	if i, _ := atSwaps.ISpread(1000, swaps, anchor); !almostEq(i, 0, 1e-10) {
+		t.Errorf("ISpread of a par swap bond got %v, want 0", i)
+	}
+	if _, err := bullet.GSpread(1000, government, bullet.Maturity); err == nil {
+		t.Error("GSpread at maturity: expected error")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Bond.AssetSwapSpread
+// -----------------------------------------------------------------------------
+func TestBondAssetSwapSpread(t *testing.T) {
+	swaps := RateAnnualContinuous{Value: 0.04}
+	flows, _ := bullet.CashFlows(anchor)
+	fair := flows.NPVCurve(swaps, anchor)
+
+	// priced on the swap curve the bond swaps to the floating rate flat
+	if asw, err := bullet.AssetSwapSpread(fair, swaps, anchor); err != nil || !almostEq(asw, 0, 1e-12) {
+		t.Errorf("AssetSwapSpread at fair value got %v, %v, want 0", asw, err)
+	}
+
+	// each unit of price below fair value is paid out over the annuity
+	annuity := 0.0
+	for k := 1; k <= 5; k++ {
+		annuity += 1000 * math.Exp(-0.04*float64(k))
+	}
+	if asw, _ := bullet.AssetSwapSpread(fair-20, swaps, anchor); !almostEq(asw, 20/annuity, 1e-12) {
+		t.Errorf("AssetSwapSpread got %v, want %v", asw, 20/annuity)
+	}
+
+	floater := Bond{Face: 100, PeriodsPerYear: 1, Issue: anchor, Maturity: bullet.Maturity, IndexCurve: swaps}
+	if _, err := floater.AssetSwapSpread(100, swaps, anchor); err == nil {
+		t.Error("AssetSwapSpread of a floater: expected error")
+	}
+}
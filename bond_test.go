@@ -0,0 +1,253 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// annual bullet used across the bond tests, coupon dates fall on anniversaries
+var bullet = Bond{
+	Face:           1000,
+	CouponRate:     0.05,
+	PeriodsPerYear: 1,
+	Issue:          anchor,
+	Maturity:       anchor.AddDate(5, 0, 0),
+}
+
+// -----------------------------------------------------------------------------
+// Bullet & zero-coupon
+// -----------------------------------------------------------------------------
+func TestBondBullet(t *testing.T) {
+	flows, err := bullet.CashFlows(anchor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(flows) != 6 || flows[0].Value != 50 || flows[5].Value != 1000 {
+		t.Errorf("bullet flows incorrect: %+v", flows)
+	}
+
+	// priced at its coupon rate the bond is worth par
+	if p, _ := bullet.PriceFromYield(0.05, anchor); !almostEq(p, 1000, 1e-10) {
+		t.Errorf("PriceFromYield at coupon got %.10f, want 1000", p)
+	}
+	// higher yield, lower price
+	if p, _ := bullet.PriceFromYield(0.06, anchor); p >= 1000 {
+		t.Errorf("PriceFromYield above coupon got %f, want below par", p)
+	}
+	// after maturity nothing is left
+	if flows, _ := bullet.CashFlows(anchor.AddDate(6, 0, 0)); len(flows) != 0 {
+		t.Errorf("flows after maturity: %+v", flows)
+	}
+}
+
+func TestBondZeroCoupon(t *testing.T) {
+	zero := Bond{Face: 100, PeriodsPerYear: 2, Issue: anchor, Maturity: anchor.AddDate(10, 0, 0)}
+	flows, _ := zero.CashFlows(anchor)
+	if len(flows) != 1 || flows[0].Value != 100 {
+		t.Errorf("zero-coupon flows incorrect: %+v", flows)
+	}
+	want := 100 * math.Pow(1+0.04/2, -20)
+	if p, _ := zero.PriceFromYield(0.04, anchor); !almostEq(p, want, epsilon) {
+		t.Errorf("zero-coupon price got %.10f, want %.10f", p, want)
+	}
+	if d, _ := zero.MacaulayDuration(0.04, anchor); !almostEq(d, 10, epsilon) {
+		t.Errorf("zero-coupon Macaulay duration got %f, want 10", d)
+	}
+	if d, _ := zero.ModifiedDuration(0.04, anchor); !almostEq(d, 10/1.02, epsilon) {
+		t.Errorf("zero-coupon modified duration got %f, want %f", d, 10/1.02)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Floating-rate note
+// -----------------------------------------------------------------------------
+func TestBondFloater(t *testing.T) {
+	frn := Bond{
+		Face:           100,
+		PeriodsPerYear: 1,
+		Issue:          anchor,
+		Maturity:       anchor.AddDate(3, 0, 0),
+		IndexCurve:     RateAnnualContinuous{Value: 0.03},
+		QuotedMargin:   0.01,
+	}
+	flows, err := frn.CashFlows(anchor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	coupon := 100 * (math.Exp(0.03) - 1 + 0.01)
+	for i := range 3 {
+		if !almostEq(flows[i].Value, coupon, epsilon) {
+			t.Errorf("floater coupon %d got %f, want %f", i, flows[i].Value, coupon)
+		}
+	}
+
+	// with no margin and discounted on its index the floater is worth par
+	frn.QuotedMargin = 0
+	flows, _ = frn.CashFlows(anchor)
+	if pv := flows.NPVCurve(frn.IndexCurve, anchor); !almostEq(pv, 100, 1e-10) {
+		t.Errorf("floater PV on its index got %.10f, want 100", pv)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Amortizing / sinking fund
+// -----------------------------------------------------------------------------
+func TestBondSinking(t *testing.T) {
+	amort := bullet
+	amort.Sinking = CashFlows{
+		{Value: 200, Date: anchor.AddDate(2, 0, 0)},
+		{Value: 300, Date: anchor.AddDate(4, 0, 0)},
+	}
+	flows, err := amort.CashFlows(anchor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	total := 0.0
+	for _, cf := range flows {
+		total += cf.Value
+	}
+	principal := 1000.0
+	// coupons: 2 years on 1000, 2 years on 800, 1 year on 500
+	coupons := 0.05 * (1000*2 + 800*2 + 500)
+	if !almostEq(total, principal+coupons, epsilon) {
+		t.Errorf("amortizing total got %f, want %f", total, principal+coupons)
+	}
+
+	// still priced at par at its coupon rate
+	if p, _ := amort.PriceFromYield(0.05, anchor); !almostEq(p, 1000, 1e-10) {
+		t.Errorf("amortizing PriceFromYield got %.10f, want 1000", p)
+	}
+	// amortization shortens duration
+	dBullet, _ := bullet.MacaulayDuration(0.05, anchor)
+	dAmort, _ := amort.MacaulayDuration(0.05, anchor)
+	if dAmort >= dBullet {
+		t.Errorf("amortizing duration %f should be below bullet %f", dAmort, dBullet)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Duration
+// -----------------------------------------------------------------------------
+func TestBondModifiedDurationMatchesSlope(t *testing.T) {
+	const h = 1e-6
+	up, _ := bullet.PriceFromYield(0.05+h, anchor)
+	down, _ := bullet.PriceFromYield(0.05-h, anchor)
+	price, _ := bullet.PriceFromYield(0.05, anchor)
+	want := -(up - down) / (2 * h) / price
+
+	got, err := bullet.ModifiedDuration(0.05, anchor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEq(got, want, 1e-6) {
+		t.Errorf("ModifiedDuration got %f, finite difference %f", got, want)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Odd coupons & accrued interest
+// -----------------------------------------------------------------------------
+func TestBondStub(t *testing.T) {
+	issue := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	short := Bond{Face: 100, CouponRate: 0.06, PeriodsPerYear: 2, Issue: issue, Maturity: time.Date(2025, 4, 15, 0, 0, 0, 0, time.UTC)}
+	long := short
+	long.Stub = StubLongFirst
+
+	shortFlows, err := short.CashFlows(issue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	longFlows, err := long.CashFlows(issue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the long first coupon pays the short stub and the next period together
+	if len(shortFlows) != 4 || len(longFlows) != 3 || !longFlows[0].Date.Equal(shortFlows[1].Date) ||
+		!almostEq(longFlows[0].Value, shortFlows[0].Value+shortFlows[1].Value, 1e-12) {
+		t.Errorf("short %v, long %v", shortFlows, longFlows)
+	}
+	if want := 6 * yearsBetween(issue, shortFlows[0].Date); !almostEq(shortFlows[0].Value, want, epsilon) {
+		t.Errorf("short stub coupon got %v, want %v", shortFlows[0].Value, want)
+	}
+
+	// accrued interest runs from issue through the long stub
+	settlement := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+	if got, err := long.AccruedInterest(settlement); err != nil || !almostEq(got, 6*yearsBetween(issue, settlement), epsilon) {
+		t.Errorf("long stub AccruedInterest got %v, %v", got, err)
+	}
+	if got, _ := short.AccruedInterest(settlement); !almostEq(got, 6*yearsBetween(shortFlows[0].Date, settlement), epsilon) {
+		t.Errorf("short stub AccruedInterest got %v", got)
+	}
+	if got, _ := short.AccruedInterest(issue); got != 0 {
+		t.Errorf("AccruedInterest at issue got %v, want 0", got)
+	}
+	if _, err := (Bond{Face: 100, PeriodsPerYear: 2, Issue: issue, Maturity: short.Maturity, IndexCurve: RateEffective{0.05, 1}}).AccruedInterest(settlement); err == nil {
+		t.Error("floater AccruedInterest: expected error")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Day count & business-day calendar
+// -----------------------------------------------------------------------------
+func TestBondDayCountCalendar(t *testing.T) {
+	// the last coupon date, 2022-01-01, is a Saturday
+	b := Bond{Face: 100, CouponRate: 0.04, PeriodsPerYear: 2, DayCount: DayCountThirty360, Issue: anchor, Maturity: anchor.AddDate(2, 0, 0)}
+	flows, err := b.CashFlows(anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cf := range flows[:3] {
+		if !almostEq(cf.Value, 2, epsilon) {
+			t.Errorf("30/360 coupon got %v, want 2", cf.Value)
+		}
+	}
+	if got, _ := b.AccruedInterest(anchor.AddDate(0, 3, 0)); !almostEq(got, 1, epsilon) {
+		t.Errorf("30/360 AccruedInterest got %v, want 1", got)
+	}
+
+	b.Calendar = &BusinessCalendar{}
+	rolled, err := b.CashFlows(anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	monday := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	if last := rolled[len(rolled)-1]; !last.Date.Equal(monday) || last.Value != flows[len(flows)-1].Value {
+		t.Errorf("payment on a Saturday got %v, want %v on Monday", last, flows[len(flows)-1].Value)
+	}
+	// the street yield discounts over the scheduled dates
+	unrolled := b
+	unrolled.Calendar = nil
+	y1, err1 := b.YieldToMaturity(100, anchor, YieldQuote{})
+	y2, err2 := unrolled.YieldToMaturity(100, anchor, YieldQuote{})
+	if err1 != nil || err2 != nil || !almostEq(y1, y2, epsilon) {
+		t.Errorf("street yield with calendar got %v, %v, want %v", y1, err1, y2)
+	}
+}
+
+func TestBondErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		b    Bond
+	}{
+		{"zero face", Bond{PeriodsPerYear: 1, Issue: anchor, Maturity: anchor.AddDate(1, 0, 0)}},
+		{"bad frequency", Bond{Face: 1, PeriodsPerYear: 5, Issue: anchor, Maturity: anchor.AddDate(1, 0, 0)}},
+		{"maturity before issue", Bond{Face: 1, PeriodsPerYear: 1, Issue: anchor, Maturity: anchor}},
+		{"sinking after maturity", Bond{Face: 1, PeriodsPerYear: 1, Issue: anchor, Maturity: anchor.AddDate(1, 0, 0),
+			Sinking: CashFlows{{Value: 0.5, Date: anchor.AddDate(2, 0, 0)}}}},
+		{"sinking exceeds face", Bond{Face: 1, PeriodsPerYear: 1, Issue: anchor, Maturity: anchor.AddDate(2, 0, 0),
+			Sinking: CashFlows{{Value: 2, Date: anchor.AddDate(1, 0, 0)}}}},
+	}
+	for _, tc := range tests {
+		if _, err := tc.b.PriceFromYield(0.05, anchor); err == nil {
+			t.Errorf("%s: expected PriceFromYield error", tc.name)
+		}
+		if _, err := tc.b.ModifiedDuration(0.05, anchor); err == nil {
+			t.Errorf("%s: expected ModifiedDuration error", tc.name)
+		}
+	}
+
+	if _, err := bullet.MacaulayDuration(0.05, anchor.AddDate(10, 0, 0)); err == nil {
+		t.Error("expected duration error after maturity")
+	}
+}
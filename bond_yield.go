@@ -0,0 +1,113 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// YieldConvention is a market convention for quoting the yield to
+// maturity of a [Bond].
+type YieldConvention int
+
+const (
+	// YieldStreet compounds PeriodsPerYear times a year over quasi-coupon
+	// periods, a fraction of the period in progress then whole periods,
+	// every payment on its scheduled date.
+	YieldStreet YieldConvention = iota
+	// YieldTrue is YieldStreet with every payment on the business day of
+	// the quote's Calendar it is actually made on.
+	YieldTrue
+	// YieldJapaneseSimple is the simple yield of the Japanese market: the
+	// coupon plus the pull to par spread evenly over the years left,
+	// on actual/365, over the clean price.
+	YieldJapaneseSimple
+)
+
+// YieldQuote describes a price quote of a [Bond]: the yield Convention,
+// whether the price is Clean, net of [Bond.AccruedInterest], or dirty,
+// and the Calendar payments roll on for [YieldTrue].
+type YieldQuote struct {
+	Convention YieldConvention
+	Clean      bool
+	Calendar   BusinessCalendar
+}
+
+// YieldToMaturity returns the yield of the bond at price, in the currency
+// of Face, at settlement under the convention of quote. Street and true
+// yields are found numerically, the search tuned by an optional
+// [SolverOptions]; the Japanese simple yield is only defined for a
+// fixed-rate bullet. [Bond.YieldFromPrice] compounds over actual year
+// fractions instead of quasi-coupon periods.
+// Math details:
+//
+// DirtyPrice = \sum_i CashFlow_i * (1 + Yield / Periods)^{-QuasiPeriods(Settlement, Date_i)}   (street, true)
+//
+// SimpleYield = (Face * CouponRate + (Face - CleanPrice) / Years) / CleanPrice,   Years = Actual365Fixed(Settlement, Maturity)
+func (b Bond) YieldToMaturity(price float64, settlement time.Time, quote YieldQuote, opts ...SolverOptions) (float64, error) {
+	// payments are taken on their scheduled dates, YieldTrue rolls them
+	// by the quote's Calendar
+	b.Calendar = nil
+	flows, err := b.CashFlows(settlement)
+	if err != nil {
+		return math.NaN(), err
+	}
+	if len(flows) == 0 {
+		return math.NaN(), fmt.Errorf("Bond.YieldToMaturity: %w after settlement", ErrEmptyCashFlows)
+	}
+	dirty, clean := price, price
+	if b.IndexCurve == nil {
+		accrued, err := b.AccruedInterest(settlement)
+		if err != nil {
+			return math.NaN(), err
+		}
+		if quote.Clean {
+			dirty += accrued
+		} else {
+			clean -= accrued
+		}
+	} else if quote.Clean {
+		return math.NaN(), errors.New("Bond.YieldToMaturity requires a dirty price for a floating-rate note")
+	}
+
+	switch quote.Convention {
+	case YieldStreet, YieldTrue:
+		anchor, step := b.Maturity, 12/b.PeriodsPerYear
+		if b.Stub == StubShortLast || b.Stub == StubLongLast {
+			anchor = b.Issue
+		}
+		periods := make([]float64, len(flows))
+		for i, cf := range flows {
+			d := cf.Date
+			if quote.Convention == YieldTrue {
+				d = quote.Calendar.AddBusinessDays(d, 0)
+			}
+			periods[i] = quasiPeriods(anchor, step, settlement, d)
+		}
+		m := float64(b.PeriodsPerYear)
+		f := func(y float64) float64 {
+			pv := 0.0
+			for i, cf := range flows {
+				pv += cf.Value * math.Pow(1+y/m, -periods[i])
+			}
+			return pv - dirty
+		}
+		o := solverOptions(opts)
+		lo, hi := -0.99*m, max(o.InitialGuess, 0.01)
+		for f(hi) > 0 && hi < 1000 {
+			hi *= 2
+		}
+		if f(lo)*f(hi) > 0 {
+			return math.NaN(), fmt.Errorf("Bond.YieldToMaturity: %w", ErrNoRootBracketed)
+		}
+		return brent(f, lo, hi, o)
+	case YieldJapaneseSimple:
+		if b.IndexCurve != nil || len(b.Sinking) > 0 {
+			return math.NaN(), errors.New("Bond.YieldToMaturity requires a fixed-rate bullet for the Japanese simple yield")
+		}
+		years := DayCountActual365Fixed.YearFraction(settlement, b.Maturity)
+		return (b.Face*b.CouponRate + (b.Face-clean)/years) / clean, nil
+	}
+	return math.NaN(), errors.New("Bond.YieldToMaturity requires a known YieldConvention")
+}
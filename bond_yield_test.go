@@ -0,0 +1,83 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// Bond.YieldToMaturity
+// -----------------------------------------------------------------------------
+func TestBondYieldStreet(t *testing.T) {
+	// on a coupon date the street yield is the yield at par
+	if y, err := bullet.YieldToMaturity(1000, anchor, YieldQuote{}); err != nil || !almostEq(y, 0.05, 1e-10) {
+		t.Errorf("par street yield got %v, %v, want 0.05", y, err)
+	}
+
+	// between coupons the first period is discounted by its share of days
+	b := Bond{Face: 100, CouponRate: 0.05, PeriodsPerYear: 2, Issue: anchor, Maturity: anchor.AddDate(3, 0, 0)}
+	settlement := anchor.AddDate(0, 2, 0)
+	next := anchor.AddDate(0, 6, 0)
+	w := next.Sub(settlement).Hours() / next.Sub(anchor).Hours()
+	flows, _ := b.CashFlows(settlement)
+	dirty := 0.0
+	for i, d := range []int{6, 12, 18, 24, 30, 36, 36} {
+		if !flows[i].Date.Equal(anchor.AddDate(0, d, 0)) {
+			t.Fatalf("flow %d on %v", i, flows[i].Date)
+		}
+		dirty += flows[i].Value * math.Pow(1.03, -(w+float64(d/6-1)))
+	}
+	y, err := b.YieldToMaturity(dirty, settlement, YieldQuote{})
+	if err != nil || !almostEq(y, 0.06, 1e-10) {
+		t.Errorf("dirty street yield got %v, %v, want 0.06", y, err)
+	}
+	accrued, _ := b.AccruedInterest(settlement)
+	if y, err := b.YieldToMaturity(dirty-accrued, settlement, YieldQuote{Clean: true}); err != nil || !almostEq(y, 0.06, 1e-10) {
+		t.Errorf("clean street yield got %v, %v, want 0.06", y, err)
+	}
+}
+
+func TestBondYieldTrue(t *testing.T) {
+	// the 2022 and 2023 coupons fall on a Saturday and a Sunday
+	price := 1000.0
+	street, _ := bullet.YieldToMaturity(price, anchor, YieldQuote{})
+	calendar := BusinessCalendar{Holidays: []time.Time{time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}}
+	yield, err := bullet.YieldToMaturity(price, anchor, YieldQuote{Convention: YieldTrue, Calendar: calendar})
+	if err != nil || yield >= street {
+		t.Errorf("true yield got %v, %v, want below the street yield %v", yield, err, street)
+	}
+}
+
+func TestBondYieldJapaneseSimple(t *testing.T) {
+	b := Bond{Face: 100, CouponRate: 0.02, PeriodsPerYear: 2, Issue: anchor, Maturity: anchor.AddDate(5, 0, 0)}
+	years := anchor.AddDate(5, 0, 0).Sub(anchor).Hours() / 24 / 365
+	want := (2 + 2/years) / 98
+	if y, err := b.YieldToMaturity(98, anchor, YieldQuote{Convention: YieldJapaneseSimple, Clean: true}); err != nil || !almostEq(y, want, epsilon) {
+		t.Errorf("simple yield got %v, %v, want %v", y, err, want)
+	}
+
+	// a dirty price is reduced to clean first
+	settlement := anchor.AddDate(0, 3, 0)
+	accrued, _ := b.AccruedInterest(settlement)
+	clean, _ := b.YieldToMaturity(98, settlement, YieldQuote{Convention: YieldJapaneseSimple, Clean: true})
+	if y, _ := b.YieldToMaturity(98+accrued, settlement, YieldQuote{Convention: YieldJapaneseSimple}); !almostEq(y, clean, epsilon) {
+		t.Errorf("simple yield from dirty got %v, want %v", y, clean)
+	}
+
+	sinking := b
+	sinking.Sinking = CashFlows{{50, anchor.AddDate(2, 0, 0)}}
+	if _, err := sinking.YieldToMaturity(98, anchor, YieldQuote{Convention: YieldJapaneseSimple}); err == nil {
+		t.Error("simple yield of a sinking fund bond: expected error")
+	}
+	floater := Bond{Face: 100, PeriodsPerYear: 2, Issue: anchor, Maturity: b.Maturity, IndexCurve: RateEffective{0.03, 1}}
+	if _, err := floater.YieldToMaturity(100, anchor, YieldQuote{Clean: true}); err == nil {
+		t.Error("clean price of a floater: expected error")
+	}
+	if _, err := b.YieldToMaturity(98, anchor, YieldQuote{Convention: YieldConvention(7)}); err == nil {
+		t.Error("unknown convention: expected error")
+	}
+	if _, err := b.YieldToMaturity(98, b.Maturity, YieldQuote{}); err == nil {
+		t.Error("settlement at maturity: expected error")
+	}
+}
@@ -0,0 +1,105 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+)
+
+// CostStructure describes a single-product business for cost-volume-profit
+// analysis: FixedCosts per period, and the VariableCost and Price per unit.
+type CostStructure struct {
+	FixedCosts   float64
+	VariableCost float64
+	Price        float64
+}
+
+// ContributionMargin returns the contribution of each unit sold
+// towards fixed costs and profit.
+// Math details:
+//
+// CM = Price - VariableCost
+func (c CostStructure) ContributionMargin() float64 {
+	return c.Price - c.VariableCost
+}
+
+// ContributionMarginRatio returns the contribution margin as a fraction
+// of the price, the contribution of each unit of revenue.
+// Math details:
+//
+// CMR = (Price - VariableCost) / Price
+func (c CostStructure) ContributionMarginRatio() float64 {
+	return c.ContributionMargin() / c.Price
+}
+
+// OperatingIncome returns the profit before interest and taxes
+// on quantity units sold.
+// Math details:
+//
+// EBIT = CM * Quantity - FixedCosts
+func (c CostStructure) OperatingIncome(quantity float64) float64 {
+	return c.ContributionMargin()*quantity - c.FixedCosts
+}
+
+// TargetQuantity returns the units to sell for an operating income of
+// profit, see [CostStructure.BreakEvenQuantity] for zero profit.
+// Math details:
+//
+// Quantity = (FixedCosts + Profit) / CM
+func (c CostStructure) TargetQuantity(profit float64) (float64, error) {
+	if c.Price <= 0 {
+		return math.NaN(), errors.New("CostStructure requires a positive price")
+	}
+	if c.ContributionMargin() <= 0 {
+		return math.NaN(), errors.New("CostStructure requires a price above the variable cost")
+	}
+	return (c.FixedCosts + profit) / c.ContributionMargin(), nil
+}
+
+// BreakEvenQuantity returns the units to sell to cover the fixed costs.
+// Math details:
+//
+// Quantity_BE = FixedCosts / CM
+func (c CostStructure) BreakEvenQuantity() (float64, error) {
+	return c.TargetQuantity(0)
+}
+
+// BreakEvenRevenue returns the sales revenue that covers the fixed costs.
+// Math details:
+//
+// Revenue_BE = FixedCosts / CMR = Price * Quantity_BE
+func (c CostStructure) BreakEvenRevenue() (float64, error) {
+	q, err := c.BreakEvenQuantity()
+	return c.Price * q, err
+}
+
+// OperatingLeverage returns the degree of operating leverage at quantity
+// units sold: the percentage change of operating income per percentage
+// change of sales. It grows without bound near the break-even point
+// and is negative below it.
+// Math details:
+//
+// DOL = CM * Quantity / EBIT = 1 + FixedCosts / EBIT
+func (c CostStructure) OperatingLeverage(quantity float64) (float64, error) {
+	ebit := c.OperatingIncome(quantity)
+	if ebit == 0 {
+		return math.NaN(), errors.New("OperatingLeverage is undefined at the break-even point")
+	}
+	return c.ContributionMargin() * quantity / ebit, nil
+}
+
+// MarginOfSafety returns how far sales of quantity units can fall before
+// the business makes a loss, in units and as a fraction of quantity.
+// Math details:
+//
+// Units = Quantity - Quantity_BE,   Ratio = Units / Quantity = 1 / DOL
+func (c CostStructure) MarginOfSafety(quantity float64) (units, ratio float64, err error) {
+	be, err := c.BreakEvenQuantity()
+	if err != nil {
+		return math.NaN(), math.NaN(), err
+	}
+	if quantity <= 0 {
+		return math.NaN(), math.NaN(), errors.New("MarginOfSafety requires a positive quantity")
+	}
+	units = quantity - be
+	return units, units / quantity, nil
+}
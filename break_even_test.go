@@ -0,0 +1,70 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// Break-even
+// -----------------------------------------------------------------------------
+func TestBreakEven(t *testing.T) {
+	c := CostStructure{FixedCosts: 50000, VariableCost: 30, Price: 50}
+
+	if got := c.ContributionMargin(); !almostEq(got, 20, epsilon) {
+		t.Errorf("ContributionMargin got %v, want 20", got)
+	}
+	if got := c.ContributionMarginRatio(); !almostEq(got, 0.4, epsilon) {
+		t.Errorf("ContributionMarginRatio got %v, want 0.4", got)
+	}
+	if q, err := c.BreakEvenQuantity(); err != nil || !almostEq(q, 2500, epsilon) {
+		t.Errorf("BreakEvenQuantity got %v, %v, want 2500", q, err)
+	}
+	if r, err := c.BreakEvenRevenue(); err != nil || !almostEq(r, 125000, 1e-9) {
+		t.Errorf("BreakEvenRevenue got %v, %v, want 125000", r, err)
+	}
+	if q, _ := c.TargetQuantity(10000); !almostEq(q, 3000, epsilon) {
+		t.Errorf("TargetQuantity got %v, want 3000", q)
+	}
+	if got := c.OperatingIncome(2500); !almostEq(got, 0, epsilon) {
+		t.Errorf("OperatingIncome at break-even got %v, want 0", got)
+	}
+
+	for _, tc := range []CostStructure{
+		{FixedCosts: 100, VariableCost: 10, Price: 10},
+		{FixedCosts: 100, VariableCost: 1, Price: 0},
+	} {
+		if _, err := tc.BreakEvenQuantity(); err == nil {
+			t.Errorf("BreakEvenQuantity(%+v): expected error", tc)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Operating leverage, margin of safety
+// -----------------------------------------------------------------------------
+func TestOperatingLeverage(t *testing.T) {
+	c := CostStructure{FixedCosts: 50000, VariableCost: 30, Price: 50}
+
+	// EBIT 30000 on contribution 80000
+	dol, err := c.OperatingLeverage(4000)
+	if err != nil || !almostEq(dol, 80000.0/30000, epsilon) {
+		t.Errorf("OperatingLeverage got %v, %v, want 8/3", dol, err)
+	}
+	// a 1% rise in sales lifts EBIT by DOL percent
+	change := c.OperatingIncome(4040)/c.OperatingIncome(4000) - 1
+	if !almostEq(change, dol/100, 1e-12) {
+		t.Errorf("EBIT change got %v, want %v", change, dol/100)
+	}
+	if _, err := c.OperatingLeverage(2500); err == nil {
+		t.Error("OperatingLeverage at break-even: expected error")
+	}
+
+	units, ratio, err := c.MarginOfSafety(4000)
+	if err != nil || !almostEq(units, 1500, epsilon) || !almostEq(ratio, 0.375, epsilon) {
+		t.Errorf("MarginOfSafety got %v, %v, %v, want 1500, 0.375", units, ratio, err)
+	}
+	if !almostEq(ratio, 1/dol, epsilon) {
+		t.Errorf("margin of safety ratio %v != 1 / DOL %v", ratio, 1/dol)
+	}
+	if _, _, err := c.MarginOfSafety(0); err == nil {
+		t.Error("MarginOfSafety(0): expected error")
+	}
+}
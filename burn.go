@@ -0,0 +1,108 @@
+package gofinance
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// MonthlyBurn is the cash burned in the calendar month starting at Month:
+// Gross is the sum of the outflows, Net the outflows less the inflows,
+// both positive when cash is being spent.
+type MonthlyBurn struct {
+	Month time.Time
+	Gross float64
+	Net   float64
+}
+
+// MonthlyBurn returns the burn of every calendar month (UTC) from the month
+// of start up to and excluding the month of end, months without flows included.
+func (cfs CashFlows) MonthlyBurn(start, end time.Time) []MonthlyBurn {
+	first, last := monthIndex(start), monthIndex(end)
+	if last <= first {
+		return nil
+	}
+	months := make([]MonthlyBurn, last-first)
+	for i := range months {
+		m := first + i
+		months[i].Month = time.Date(m/12, time.Month(m%12+1), 1, 0, 0, 0, 0, time.UTC)
+	}
+	for _, cf := range cfs {
+		i := monthIndex(cf.Date) - first
+		if i < 0 || i >= len(months) {
+			continue
+		}
+		if cf.Value < 0 {
+			months[i].Gross -= cf.Value
+		}
+		months[i].Net -= cf.Value
+	}
+	return months
+}
+
+// AverageBurn returns the mean gross and net monthly burn.
+func AverageBurn(months []MonthlyBurn) (gross, net float64) {
+	for _, m := range months {
+		gross += m.Gross
+		net += m.Net
+	}
+	n := float64(len(months))
+	return gross / n, net / n
+}
+
+// maxRunwayMonths caps runway projections, beyond which cash is taken
+// to last indefinitely.
+const maxRunwayMonths = 1200
+
+// Runway returns the months until cash runs out at a net monthly burn of
+// monthlyBurn, growing by burnGrowth each month (e.g. 0.02 for 2%),
+// interpolating within the final month. It returns +Inf if cash lasts
+// beyond 100 years, for example if the company is not burning cash.
+// Math details:
+//
+// Runway = n + (Cash - \sum_{k=1}^{n} Burn_k) / Burn_{n+1},   Burn_k = MonthlyBurn * (1 + BurnGrowth)^{k-1}
+func Runway(cash, monthlyBurn, burnGrowth float64) float64 {
+	burn := monthlyBurn
+	for n := 0; n < maxRunwayMonths; n++ {
+		if burn > 0 && burn >= cash {
+			return float64(n) + cash/burn
+		}
+		cash -= burn
+		burn *= 1 + burnGrowth
+	}
+	return math.Inf(1)
+}
+
+// RunwayEstimate is a runway projection with a confidence band,
+// all in months.
+type RunwayEstimate struct {
+	Months float64
+	Low    float64
+	High   float64
+}
+
+// ProjectRunway projects the runway of cash from the historical monthly burn,
+// growing by burnGrowth each month. The band at confidence (e.g. 0.9)
+// reflects the uncertainty of the average burn estimated from history:
+// Low is the runway at the upper end of its confidence interval,
+// High at the lower end.
+// Math details:
+//
+// Burn_{Low, High} = Mean(NetBurn) \pm N^{-1}((1 + Confidence) / 2) * StdDev(NetBurn) / \sqrt{n}
+func ProjectRunway(cash float64, history []MonthlyBurn, burnGrowth, confidence float64) (RunwayEstimate, error) {
+	if len(history) < 2 {
+		return RunwayEstimate{}, fmt.Errorf("ProjectRunway requires at least two months of history: %w", ErrInsufficientData)
+	}
+	_, mean := AverageBurn(history)
+	ss := 0.0
+	for _, m := range history {
+		ss += (m.Net - mean) * (m.Net - mean)
+	}
+	n := float64(len(history))
+	halfWidth := normInv((1+confidence)/2) * math.Sqrt(ss/(n-1)/n)
+	return RunwayEstimate{
+		Months: Runway(cash, mean, burnGrowth),
+		Low:    Runway(cash, mean+halfWidth, burnGrowth),
+		High:   Runway(cash, mean-halfWidth, burnGrowth),
+	}, nil
+}
@@ -0,0 +1,75 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Burn rate
+// -----------------------------------------------------------------------------
+func TestMonthlyBurn(t *testing.T) {
+	cfs := CashFlows{
+		{-100, anchor.AddDate(0, 0, 5)},
+		{-50, anchor.AddDate(0, 0, 20)},
+		{30, anchor.AddDate(0, 0, 25)},
+		{-80, anchor.AddDate(0, 2, 1)},
+		{-999, anchor.AddDate(0, 3, 0)}, // outside
+	}
+	months := cfs.MonthlyBurn(anchor, anchor.AddDate(0, 3, 0))
+	want := []MonthlyBurn{
+		{anchor, 150, 120},
+		{anchor.AddDate(0, 1, 0), 0, 0},
+		{anchor.AddDate(0, 2, 0), 80, 80},
+	}
+	if len(months) != len(want) {
+		t.Fatalf("MonthlyBurn got %+v", months)
+	}
+	for i := range want {
+		if months[i] != want[i] {
+			t.Errorf("month %d got %+v, want %+v", i, months[i], want[i])
+		}
+	}
+	gross, net := AverageBurn(months)
+	if !almostEq(gross, 230.0/3, 1e-12) || !almostEq(net, 200.0/3, 1e-12) {
+		t.Errorf("AverageBurn got %f, %f", gross, net)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Runway
+// -----------------------------------------------------------------------------
+func TestRunway(t *testing.T) {
+	tests := []struct {
+		name                     string
+		cash, burn, growth, want float64
+	}{
+		{"flat burn", 1000, 100, 0, 10},
+		{"fractional month", 1050, 100, 0, 10.5},
+		{"growing burn", 331, 100, 0.1, 3},
+		{"not burning", 1000, -10, 0, math.Inf(1)},
+	}
+	for _, tt := range tests {
+		if got := Runway(tt.cash, tt.burn, tt.growth); !almostEq(got, tt.want, 1e-9) && got != tt.want {
+			t.Errorf("%s: Runway got %f, want %f", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestProjectRunway(t *testing.T) {
+	history := []MonthlyBurn{{Net: 90}, {Net: 110}, {Net: 100}, {Net: 100}}
+	est, err := ProjectRunway(1200, history, 0, 0.9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !almostEq(est.Months, 12, 1e-9) || !(est.Low < est.Months && est.Months < est.High) {
+		t.Errorf("ProjectRunway got %+v", est)
+	}
+	halfWidth := normInv(0.95) * math.Sqrt(200.0/3/4)
+	if !almostEq(est.Low, 1200/(100+halfWidth), 1e-9) {
+		t.Errorf("ProjectRunway low got %f, want %f", est.Low, 1200/(100+halfWidth))
+	}
+	if _, err := ProjectRunway(1200, history[:1], 0, 0.9); err == nil {
+		t.Error("ProjectRunway accepted one month of history")
+	}
+}
@@ -0,0 +1,121 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// FXCarry returns the carry over years of holding the foreign currency
+// funded in the domestic one, the return if the spot rate does not move:
+// the interest differential.
+// Math details:
+//
+// Carry = DiscountFactor_domestic(Years) / DiscountFactor_foreign(Years) - 1
+func FXCarry(domestic, foreign Rate, years float64) float64 {
+	return domestic.DiscountFactor(years)/foreign.DiscountFactor(years) - 1
+}
+
+// ForwardImpliedCarry returns the annual effective carry of buying the
+// foreign currency forward, implied by the forward points: the return if
+// the spot rate stays where it is until delivery in years.
+// By covered interest parity it equals the annualised [FXCarry].
+// Math details:
+//
+// Carry = (Spot / Forward)^{1 / Years} - 1
+func ForwardImpliedCarry(spot, forward, years float64) float64 {
+	return math.Pow(spot/forward, 1/years) - 1
+}
+
+// CarryPeriod is one period of a carry trade rolled in forwards, dated at
+// its end: the Carry locked in by the forward at the start, the Spot move
+// over the period, and the Total realized return.
+type CarryPeriod struct {
+	Date  time.Time
+	Carry float64
+	Spot  float64
+	Total float64
+}
+
+// CarryReturns returns the periods of a carry trade that buys the foreign
+// currency forward at each date and delivers it at the next, from spot
+// rates and the outright forwards for delivery at the next date, observed
+// at the same dates.
+// Math details:
+//
+// Carry_t = Spot_{t-1} / Forward_{t-1} - 1,   SpotMove_t = Spot_t / Spot_{t-1} - 1
+//
+// Total_t = Spot_t / Forward_{t-1} - 1 = (1 + Carry_t) * (1 + SpotMove_t) - 1
+func CarryReturns(spots, forwards TimeSeries) ([]CarryPeriod, error) {
+	if len(spots) != len(forwards) || len(spots) < 2 {
+		return nil, fmt.Errorf("CarryReturns requires two or more spots and a forward for each: %w", ErrInsufficientData)
+	}
+	periods := make([]CarryPeriod, len(spots)-1)
+	for i := 1; i < len(spots); i++ {
+		if !spots[i-1].Date.Equal(forwards[i-1].Date) {
+			return nil, errors.New("CarryReturns requires spots and forwards at the same dates")
+		}
+		prev, fwd, spot := spots[i-1].Value, forwards[i-1].Value, spots[i].Value
+		periods[i-1] = CarryPeriod{spots[i].Date, prev/fwd - 1, spot/prev - 1, spot/fwd - 1}
+	}
+	return periods, nil
+}
+
+// CarrySummary compares the carry a trade was expected to earn with what
+// it realized, annualised: ImpliedCarry compounds the forward-implied
+// carry, Realized the total returns with their Volatility. MaxDrawdown is
+// that of the wealth of the trade, and the ratios of carry to Volatility
+// and to MaxDrawdown measure how much carry was paid per unit of risk.
+type CarrySummary struct {
+	ImpliedCarry      float64
+	Realized          float64
+	Volatility        float64
+	MaxDrawdown       float64
+	CarryToVolatility float64
+	CarryToDrawdown   float64
+}
+
+// SummarizeCarry summarises carry periods occurring periodsPerYear times
+// a year. Ratios are +Inf when there is no volatility or no drawdown.
+// Math details:
+//
+// ImpliedCarry = \prod_t (1 + Carry_t)^{PeriodsPerYear / n} - 1
+//
+// Realized = \prod_t (1 + Total_t)^{PeriodsPerYear / n} - 1
+//
+// Volatility = StdDev(Total) * \sqrt{PeriodsPerYear}
+//
+// CarryToVolatility = ImpliedCarry / Volatility,   CarryToDrawdown = ImpliedCarry / MaxDrawdown
+func SummarizeCarry(periods []CarryPeriod, periodsPerYear float64) (CarrySummary, error) {
+	if len(periods) < 2 {
+		return CarrySummary{}, fmt.Errorf("SummarizeCarry requires two or more periods: %w", ErrInsufficientData)
+	}
+	n := float64(len(periods))
+	carry, total := 1.0, 1.0
+	totals := make([]float64, len(periods))
+	wealth := TimeSeries{{Value: 1}} // the peak includes the starting wealth
+	for i, p := range periods {
+		carry *= 1 + p.Carry
+		total *= 1 + p.Total
+		totals[i] = p.Total
+		wealth = append(wealth, Observation{p.Date, total})
+	}
+	s := CarrySummary{
+		ImpliedCarry: math.Pow(carry, periodsPerYear/n) - 1,
+		Realized:     math.Pow(total, periodsPerYear/n) - 1,
+		Volatility:   math.Sqrt(covariance(totals, totals) * periodsPerYear),
+		MaxDrawdown:  wealth.MaxDrawdown(),
+	}
+	s.CarryToVolatility = ratioOrInf(s.ImpliedCarry, s.Volatility)
+	s.CarryToDrawdown = ratioOrInf(s.ImpliedCarry, s.MaxDrawdown)
+	return s, nil
+}
+
+// ratioOrInf returns a / b, or an infinity of the sign of a when b is zero.
+func ratioOrInf(a, b float64) float64 {
+	if b == 0 {
+		return math.Inf(int(math.Copysign(1, a)))
+	}
+	return a / b
+}
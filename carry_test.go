@@ -0,0 +1,105 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Interest differential
+// -----------------------------------------------------------------------------
+func TestFXCarry(t *testing.T) {
+	domestic := RateAnnualContinuous{0.01}
+	foreign := RateAnnualContinuous{0.05}
+	if got, want := FXCarry(domestic, foreign, 0.5), math.Exp(0.02)-1; !almostEq(got, want, epsilon) {
+		t.Errorf("FXCarry got %f, want %f", got, want)
+	}
+	// the forward is at a discount by the same differential
+	fwd := ForwardPriceFX(1.25, domestic, foreign, 2)
+	if got, want := ForwardImpliedCarry(1.25, fwd, 2), math.Exp(0.04)-1; !almostEq(got, want, epsilon) {
+		t.Errorf("ForwardImpliedCarry got %f, want %f", got, want)
+	}
+	if got, want := ForwardImpliedCarry(1.25, fwd, 2), math.Pow(1+FXCarry(domestic, foreign, 2), 0.5)-1; !almostEq(got, want, epsilon) {
+		t.Errorf("ForwardImpliedCarry got %f, want annualised FXCarry %f", got, want)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Realized carry
+// -----------------------------------------------------------------------------
+func TestCarryReturns(t *testing.T) {
+	spots := TimeSeries{{anchor, 100}, {anchor.AddDate(0, 1, 0), 101}, {anchor.AddDate(0, 2, 0), 97}}
+	forwards := TimeSeries{{anchor, 99}, {anchor.AddDate(0, 1, 0), 100}, {anchor.AddDate(0, 2, 0), 96}}
+	periods, err := CarryReturns(spots, forwards)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []CarryPeriod{
+		{anchor.AddDate(0, 1, 0), 100.0/99 - 1, 0.01, 101.0/99 - 1},
+		{anchor.AddDate(0, 2, 0), 0.01, 97.0/101 - 1, 0.97 - 1},
+	}
+	for i, w := range want {
+		p := periods[i]
+		if !p.Date.Equal(w.Date) || !almostEq(p.Carry, w.Carry, epsilon) || !almostEq(p.Spot, w.Spot, epsilon) || !almostEq(p.Total, w.Total, epsilon) {
+			t.Errorf("period %d got %+v, want %+v", i, p, w)
+		}
+		if !almostEq(1+p.Total, (1+p.Carry)*(1+p.Spot), epsilon) {
+			t.Errorf("period %d Total does not decompose into Carry and Spot", i)
+		}
+	}
+
+	if _, err := CarryReturns(spots, forwards[:2]); err == nil {
+		t.Error("CarryReturns accepted mismatched lengths")
+	}
+	shifted := TimeSeries{{anchor.AddDate(0, 0, 1), 99}, forwards[1], forwards[2]}
+	if _, err := CarryReturns(spots, shifted); err == nil {
+		t.Error("CarryReturns accepted mismatched dates")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Carry summary
+// -----------------------------------------------------------------------------
+func TestSummarizeCarry(t *testing.T) {
+	periods := []CarryPeriod{
+		{anchor.AddDate(0, 1, 0), 0.01, 0.02, 1.01*1.02 - 1},
+		{anchor.AddDate(0, 2, 0), 0.01, -0.05, 1.01*0.95 - 1},
+		{anchor.AddDate(0, 3, 0), 0.01, 0.01, 1.01*1.01 - 1},
+		{anchor.AddDate(0, 4, 0), 0.01, 0.00, 0.01},
+	}
+	s, err := SummarizeCarry(periods, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := math.Pow(1.01, 12) - 1; !almostEq(s.ImpliedCarry, want, epsilon) {
+		t.Errorf("ImpliedCarry got %f, want %f", s.ImpliedCarry, want)
+	}
+	growth := 1.0302 * 0.9595 * 1.0201 * 1.01
+	if want := math.Pow(growth, 3) - 1; !almostEq(s.Realized, want, epsilon) {
+		t.Errorf("Realized got %f, want %f", s.Realized, want)
+	}
+	totals := []float64{0.0302, -0.0405, 0.0201, 0.01}
+	if want := math.Sqrt(covariance(totals, totals) * 12); !almostEq(s.Volatility, want, epsilon) {
+		t.Errorf("Volatility got %f, want %f", s.Volatility, want)
+	}
+	if want := 0.0405; !almostEq(s.MaxDrawdown, want, epsilon) {
+		t.Errorf("MaxDrawdown got %f, want %f", s.MaxDrawdown, want)
+	}
+	if !almostEq(s.CarryToVolatility, s.ImpliedCarry/s.Volatility, epsilon) || !almostEq(s.CarryToDrawdown, s.ImpliedCarry/s.MaxDrawdown, epsilon) {
+		t.Errorf("ratios got %f and %f", s.CarryToVolatility, s.CarryToDrawdown)
+	}
+
+	// a first-period loss is a drawdown from the starting wealth
+	s, _ = SummarizeCarry([]CarryPeriod{{Total: -0.1}, {Total: 0.05}}, 12)
+	if !almostEq(s.MaxDrawdown, 0.1, epsilon) {
+		t.Errorf("MaxDrawdown got %f, want 0.1", s.MaxDrawdown)
+	}
+	// no losses, no drawdown
+	s, _ = SummarizeCarry([]CarryPeriod{{Carry: 0.01, Total: 0.01}, {Carry: 0.01, Total: 0.02}}, 12)
+	if !math.IsInf(s.CarryToDrawdown, 1) {
+		t.Errorf("CarryToDrawdown got %f, want +Inf", s.CarryToDrawdown)
+	}
+	if _, err := SummarizeCarry(periods[:1], 12); err == nil {
+		t.Error("SummarizeCarry accepted a single period")
+	}
+}
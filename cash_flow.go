@@ -1,12 +1,13 @@
 package gofinance
 
 import (
-	"errors"
+	"cmp"
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
+	"math"
 	"slices"
 	"time"
-
-	"github.com/khezen/rootfinding" // for [IRR]
 )
 
 // CashFlow represents a single dated cash‑flow.
@@ -123,6 +124,13 @@ func (cf CashFlow) PresentValueNow(r Rate) float64 {
 	return cf.PresentValue(r, time.Now().UTC())
 }
 
+// Equal reports whether two cash-flows occur at the same instant and their
+// Values differ by no more than tolerance.
+// Dates are compared with [time.Time.Equal], so the location does not matter.
+func (cf CashFlow) Equal(other CashFlow, tolerance float64) bool {
+	return cf.Date.Equal(other.Date) && math.Abs(cf.Value-other.Value) <= tolerance
+}
+
 // CashFlows is a helper alias that adds portfolio‑level analytics to a slice
 // of CashFlow.
 //
@@ -133,22 +141,87 @@ func (cf CashFlow) PresentValueNow(r Rate) float64 {
 // All collection methods leave the original slice untouched (except [Sort]).
 type CashFlows []CashFlow
 
-// Sort orders the cash-flows in-place by ascending Date.
+// Sort orders the cash-flows in-place by ascending Date,
+// flows on the same Date are ordered by ascending Value.
+// The sort is stable, so flows equal in both Date and Value keep their
+// insertion order and repeated runs always produce the same order.
 // Useful before IRR calculations that implicitly take the
 // first cash-flow as the time-zero reference.
 func (cfs CashFlows) Sort() {
-	slices.SortFunc(cfs, func(a, b CashFlow) int {
-		switch {
-		case a.Date.Before(b.Date):
-			return -1 // a < b
-		case a.Date.After(b.Date):
-			return 1 // a > b
-		default:
-			return 0 // a == b
+	slices.SortStableFunc(cfs, func(a, b CashFlow) int {
+		if c := a.Date.Compare(b.Date); c != 0 {
+			return c
 		}
+		return cmp.Compare(a.Value, b.Value)
 	})
 }
 
+// SortedCopy returns a sorted copy of the cash-flows, see [CashFlows.Sort]
+// for the ordering. The original slice is left untouched.
+func (cfs CashFlows) SortedCopy() CashFlows {
+	sorted := slices.Clone(cfs)
+	sorted.Sort()
+	return sorted
+}
+
+// Dedupe returns the cash-flows with duplicates removed, two flows being
+// duplicates when [CashFlow.Equal] holds for the given tolerance.
+// The first occurrence is kept and the original order is preserved.
+func (cfs CashFlows) Dedupe(tolerance float64) CashFlows {
+	seen := make(map[int64][]float64) // UnixNano → Values kept so far
+	deduped := make(CashFlows, 0, len(cfs))
+	for _, cf := range cfs {
+		key := cf.Date.UnixNano()
+		if slices.ContainsFunc(seen[key], func(v float64) bool {
+			return math.Abs(cf.Value-v) <= tolerance
+		}) {
+			continue
+		}
+		seen[key] = append(seen[key], cf.Value)
+		deduped = append(deduped, cf)
+	}
+	return deduped
+}
+
+// Hash returns a content hash of the cash-flows, suitable as a cache key.
+// The hash depends only on the set of (Date, Value) pairs:
+// the order of the slice and the location of the Dates do not change it.
+func (cfs CashFlows) Hash() uint64 {
+	h := fnv.New64a()
+	var buf [16]byte
+	for _, cf := range cfs.SortedCopy() {
+		value := cf.Value
+		if value == 0 {
+			value = 0 // fold −0 into +0
+		}
+		binary.LittleEndian.PutUint64(buf[:8], uint64(cf.Date.UnixNano()))
+		binary.LittleEndian.PutUint64(buf[8:], math.Float64bits(value))
+		h.Write(buf[:])
+	}
+	return h.Sum64()
+}
+
+// ValuationKey returns a cache key for a valuation of the cash-flows,
+// combining [CashFlows.Hash] with the market inputs: the [Rate], including
+// its concrete type and fields, and the valuationDate.
+//
+//	key := cfs.ValuationKey(r, date)
+//	npv, ok := cache[key]
+//	if !ok {
+//		npv = cfs.NPV(r, date)
+//		cache[key] = npv
+//	}
+func (cfs CashFlows) ValuationKey(r Rate, valuationDate time.Time) uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], cfs.Hash())
+	h.Write(buf[:])
+	binary.LittleEndian.PutUint64(buf[:], uint64(valuationDate.UnixNano()))
+	h.Write(buf[:])
+	fmt.Fprintf(h, "%T%+v", r, r)
+	return h.Sum64()
+}
+
 // NPV computes the net present value of the collection at valuationDate using
 // the provided discount Rate.
 func (cfs CashFlows) NPV(r Rate, valuationDate time.Time) float64 {
@@ -159,25 +232,123 @@ func (cfs CashFlows) NPV(r Rate, valuationDate time.Time) float64 {
 	return npv
 }
 
+// irrLowerBound is the lowest continuous rate IRR searches, just shy of −100 %.
+const irrLowerBound = -0.999999
+
+// irrInitialGuess approximates the IRR with a simple money-weighted return:
+// total inflows over total outflows, spread over the gap between
+// the value-weighted average dates of inflows and outflows.
+// Math details:
+//
+// Guess = ln(Inflows / Outflows) / (AverageYearsOfInflows - AverageYearsOfOutflows)
+//
+// ok is false when the stream has no inflows, no outflows, or no time gap.
+func irrInitialGuess(ordered CashFlows, years []float64) (guess float64, ok bool) {
+	var in, out, inYears, outYears float64
+	for i, cf := range ordered {
+		if cf.Value > 0 {
+			in += cf.Value
+			inYears += cf.Value * years[i]
+		} else {
+			out -= cf.Value
+			outYears -= cf.Value * years[i]
+		}
+	}
+	if in == 0 || out == 0 {
+		return 0, false
+	}
+	gap := inYears/in - outYears/out
+	if gap == 0 {
+		return 0, false
+	}
+	guess = math.Log(in/out) / gap
+	return guess, !math.IsNaN(guess) && !math.IsInf(guess, 0) && guess > irrLowerBound
+}
+
+// irrNewton runs Newton's method on the NPV of the stream from guess.
+// Math details:
+//
+// NPV(r) = \sum Value_i * e^{-r * Years_i}
+//
+// NPV'(r) = -\sum Years_i * Value_i * e^{-r * Years_i}
+//
+// r_{n+1} = r_n - NPV(r_n) / NPV'(r_n)
+//
+// ok is false when Newton does not converge within opts.MaxIterations
+// or leaves the range searched by IRR, the caller then falls back to Brent.
+func irrNewton(ordered CashFlows, years []float64, guess float64, opts SolverOptions) (root float64, ok bool) {
+	r := guess
+	for range opts.MaxIterations {
+		npv, slope := 0.0, 0.0
+		for i, cf := range ordered {
+			pv := cf.Value * math.Exp(-r*years[i])
+			npv += pv
+			slope -= years[i] * pv
+		}
+		if slope == 0 || math.IsNaN(slope) || math.IsInf(slope, 0) {
+			return 0, false
+		}
+		step := npv / slope
+		r -= step
+		if math.IsNaN(r) || r <= irrLowerBound {
+			return 0, false
+		}
+		if math.Abs(step) <= math.Max(opts.AbsTolerance, opts.RelTolerance*math.Abs(r)) {
+			return r, true
+		}
+	}
+	return 0, false
+}
+
 // IRR estimates the internal [Rate] of return by finding the rate (r)
 // that makes the NPV of the cash-flow stream equal to zero.
-// It brackets a root automatically and then refines it with
-// [github.com/khezen/rootfinding.Brent]. The function returns an error if it cannot
-// bracket a root or if Brent fails to converge.
-func (cashFlows CashFlows) IRR() (Rate, error) {
+//
+// It first tries Newton's method from a money-weighted approximation
+// of the return, which converges in a handful of steps for well-behaved
+// streams. If Newton fails, it brackets a root automatically, starting
+// from the initial guess, and then refines it with Brent's method.
+// The function returns an error if it cannot bracket a root or
+// if Brent fails to converge.
+//
+// Precision and iteration cap can be tuned with an optional [SolverOptions],
+// without it [DefaultSolverOptions] apply. An explicit InitialGuess replaces
+// the money-weighted approximation as the starting point of Newton.
+func (cashFlows CashFlows) IRR(opts ...SolverOptions) (Rate, error) {
 	if len(cashFlows) == 0 {
-		return RateAnnualContinuous{}, errors.New("IRR requires at least one cash-flow")
+		return RateAnnualContinuous{}, fmt.Errorf("IRR: %w", ErrEmptyCashFlows)
 	}
+	o := solverOptions(opts)
 
 	// work on a sorted copy so the caller’s slice remains untouched
-	ordered := make(CashFlows, len(cashFlows))
-	copy(ordered, cashFlows)
-	ordered.Sort()
+	ordered := cashFlows.SortedCopy()
 	anchor := ordered[0].Date
 
-	// helper: wraps [NPV] with valuationDate = anchor
+	// year fractions do not depend on the rate, compute them once
+	years := make([]float64, len(ordered))
+	for i, cf := range ordered {
+		years[i] = cf.YearsFrom(anchor)
+	}
+
+	// helper: same as [NPV] with valuationDate = anchor
 	npv := func(r float64) float64 {
-		return ordered.NPV(RateAnnualContinuous{Value: r}, anchor)
+		sum := 0.0
+		for i, cf := range ordered {
+			sum += cf.Value * math.Exp(-r*years[i])
+		}
+		return sum
+	}
+
+	//----------------------------------------------------------------------
+	// 0.  Newton fast path
+	// ---------------------------------------------------------------------
+	guess := o.InitialGuess
+	if len(opts) == 0 || opts[0].InitialGuess == 0 {
+		if g, ok := irrInitialGuess(ordered, years); ok {
+			guess = g
+		}
+	}
+	if root, ok := irrNewton(ordered, years, guess, o); ok {
+		return RateAnnualContinuous{Value: root}, nil
 	}
 
 	//----------------------------------------------------------------------
@@ -186,27 +357,33 @@ func (cashFlows CashFlows) IRR() (Rate, error) {
 	// Starts with a very low rate just shy of −100 % (continuous); this gives
 	// a huge discount factor and usually pushes NPV positive when the first
 	// cash-flow is an outflow.
-	lowerBoundRate := -0.999999 // ~-99.9999 %
-	upperBoundRate := 0.10      // 10 % p.a. to begin with
+	lowerBoundRate := irrLowerBound // ~-99.9999 %
+	upperBoundRate := o.InitialGuess
+	if upperBoundRate <= lowerBoundRate {
+		upperBoundRate = DefaultSolverOptions().InitialGuess
+	}
 	npvLowerBound := npv(lowerBoundRate)
 	npvUpperBound := npv(upperBoundRate)
 
 	// If NPV signs do not differ, expand the upper bound exponentially
 	// until we hit a sign change or a reasonable ceiling.
 	for npvLowerBound*npvUpperBound > 0 && upperBoundRate < 1000 {
+		if upperBoundRate <= 0 {
+			upperBoundRate = DefaultSolverOptions().InitialGuess
+		}
 		upperBoundRate *= 2
 		npvUpperBound = npv(upperBoundRate)
 	}
 	if npvLowerBound*npvUpperBound > 0 {
-		return RateAnnualContinuous{}, errors.New("IRR: could not bracket a root")
+		return RateAnnualContinuous{}, fmt.Errorf("IRR: %w", ErrNoRootBracketed)
 	}
 
 	//----------------------------------------------------------------------
 	// 2.  Refine with Brent
 	// ---------------------------------------------------------------------
-	root, err := rootfinding.Brent(npv, lowerBoundRate, upperBoundRate, 12)
+	root, err := brent(npv, lowerBoundRate, upperBoundRate, o)
 	if err != nil {
 		return RateAnnualContinuous{}, fmt.Errorf("IRR: %w", err)
-	} // this if statement is not covered by tests because difficult to provoke error here
+	}
 	return RateAnnualContinuous{Value: root}, nil
 }
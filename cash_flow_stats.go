@@ -0,0 +1,87 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// FirstDate returns the earliest Date of the cash-flows,
+// the zero time if there are none.
+func (cfs CashFlows) FirstDate() time.Time {
+	var first time.Time
+	for i, cf := range cfs {
+		if i == 0 || cf.Date.Before(first) {
+			first = cf.Date
+		}
+	}
+	return first
+}
+
+// LastDate returns the latest Date of the cash-flows,
+// the zero time if there are none.
+func (cfs CashFlows) LastDate() time.Time {
+	var last time.Time
+	for i, cf := range cfs {
+		if i == 0 || cf.Date.After(last) {
+			last = cf.Date
+		}
+	}
+	return last
+}
+
+// TotalInflow returns the sum of the positive cash-flows.
+func (cfs CashFlows) TotalInflow() float64 {
+	total := 0.0
+	for _, cf := range cfs {
+		if cf.Value > 0 {
+			total += cf.Value
+		}
+	}
+	return total
+}
+
+// TotalOutflow returns the sum of the negative cash-flows
+// as a positive amount.
+func (cfs CashFlows) TotalOutflow() float64 {
+	total := 0.0
+	for _, cf := range cfs {
+		if cf.Value < 0 {
+			total -= cf.Value
+		}
+	}
+	return total
+}
+
+// Net returns the undiscounted sum of the cash-flows.
+func (cfs CashFlows) Net() float64 {
+	total := 0.0
+	for _, cf := range cfs {
+		total += cf.Value
+	}
+	return total
+}
+
+// WeightedAverageLife returns the average time in years from valuationDate
+// until the cash-flows after it are received, weighted by amount.
+// For loans and loan pools pass the principal repayments only, interest
+// excluded, to get the conventional WAL.
+// Flows before valuationDate are ignored; the flows after it must not
+// sum to zero.
+// Math details:
+//
+// WAL = \sum_i t_i * CF_i / \sum_i CF_i
+func (cfs CashFlows) WeightedAverageLife(valuationDate time.Time) (float64, error) {
+	weighted, total := 0.0, 0.0
+	for _, cf := range cfs {
+		if cf.Date.Before(valuationDate) {
+			continue
+		}
+		weighted += cf.YearsFrom(valuationDate) * cf.Value
+		total += cf.Value
+	}
+	if total == 0 {
+		return math.NaN(), errors.New("WeightedAverageLife requires cash-flows after valuationDate that do not sum to zero")
+	}
+	return weighted / total, nil
+}
@@ -0,0 +1,77 @@
+package gofinance
+
+import (
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// Cash-flow statistics
+// -----------------------------------------------------------------------------
+func TestCashFlowStats(t *testing.T) {
+	cfs := CashFlows{
+		{50, anchor.AddDate(2, 0, 0)},
+		{-100, anchor},
+		{30, anchor.AddDate(1, 0, 0)},
+		{-10, anchor.AddDate(1, 6, 0)},
+		{60, anchor.AddDate(3, 0, 0)},
+	}
+	if got := cfs.FirstDate(); !got.Equal(anchor) {
+		t.Errorf("FirstDate got %v, want %v", got, anchor)
+	}
+	if got := cfs.LastDate(); !got.Equal(anchor.AddDate(3, 0, 0)) {
+		t.Errorf("LastDate got %v", got)
+	}
+	if got := cfs.TotalInflow(); !almostEq(got, 140, epsilon) {
+		t.Errorf("TotalInflow got %v, want 140", got)
+	}
+	if got := cfs.TotalOutflow(); !almostEq(got, 110, epsilon) {
+		t.Errorf("TotalOutflow got %v, want 110", got)
+	}
+	if got := cfs.Net(); !almostEq(got, 30, epsilon) {
+		t.Errorf("Net got %v, want 30", got)
+	}
+
+	var empty CashFlows
+	if !empty.FirstDate().IsZero() || !empty.LastDate().IsZero() || empty.Net() != 0 {
+		t.Error("empty CashFlows should give zero dates and amounts")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// WeightedAverageLife
+// -----------------------------------------------------------------------------
+func TestWeightedAverageLife(t *testing.T) {
+	// a level amortizing loan repaying 100 a year for three years
+	principal := CashFlows{
+		{100, anchor.AddDate(1, 0, 0)},
+		{100, anchor.AddDate(2, 0, 0)},
+		{100, anchor.AddDate(3, 0, 0)},
+	}
+	want := (principal[0].YearsFrom(anchor) + principal[1].YearsFrom(anchor) + principal[2].YearsFrom(anchor)) / 3
+	wal, err := principal.WeightedAverageLife(anchor)
+	if err != nil || !almostEq(wal, want, epsilon) || !almostEq(wal, 2, 0.01) {
+		t.Errorf("WAL got %v, %v, want %v", wal, err, want)
+	}
+
+	// a bullet repays everything at maturity
+	bullet := CashFlows{{300, anchor.AddDate(5, 0, 0)}}
+	if wal, _ := bullet.WeightedAverageLife(anchor); !almostEq(wal, bullet[0].YearsFrom(anchor), epsilon) {
+		t.Errorf("bullet WAL got %v", wal)
+	}
+
+	// flows already received are ignored
+	later := anchor.AddDate(1, 6, 0)
+	wal, _ = principal.WeightedAverageLife(later)
+	want = (principal[1].YearsFrom(later) + principal[2].YearsFrom(later)) / 2
+	if !almostEq(wal, want, epsilon) {
+		t.Errorf("seasoned WAL got %v, want %v", wal, want)
+	}
+
+	if _, err := principal.WeightedAverageLife(anchor.AddDate(4, 0, 0)); err == nil {
+		t.Error("no flows after valuation date: expected error")
+	}
+	if _, err := (CashFlows{{100, anchor}, {-100, anchor.Add(time.Hour)}}).WeightedAverageLife(anchor); err == nil {
+		t.Error("flows summing to zero: expected error")
+	}
+}
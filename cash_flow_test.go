@@ -2,6 +2,7 @@ package gofinance
 
 import (
 	"math"
+	"slices"
 	"testing"
 	"time"
 
@@ -158,6 +159,50 @@ func TestCashFlowsSortEqualDates(t *testing.T) {
 	}
 }
 
+// -----------------------------------------------------------------------------
+// CashFlows.Sort – value tie-break & stability
+// -----------------------------------------------------------------------------
+func TestCashFlowsSortStable(t *testing.T) {
+	dAfter := anchor.AddDate(0, 0, 1)
+	cfs := CashFlows{
+		{Value: 5, Date: dAfter},
+		{Value: 300, Date: anchor},
+		{Value: 100, Date: anchor},
+		// same instant and Value, told apart only by the zone name
+		{Value: 100, Date: anchor.In(time.FixedZone("X", 0))},
+	}
+
+	cfs.Sort()
+
+	want := []float64{100, 100, 300, 5}
+	for i, cf := range cfs {
+		if cf.Value != want[i] {
+			t.Fatalf("CashFlows.Sort value order: got %+v, want values %v", cfs, want)
+		}
+	}
+	if cfs[0].Date.Location() != time.UTC || cfs[1].Date.Location().String() != "X" {
+		t.Errorf("CashFlows.Sort is not stable for equal flows: %+v", cfs)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// CashFlows.SortedCopy
+// -----------------------------------------------------------------------------
+func TestCashFlowsSortedCopy(t *testing.T) {
+	cfs := CashFlows{
+		{Value: 2, Date: anchor.AddDate(0, 0, 1)},
+		{Value: 1, Date: anchor},
+	}
+	sorted := cfs.SortedCopy()
+
+	if sorted[0].Value != 1 || sorted[1].Value != 2 {
+		t.Errorf("SortedCopy order incorrect: %+v", sorted)
+	}
+	if cfs[0].Value != 2 || cfs[1].Value != 1 {
+		t.Errorf("SortedCopy modified the original slice: %+v", cfs)
+	}
+}
+
 // -----------------------------------------------------------------------------
 // NPV
 // -----------------------------------------------------------------------------
@@ -233,3 +278,166 @@ func TestIRRErrors(t *testing.T) {
 		t.Error("IRR expected error for un-bracketable root, got nil")
 	}
 }
+
+// -----------------------------------------------------------------------------
+// IRR initial guess & Newton fast path
+// -----------------------------------------------------------------------------
+func TestIRRInitialGuess(t *testing.T) {
+	cfs := CashFlows{
+		{-100, anchor},
+		{110, anchor.AddDate(1, 0, 0)},
+	}
+	guess, ok := irrInitialGuess(cfs, []float64{0, 1})
+	if !ok || !almostEq(guess, math.Log(1.1), epsilon) {
+		t.Errorf("two-flow guess got (%f, %v), want (%f, true)", guess, ok, math.Log(1.1))
+	}
+
+	tests := []struct {
+		name  string
+		cfs   CashFlows
+		years []float64
+	}{
+		{"no outflows", CashFlows{{10, anchor}, {10, anchor}}, []float64{0, 1}},
+		{"no inflows", CashFlows{{-10, anchor}, {-10, anchor}}, []float64{0, 1}},
+		{"no time gap", CashFlows{{-10, anchor}, {20, anchor}}, []float64{0, 0}},
+	}
+	for _, tc := range tests {
+		if _, ok := irrInitialGuess(tc.cfs, tc.years); ok {
+			t.Errorf("%s: expected ok=false", tc.name)
+		}
+	}
+}
+
+func TestIRRNewton(t *testing.T) {
+	cfs := CashFlows{
+		{-1000, anchor},
+		{400, anchor.AddDate(1, 0, 0)},
+		{400, anchor.AddDate(2, 0, 0)},
+		{400, anchor.AddDate(3, 0, 0)},
+	}
+	years := []float64{0, 1, 2, 3}
+
+	root, ok := irrNewton(cfs, years, 0.05, DefaultSolverOptions())
+	if !ok {
+		t.Fatal("Newton did not converge")
+	}
+	if npv := cfs.NPV(RateAnnualContinuous{Value: root}, anchor); !almostEq(npv, 0, 1e-9) {
+		t.Errorf("NPV at Newton root = %g, want 0", npv)
+	}
+
+	// flat NPV has no slope to follow
+	if _, ok := irrNewton(CashFlows{{5, anchor}}, []float64{0}, 0.05, DefaultSolverOptions()); ok {
+		t.Error("expected Newton to fail on zero slope")
+	}
+
+	// from a far-off guess the slope is tiny and Newton overshoots
+	// below the searched range
+	if _, ok := irrNewton(cfs, years, 20, DefaultSolverOptions()); ok {
+		t.Error("expected Newton to leave the searched range")
+	}
+}
+
+func TestIRRFallbackToBrent(t *testing.T) {
+	// a far-off guess sends Newton out of range, Brent still converges
+	cfs := CashFlows{
+		{-1000, anchor},
+		{400, anchor.AddDate(1, 0, 0)},
+		{400, anchor.AddDate(2, 0, 0)},
+		{400, anchor.AddDate(3, 0, 0)},
+	}
+	irr, err := cfs.IRR(SolverOptions{InitialGuess: 20})
+	if err != nil {
+		t.Fatalf("IRR error: %v", err)
+	}
+	if npv := cfs.NPV(irr, anchor); !almostEq(npv, 0, 1e-6) {
+		t.Errorf("NPV at IRR = %f, want 0", npv)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// CashFlow.Equal & CashFlows.Dedupe
+// -----------------------------------------------------------------------------
+func TestCashFlowEqual(t *testing.T) {
+	a := CashFlow{Value: 100, Date: anchor}
+	tests := []struct {
+		name string
+		b    CashFlow
+		tol  float64
+		want bool
+	}{
+		{"identical", a, 0, true},
+		{"within tolerance", CashFlow{Value: 100.004, Date: anchor}, 0.01, true},
+		{"outside tolerance", CashFlow{Value: 100.02, Date: anchor}, 0.01, false},
+		{"other location, same instant", CashFlow{Value: 100, Date: anchor.In(time.FixedZone("X", 3600))}, 0, true},
+		{"other date", CashFlow{Value: 100, Date: anchor.AddDate(0, 0, 1)}, 1, false},
+	}
+	for _, tc := range tests {
+		if got := a.Equal(tc.b, tc.tol); got != tc.want {
+			t.Errorf("%s: Equal got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestCashFlowsDedupe(t *testing.T) {
+	d2 := anchor.AddDate(0, 1, 0)
+	cfs := CashFlows{
+		{Value: 10, Date: d2},
+		{Value: 5, Date: anchor},
+		{Value: 10.001, Date: d2}, // duplicate of the first within tolerance
+		{Value: 7, Date: d2},      // same date, different value
+		{Value: 5, Date: anchor},  // exact duplicate
+	}
+	got := cfs.Dedupe(0.01)
+	want := CashFlows{cfs[0], cfs[1], cfs[3]}
+	if len(got) != len(want) {
+		t.Fatalf("Dedupe got %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i], 0) {
+			t.Errorf("Dedupe[%d] got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if len(cfs) != 5 {
+		t.Error("Dedupe modified the original slice")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// CashFlows.Hash & ValuationKey
+// -----------------------------------------------------------------------------
+func TestCashFlowsHash(t *testing.T) {
+	cfs := CashFlows{
+		{Value: -100, Date: anchor},
+		{Value: 0, Date: anchor.AddDate(0, 6, 0)},
+		{Value: 110, Date: anchor.AddDate(1, 0, 0)},
+	}
+	shuffled := CashFlows{
+		{Value: 110, Date: anchor.AddDate(1, 0, 0).In(time.FixedZone("X", 7200))},
+		{Value: math.Copysign(0, -1), Date: anchor.AddDate(0, 6, 0)},
+		{Value: -100, Date: anchor},
+	}
+	if cfs.Hash() != shuffled.Hash() {
+		t.Error("Hash depends on order, location, or sign of zero")
+	}
+
+	changed := slices.Clone(cfs)
+	changed[2].Value = 111
+	if cfs.Hash() == changed.Hash() {
+		t.Error("Hash did not change with a Value")
+	}
+
+	r := RateAnnualContinuous{Value: 0.05}
+	key := cfs.ValuationKey(r, anchor)
+	if key != shuffled.ValuationKey(r, anchor) {
+		t.Error("ValuationKey differs for the same flow set")
+	}
+	if key == cfs.ValuationKey(RateAnnualContinuous{Value: 0.06}, anchor) {
+		t.Error("ValuationKey did not change with the rate value")
+	}
+	if key == cfs.ValuationKey(RateEffective{Value: 0.05}, anchor) {
+		t.Error("ValuationKey did not change with the rate type")
+	}
+	if key == cfs.ValuationKey(r, anchor.AddDate(0, 0, 1)) {
+		t.Error("ValuationKey did not change with the valuation date")
+	}
+}
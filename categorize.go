@@ -0,0 +1,87 @@
+package gofinance
+
+import (
+	"regexp"
+	"slices"
+)
+
+// CategoryRule assigns Category to the transactions it matches.
+// A transaction matches when every condition that is set holds:
+// Description and Counterparty are matched by regular expression,
+// nil matching anything, and the Amount must lie within [MinAmount, MaxAmount],
+// unless both are zero. Use math.Inf for one-sided amount ranges.
+type CategoryRule struct {
+	Category     string
+	Priority     int
+	Description  *regexp.Regexp
+	Counterparty *regexp.Regexp
+	MinAmount    float64
+	MaxAmount    float64
+}
+
+// Matches reports whether the rule applies to the transaction.
+func (r CategoryRule) Matches(t Transaction) bool {
+	if r.Description != nil && !r.Description.MatchString(t.Description) {
+		return false
+	}
+	if r.Counterparty != nil && !r.Counterparty.MatchString(t.Counterparty) {
+		return false
+	}
+	if (r.MinAmount != 0 || r.MaxAmount != 0) && (t.Amount < r.MinAmount || t.Amount > r.MaxAmount) {
+		return false
+	}
+	return true
+}
+
+// Categorizer is a rule-based transaction classifier.
+// The matching rule with the highest Priority wins, ties going to the rule
+// listed first; transactions matched by no rule get Default.
+//
+//	c := Categorizer{
+//		Rules: []CategoryRule{
+//			{Category: "groceries", Counterparty: regexp.MustCompile(`(?i)market`)},
+//			{Category: "rent", Priority: 10, Description: regexp.MustCompile(`(?i)\brent\b`), MinAmount: math.Inf(-1)},
+//		},
+//		Default: "uncategorized",
+//	}
+//	categorized := c.Apply(imported)
+type Categorizer struct {
+	Rules   []CategoryRule
+	Default string
+}
+
+// Categorize returns the category of a single transaction.
+func (c Categorizer) Categorize(t Transaction) string {
+	best := -1
+	for i, r := range c.Rules {
+		if r.Matches(t) && (best < 0 || r.Priority > c.Rules[best].Priority) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return c.Default
+	}
+	return c.Rules[best].Category
+}
+
+// Apply returns a copy of the transactions with their Category assigned.
+// Transactions already carrying a Category, for example set by hand,
+// keep it.
+func (c Categorizer) Apply(ts Transactions) Transactions {
+	out := slices.Clone(ts)
+	for i := range out {
+		if out[i].Category == "" {
+			out[i].Category = c.Categorize(out[i])
+		}
+	}
+	return out
+}
+
+// ByCategory sums the transaction Amounts per Category.
+func (ts Transactions) ByCategory() map[string]float64 {
+	sums := make(map[string]float64)
+	for _, t := range ts {
+		sums[t.Category] += t.Amount
+	}
+	return sums
+}
@@ -0,0 +1,60 @@
+package gofinance
+
+import (
+	"math"
+	"regexp"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Categorizer
+// -----------------------------------------------------------------------------
+func TestCategorizer(t *testing.T) {
+	c := Categorizer{
+		Rules: []CategoryRule{
+			{Category: "groceries", Counterparty: regexp.MustCompile(`(?i)market`)},
+			{Category: "income", MinAmount: 0.01, MaxAmount: math.Inf(1)},
+			{Category: "rent", Priority: 10, Description: regexp.MustCompile(`(?i)\brent\b`)},
+			{Category: "big purchase", Priority: 5, MinAmount: math.Inf(-1), MaxAmount: -1000},
+			{Category: "shadowed", Counterparty: regexp.MustCompile(`(?i)market`)},
+		},
+		Default: "uncategorized",
+	}
+
+	tests := []struct {
+		name string
+		tx   Transaction
+		want string
+	}{
+		{"counterparty regex", Transaction{Amount: -30, Counterparty: "Super Market Ltd"}, "groceries"},
+		{"amount range", Transaction{Amount: 2500, Counterparty: "Employer"}, "income"},
+		{"priority beats order", Transaction{Amount: -1200, Description: "Rent March", Counterparty: "Landlord"}, "rent"},
+		{"amount range with priority", Transaction{Amount: -1500, Counterparty: "Market of cars"}, "big purchase"},
+		{"no rule", Transaction{Amount: -10, Counterparty: "Cafe"}, "uncategorized"},
+	}
+	for _, tt := range tests {
+		if got := c.Categorize(tt.tx); got != tt.want {
+			t.Errorf("%s: Categorize got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCategorizerApply(t *testing.T) {
+	c := Categorizer{Rules: []CategoryRule{{Category: "food", Description: regexp.MustCompile(`lunch`)}}}
+	ts := Transactions{
+		{Amount: -12, Description: "lunch"},
+		{Amount: -8, Description: "lunch", Category: "business"},
+		{Amount: -3, Description: "bus"},
+	}
+	got := c.Apply(ts)
+	if got[0].Category != "food" || got[1].Category != "business" || got[2].Category != "" {
+		t.Errorf("Apply got categories %q %q %q", got[0].Category, got[1].Category, got[2].Category)
+	}
+	if ts[0].Category != "" {
+		t.Error("Apply modified its input")
+	}
+	sums := got.ByCategory()
+	if sums["food"] != -12 || sums["business"] != -8 || sums[""] != -3 {
+		t.Errorf("ByCategory got %v", sums)
+	}
+}
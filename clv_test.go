@@ -0,0 +1,60 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Retention-curve CLV
+// -----------------------------------------------------------------------------
+func TestCLVRetention(t *testing.T) {
+	r := RateEffective{0.10, 1}
+	got := CLVRetention(100, []float64{0.8, 0.5}, 1, r)
+	want := 80/1.1 + 50/1.21
+	if !almostEq(got, want, 1e-9) {
+		t.Errorf("CLVRetention got %f, want %f", got, want)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// BG/NBD
+// -----------------------------------------------------------------------------
+func TestHypergeometric2F1(t *testing.T) {
+	// 2F1(1, 1; 2; z) = -ln(1 - z) / z
+	z := 0.7
+	if got := hypergeometric2F1(1, 1, 2, z); !almostEq(got, -math.Log(1-z)/z, 1e-12) {
+		t.Errorf("2F1 got %f", got)
+	}
+}
+
+func TestBGNBD(t *testing.T) {
+	// CDNOW example of Fader, Hardie and Lee (2005), time in weeks
+	m := BGNBD{R: 0.243, Alpha: 4.414, A: 0.793, B: 2.426}
+	if got := m.ConditionalExpectedTransactions(39, 2, 30.43, 38.86); !almostEq(got, 1.226, 1e-3) {
+		t.Errorf("conditional expectation got %f, want 1.226", got)
+	}
+	// no history is the unconditional expectation, which starts at rate R / Alpha
+	if got := m.ExpectedTransactions(1e-6) / 1e-6; !almostEq(got, m.R/m.Alpha, 1e-5) {
+		t.Errorf("initial purchase rate got %f, want %f", got, m.R/m.Alpha)
+	}
+}
+
+func TestBGNBDCLV(t *testing.T) {
+	m := BGNBD{R: 0.25, Alpha: 0.1, A: 1.5, B: 3}
+	flat := RateAnnualContinuous{0}
+	got, err := m.CLV(20, 3, 0.5, 1, 2, 12, flat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 20 * m.ConditionalExpectedTransactions(2, 3, 0.5, 1); !almostEq(got, want, 1e-9) {
+		t.Errorf("undiscounted CLV got %f, want %f", got, want)
+	}
+	discounted, _ := m.CLV(20, 3, 0.5, 1, 2, 12, RateAnnualContinuous{0.1})
+	if discounted >= got || discounted <= got*math.Exp(-0.2) {
+		t.Errorf("discounted CLV %f not within (%f, %f)", discounted, got*math.Exp(-0.2), got)
+	}
+	if _, err := (BGNBD{R: 1, Alpha: 1, A: 1, B: 1}).CLV(1, 0, 0, 0, 1, 12, flat); err == nil {
+		t.Error("A of 1 accepted")
+	}
+}
@@ -0,0 +1,325 @@
+// Command gofinance is a command-line front end to the gofinance library
+// for analysts who do not write Go.
+//
+// Usage:
+//
+//	gofinance npv -rate RATE [-date DATE] [FILE]
+//	gofinance irr [FILE]
+//	gofinance bondyield -coupon C -issue DATE -maturity DATE -settlement DATE -price P [flags]
+//	gofinance amortize -coupon C -issue DATE -maturity DATE -settlement DATE -price P [flags]
+//	gofinance convert-rate -to effective|apr|continuous [-periods N] RATE
+//
+// Cash flows are read from FILE, or standard input when FILE is omitted or -,
+// either as CSV rows of date,value with an optional header row, or as a JSON
+// array of {"date": ..., "value": ...} objects. Dates take any format accepted
+// by [gofinance.StringToTime] and rates any format accepted by
+// [gofinance.ParseRate], e.g. "5% apr monthly".
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chemerysov/gofinance"
+)
+
+// commands maps subcommand names to their implementations.
+var commands = map[string]func(args []string, stdin io.Reader, stdout, stderr io.Writer) error{
+	"npv":          runNPV,
+	"irr":          runIRR,
+	"bondyield":    runBondYield,
+	"amortize":     runAmortize,
+	"convert-rate": runConvertRate,
+}
+
+const usage = `usage: gofinance <command> [flags] [args]
+
+commands:
+  npv           net present value of cash flows at a rate
+  irr           internal rate of return of cash flows
+  bondyield     yield to maturity of a bond from its price
+  amortize      effective interest amortization schedule of a bond
+  convert-rate  convert a rate to another compounding convention
+
+run gofinance <command> -h for the flags of a command
+`
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(0)
+		}
+		fmt.Fprintln(os.Stderr, "gofinance:", err)
+		os.Exit(2)
+	}
+}
+
+// run dispatches args to a subcommand, reading input from stdin,
+// writing results to stdout and usage to stderr.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return errors.New("missing command")
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprint(stderr, usage)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+	return cmd(args[1:], stdin, stdout, stderr)
+}
+
+func runNPV(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("npv", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	rate := fs.String("rate", "", "discount rate, e.g. \"5% apr monthly\" (required)")
+	date := fs.String("date", "", "valuation date (default: date of the first cash flow)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *rate == "" {
+		return errors.New("npv requires -rate")
+	}
+	r, err := gofinance.ParseRate(*rate)
+	if err != nil {
+		return err
+	}
+	flows, err := readCashFlows(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	valDate := flows.SortedCopy()[0].Date
+	if *date != "" {
+		if valDate, err = gofinance.StringToTime(*date); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintln(stdout, formatFloat(flows.NPV(r, valDate)))
+	return err
+}
+
+func runIRR(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("irr", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	flows, err := readCashFlows(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	r, err := flows.IRR()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(stdout, formatFloat(r.RateAnnualEffective()))
+	return err
+}
+
+// bondFlags registers the flags describing a bond and a trade in it.
+// The returned function builds the bond and parses the settlement date
+// once fs has been parsed.
+func bondFlags(fs *flag.FlagSet) (build func() (gofinance.Bond, time.Time, float64, error)) {
+	face := fs.Float64("face", 100, "face value")
+	coupon := fs.Float64("coupon", 0, "annual coupon rate as a decimal, e.g. 0.05")
+	ppy := fs.Int("ppy", 2, "coupons per year")
+	issue := fs.String("issue", "", "issue date, from which the first coupon accrues (required)")
+	maturity := fs.String("maturity", "", "maturity date (required)")
+	settlement := fs.String("settlement", "", "settlement date (required)")
+	price := fs.Float64("price", 0, "dirty price (required)")
+	return func() (gofinance.Bond, time.Time, float64, error) {
+		if *issue == "" || *maturity == "" || *settlement == "" || *price == 0 {
+			return gofinance.Bond{}, time.Time{}, 0, fmt.Errorf("%s requires -issue, -maturity, -settlement, and -price", fs.Name())
+		}
+		settle, err := gofinance.StringToTime(*settlement)
+		if err != nil {
+			return gofinance.Bond{}, time.Time{}, 0, err
+		}
+		mat, err := gofinance.StringToTime(*maturity)
+		if err != nil {
+			return gofinance.Bond{}, time.Time{}, 0, err
+		}
+		iss, err := gofinance.StringToTime(*issue)
+		if err != nil {
+			return gofinance.Bond{}, time.Time{}, 0, err
+		}
+		bond := gofinance.Bond{Face: *face, CouponRate: *coupon, PeriodsPerYear: *ppy, Issue: iss, Maturity: mat}
+		return bond, settle, *price, nil
+	}
+}
+
+func runBondYield(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("bondyield", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	build := bondFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	bond, settlement, price, err := build()
+	if err != nil {
+		return err
+	}
+	yield, err := bond.YieldFromPrice(price, settlement)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(stdout, formatFloat(yield))
+	return err
+}
+
+func runAmortize(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("amortize", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	build := bondFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	bond, settlement, price, err := build()
+	if err != nil {
+		return err
+	}
+	rows, err := bond.Amortization(price, settlement)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(stdout)
+	w.Write([]string{"date", "coupon", "principal", "interest", "amortization", "carrying"})
+	for _, row := range rows {
+		w.Write([]string{
+			row.Date.Format(time.DateOnly),
+			formatFloat(row.Coupon),
+			formatFloat(row.Principal),
+			formatFloat(row.Interest),
+			formatFloat(row.Amortization),
+			formatFloat(row.Carrying),
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func runConvertRate(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("convert-rate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	to := fs.String("to", "effective", "target convention: effective, apr, or continuous")
+	periods := fs.Float64("periods", 1, "compounding periods per year of the target rate")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errors.New("convert-rate requires a rate argument")
+	}
+	r, err := gofinance.ParseRate(strings.Join(fs.Args(), " "))
+	if err != nil {
+		return err
+	}
+	var value float64
+	switch *to {
+	case "effective":
+		value = gofinance.ToEffective(r, *periods).Value
+	case "apr":
+		value = gofinance.ToAnnualPercentage(r, *periods).Value
+	case "continuous":
+		value = gofinance.ToContinuous(r).Value
+	default:
+		return fmt.Errorf("convert-rate: unknown convention %q", *to)
+	}
+	_, err = fmt.Fprintln(stdout, formatFloat(value))
+	return err
+}
+
+// readCashFlows reads cash flows from the file named in args,
+// or from stdin when args is empty or "-".
+func readCashFlows(args []string, stdin io.Reader) (gofinance.CashFlows, error) {
+	in := stdin
+	if len(args) > 1 {
+		return nil, errors.New("expected at most one input file")
+	}
+	if len(args) == 1 && args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		in = f
+	}
+	data, err := io.ReadAll(in)
+	if err != nil {
+		return nil, err
+	}
+	var flows gofinance.CashFlows
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		flows, err = parseJSON(trimmed)
+	} else {
+		flows, err = parseCSV(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(flows) == 0 {
+		return nil, errors.New("no cash flows in input")
+	}
+	return flows, nil
+}
+
+// parseJSON parses an array of {"date": ..., "value": ...} objects.
+func parseJSON(data []byte) (gofinance.CashFlows, error) {
+	var records []struct {
+		Date  string  `json:"date"`
+		Value float64 `json:"value"`
+	}
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+	flows := make(gofinance.CashFlows, len(records))
+	for i, rec := range records {
+		cf, err := gofinance.NewCashFlow(rec.Value, rec.Date)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", i+1, err)
+		}
+		flows[i] = cf
+	}
+	return flows, nil
+}
+
+// parseCSV parses rows of date,value, skipping a header row whose value
+// is not a number.
+func parseCSV(data []byte) (gofinance.CashFlows, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var flows gofinance.CashFlows
+	for i, rec := range records {
+		value, err := strconv.ParseFloat(rec[1], 64)
+		if err != nil {
+			if i == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		cf, err := gofinance.NewCashFlow(value, rec[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		flows = append(flows, cf)
+	}
+	return flows, nil
+}
+
+// formatFloat prints x with the fewest digits that read back exactly.
+func formatFloat(x float64) string {
+	return strconv.FormatFloat(x, 'f', -1, 64)
+}
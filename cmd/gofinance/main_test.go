@@ -0,0 +1,133 @@
+package main
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// runOutput runs a command line with the given stdin and returns its output.
+func runOutput(t *testing.T, stdin string, args ...string) string {
+	t.Helper()
+	var out, errOut strings.Builder
+	if err := run(args, strings.NewReader(stdin), &out, &errOut); err != nil {
+		t.Fatalf("run(%q): %v\n%s", args, err, errOut.String())
+	}
+	return strings.TrimSpace(out.String())
+}
+
+func outputFloat(t *testing.T, s string) float64 {
+	t.Helper()
+	x, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		t.Fatalf("output %q is not a number", s)
+	}
+	return x
+}
+
+func almostEq(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol
+}
+
+// -----------------------------------------------------------------------------
+// Cash-flow commands
+// -----------------------------------------------------------------------------
+
+func TestCashFlowCommands(t *testing.T) {
+	csvInput := "date,value\n2020-01-01,-100\n2021-01-01,110\n"
+	jsonInput := `[{"date": "2020-01-01", "value": -100}, {"date": "2021-01-01", "value": 110}]`
+
+	for _, input := range []string{csvInput, jsonInput} {
+		if irr := outputFloat(t, runOutput(t, input, "irr")); !almostEq(irr, 0.10, 1e-9) {
+			t.Errorf("irr = %v, want 0.10", irr)
+		}
+		if npv := outputFloat(t, runOutput(t, input, "npv", "-rate", "10%")); !almostEq(npv, 0, 1e-9) {
+			t.Errorf("npv = %v, want 0", npv)
+		}
+		npv := outputFloat(t, runOutput(t, input, "npv", "-rate", "0", "-date", "2021-01-01"))
+		if !almostEq(npv, 10, 1e-9) {
+			t.Errorf("npv at 0%% = %v, want 10", npv)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Bond and rate commands
+// -----------------------------------------------------------------------------
+
+func TestBondCommands(t *testing.T) {
+	bond := []string{"-coupon", "0.05", "-issue", "2020-01-01", "-maturity", "2022-01-01", "-settlement", "2020-01-01"}
+
+	yield := outputFloat(t, runOutput(t, "", append([]string{"bondyield", "-price", "100"}, bond...)...))
+	if !almostEq(yield, 0.05, 1e-4) {
+		t.Errorf("bondyield at par = %v, want about 0.05", yield)
+	}
+
+	lines := strings.Split(runOutput(t, "", append([]string{"amortize", "-price", "102"}, bond...)...), "\n")
+	if len(lines) != 5 || lines[0] != "date,coupon,principal,interest,amortization,carrying" {
+		t.Fatalf("amortize output:\n%s", strings.Join(lines, "\n"))
+	}
+	last := strings.Split(lines[4], ",")
+	if last[0] != "2022-01-01" || last[2] != "100" || !almostEq(outputFloat(t, last[5]), 0, 1e-9) {
+		t.Errorf("last amortization row = %q", lines[4])
+	}
+}
+
+func TestConvertRate(t *testing.T) {
+	tests := []struct {
+		args []string
+		want float64
+	}{
+		{[]string{"-to", "effective", "12%", "apr", "monthly"}, 0.12682503013196977},
+		{[]string{"-to", "apr", "-periods", "12", "5%"}, 12 * (math.Pow(1.05, 1.0/12) - 1)},
+		{[]string{"-to", "continuous", "0"}, 0},
+	}
+	for _, tt := range tests {
+		got := outputFloat(t, runOutput(t, "", append([]string{"convert-rate"}, tt.args...)...))
+		if !almostEq(got, tt.want, 1e-12) {
+			t.Errorf("convert-rate %q = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	tests := [][]string{
+		{},
+		{"unknown"},
+		{"npv"},
+		{"bondyield", "-price", "100"},
+		{"bondyield", "-coupon", "0.05", "-maturity", "2022-01-01", "-settlement", "2020-01-01", "-price", "100"},
+		{"convert-rate", "-to", "simple", "5%"},
+		{"convert-rate", "5% fortnightly"},
+	}
+	for _, args := range tests {
+		if err := run(args, strings.NewReader("2020-01-01,-100\n"), &strings.Builder{}, &strings.Builder{}); err == nil {
+			t.Errorf("run(%q) succeeded, want an error", args)
+		}
+	}
+	if err := run([]string{"irr"}, strings.NewReader("date,value\n2020-01-01,abc\n"), &strings.Builder{}, &strings.Builder{}); err == nil {
+		t.Error("irr accepted a non-numeric value")
+	}
+}
+
+func TestRunStderr(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{}, "usage: gofinance"},
+		{[]string{"unknown"}, "usage: gofinance"},
+		{[]string{"npv", "-bogus"}, "flag provided but not defined: -bogus"},
+		{[]string{"bondyield", "-h"}, "-issue"},
+	}
+	for _, tt := range tests {
+		var out, errOut strings.Builder
+		if err := run(tt.args, strings.NewReader(""), &out, &errOut); err == nil {
+			t.Errorf("run(%q) succeeded, want an error", tt.args)
+		}
+		if !strings.Contains(errOut.String(), tt.want) || out.Len() != 0 {
+			t.Errorf("run(%q) wrote %q to stderr and %q to stdout, want %q on stderr", tt.args, errOut.String(), out.String(), tt.want)
+		}
+	}
+}
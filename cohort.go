@@ -0,0 +1,131 @@
+package gofinance
+
+import (
+	"slices"
+	"time"
+)
+
+// Cohort is the group of customers first seen in the calendar month
+// starting at Start (UTC). Active[k] counts the customers with a purchase in
+// month k after Start, month 0 being the acquisition month, and Revenue[k]
+// sums their purchases in that month.
+type Cohort struct {
+	Start     time.Time
+	Customers []string
+	Active    []int
+	Revenue   []float64
+}
+
+// monthIndex counts calendar months since year 0, in UTC.
+func monthIndex(t time.Time) int {
+	t = t.UTC()
+	return t.Year()*12 + int(t.Month()) - 1
+}
+
+// Cohorts buckets customers, identified by Counterparty, into cohorts by the
+// month of their first purchase, a purchase being a transaction with positive
+// Amount. Every cohort runs to the last month with a purchase in ts.
+// Cohorts are returned in order of Start, customers sorted by name.
+func Cohorts(ts Transactions) []Cohort {
+	first := make(map[string]int)
+	last := 0
+	for _, t := range ts {
+		if t.Amount <= 0 {
+			continue
+		}
+		m := monthIndex(t.Date)
+		if f, ok := first[t.Counterparty]; !ok || m < f {
+			first[t.Counterparty] = m
+		}
+		last = max(last, m)
+	}
+
+	byMonth := make(map[int]*Cohort)
+	for customer, m := range first {
+		c, ok := byMonth[m]
+		if !ok {
+			c = &Cohort{
+				Start:   time.Date(m/12, time.Month(m%12+1), 1, 0, 0, 0, 0, time.UTC),
+				Active:  make([]int, last-m+1),
+				Revenue: make([]float64, last-m+1),
+			}
+			byMonth[m] = c
+		}
+		c.Customers = append(c.Customers, customer)
+	}
+
+	type seen struct {
+		customer string
+		month    int
+	}
+	active := make(map[seen]bool)
+	for _, t := range ts {
+		if t.Amount <= 0 {
+			continue
+		}
+		f := first[t.Counterparty]
+		k := monthIndex(t.Date) - f
+		c := byMonth[f]
+		c.Revenue[k] += t.Amount
+		if s := (seen{t.Counterparty, k}); !active[s] {
+			active[s] = true
+			c.Active[k]++
+		}
+	}
+
+	cohorts := make([]Cohort, 0, len(byMonth))
+	for _, c := range byMonth {
+		slices.Sort(c.Customers)
+		cohorts = append(cohorts, *c)
+	}
+	slices.SortFunc(cohorts, func(a, b Cohort) int { return a.Start.Compare(b.Start) })
+	return cohorts
+}
+
+// Retention returns the fraction of the cohort's customers active in each
+// month since acquisition, for use with [CLVRetention].
+func (c Cohort) Retention() []float64 {
+	r := make([]float64, len(c.Active))
+	for k, a := range c.Active {
+		r[k] = float64(a) / float64(len(c.Customers))
+	}
+	return r
+}
+
+// CumulativeRevenue returns the cohort's revenue accumulated to the end of
+// each month since acquisition.
+func (c Cohort) CumulativeRevenue() []float64 {
+	cum := make([]float64, len(c.Revenue))
+	sum := 0.0
+	for k, rev := range c.Revenue {
+		sum += rev
+		cum[k] = sum
+	}
+	return cum
+}
+
+// AcquisitionCohort prepares the cohort for unit economics,
+// given its total acquisition cost and contribution margin.
+func (c Cohort) AcquisitionCohort(cac, contributionMargin float64) AcquisitionCohort {
+	return AcquisitionCohort{c.Start, cac, slices.Clone(c.Revenue), contributionMargin}
+}
+
+// RetentionMatrix returns the [Cohort.Retention] of every cohort,
+// one row per cohort, rows shortening as cohorts get younger.
+func RetentionMatrix(cohorts []Cohort) [][]float64 {
+	m := make([][]float64, len(cohorts))
+	for i, c := range cohorts {
+		m[i] = c.Retention()
+	}
+	return m
+}
+
+// CumulativeRevenueMatrix returns the [Cohort.CumulativeRevenue] of every
+// cohort, one row per cohort.
+func CumulativeRevenueMatrix(cohorts []Cohort) [][]float64 {
+	m := make([][]float64, len(cohorts))
+	for i, c := range cohorts {
+		m[i] = c.CumulativeRevenue()
+	}
+	return m
+}
@@ -0,0 +1,49 @@
+package gofinance
+
+import (
+	"slices"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Cohorts
+// -----------------------------------------------------------------------------
+func TestCohorts(t *testing.T) {
+	tx := func(m, d int, customer string, amount float64) Transaction {
+		return Transaction{Date: anchor.AddDate(0, m, d), Amount: amount, Counterparty: customer}
+	}
+	ts := Transactions{
+		tx(0, 3, "a", 10), tx(0, 20, "a", 5), tx(1, 3, "a", 10), tx(2, 3, "a", 10),
+		tx(0, 9, "b", 20), tx(2, 9, "b", 20),
+		tx(1, 15, "c", 30), tx(2, 15, "c", 30),
+		tx(1, 1, "refund", -50),
+	}
+	cohorts := Cohorts(ts)
+	if len(cohorts) != 2 {
+		t.Fatalf("Cohorts got %d cohorts, want 2", len(cohorts))
+	}
+	jan, feb := cohorts[0], cohorts[1]
+	if !jan.Start.Equal(anchor) || !slices.Equal(jan.Customers, []string{"a", "b"}) {
+		t.Errorf("January cohort got %v %v", jan.Start, jan.Customers)
+	}
+	if !slices.Equal(jan.Active, []int{2, 1, 2}) || !slices.Equal(jan.Revenue, []float64{35, 10, 30}) {
+		t.Errorf("January cohort got active %v revenue %v", jan.Active, jan.Revenue)
+	}
+	if !slices.Equal(feb.Customers, []string{"c"}) || !slices.Equal(feb.Active, []int{1, 1}) {
+		t.Errorf("February cohort got %v %v", feb.Customers, feb.Active)
+	}
+
+	retention := RetentionMatrix(cohorts)
+	if !slices.Equal(retention[0], []float64{1, 0.5, 1}) || !slices.Equal(retention[1], []float64{1, 1}) {
+		t.Errorf("RetentionMatrix got %v", retention)
+	}
+	revenue := CumulativeRevenueMatrix(cohorts)
+	if !slices.Equal(revenue[0], []float64{35, 45, 75}) || !slices.Equal(revenue[1], []float64{30, 60}) {
+		t.Errorf("CumulativeRevenueMatrix got %v", revenue)
+	}
+
+	ac := jan.AcquisitionCohort(35, 0.5)
+	if m, ok := ac.PaybackMonth(); !ok || m != 3 {
+		t.Errorf("AcquisitionCohort payback got %d, %v, want 3", m, ok)
+	}
+}
@@ -0,0 +1,123 @@
+package gofinance
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"time"
+)
+
+// CollateralAsset is an asset eligible for posting under a [CSA].
+// Available is the market value that can be posted and Haircut the fraction
+// of market value the CSA schedule disregards, for example 0.02 for 2%.
+// FundingRate is the simple annual cost of funding the asset or of giving
+// up its alternative use, Remuneration the simple annual rate the CSA pays
+// on it while posted, for example the overnight rate on cash.
+type CollateralAsset struct {
+	Name         string
+	Available    float64
+	Haircut      float64
+	FundingRate  float64
+	Remuneration float64
+}
+
+// costPerUnit returns the annual cost of the asset per unit of collateral
+// value, the ordering of cheapest to deliver.
+// Math details:
+//
+// CostPerUnit = (FundingRate - Remuneration) / (1 - Haircut)
+func (a CollateralAsset) costPerUnit() float64 {
+	return (a.FundingRate - a.Remuneration) / (1 - a.Haircut)
+}
+
+// CSA is the collateral schedule of a credit support annex: the assets
+// Eligible for posting and the Threshold of exposure left uncollateralised.
+type CSA struct {
+	Threshold float64
+	Eligible  []CollateralAsset
+}
+
+// CollateralPosting is the part of an asset selected for posting: its
+// MarketValue, the CollateralValue after haircut, and the AnnualCost of
+// keeping it posted.
+type CollateralPosting struct {
+	Asset           string
+	MarketValue     float64
+	CollateralValue float64
+	AnnualCost      float64
+}
+
+// CollateralAllocation is the cheapest-to-deliver selection for an exposure:
+// the Requirement after threshold, the Postings covering it,
+// and their total AnnualCost.
+type CollateralAllocation struct {
+	Requirement float64
+	Postings    []CollateralPosting
+	AnnualCost  float64
+}
+
+// SelectCollateral covers the exposure above the threshold with the
+// cheapest-to-deliver eligible assets. Assets are taken in order of cost per
+// unit of collateral value until the requirement is met, which is optimal
+// as the only constraint is the amount each asset has available.
+// It errors if the eligible assets cannot cover the requirement.
+// Math details:
+//
+// Requirement = max(Exposure - Threshold, 0)
+//
+// MarketValue_i = CollateralValue_i / (1 - Haircut_i)
+//
+// AnnualCost_i = MarketValue_i * (FundingRate_i - Remuneration_i)
+func (c CSA) SelectCollateral(exposure float64) (CollateralAllocation, error) {
+	alloc := CollateralAllocation{Requirement: max(exposure-c.Threshold, 0)}
+	assets := slices.Clone(c.Eligible)
+	for _, a := range assets {
+		if a.Haircut < 0 || a.Haircut >= 1 || a.Available < 0 {
+			return CollateralAllocation{}, errors.New("CSA.SelectCollateral requires haircuts in [0, 1) and non-negative availability")
+		}
+	}
+	slices.SortStableFunc(assets, func(a, b CollateralAsset) int { return cmp.Compare(a.costPerUnit(), b.costPerUnit()) })
+
+	remaining := alloc.Requirement
+	for _, a := range assets {
+		if remaining <= 0 {
+			break
+		}
+		value := min(remaining, a.Available*(1-a.Haircut))
+		if value <= 0 {
+			continue
+		}
+		mv := value / (1 - a.Haircut)
+		cost := mv * (a.FundingRate - a.Remuneration)
+		alloc.Postings = append(alloc.Postings, CollateralPosting{a.Name, mv, value, cost})
+		alloc.AnnualCost += cost
+		remaining -= value
+	}
+	if remaining > 1e-9*max(alloc.Requirement, 1) {
+		return CollateralAllocation{}, errors.New("CSA.SelectCollateral: eligible assets do not cover the requirement")
+	}
+	return alloc, nil
+}
+
+// ColVA returns the collateral valuation adjustment of an expected exposure
+// profile: the discounted cost of posting the cheapest-to-deliver collateral.
+// The exposure at each date of the profile is collateralised over the period
+// since the previous date, or since valuationDate for the first.
+// The adjustment is negative when collateral costs more than it earns.
+// Math details:
+//
+// ColVA = -\sum_i AnnualCost(Exposure_i) * (t_i - t_{i-1}) * DiscountFactor(t_i)
+func (c CSA) ColVA(profile TimeSeries, curve YieldCurve, valuationDate time.Time) (float64, error) {
+	costs := make(TimeSeries, 0, len(profile))
+	for _, o := range profile {
+		if !o.Date.After(valuationDate) {
+			continue
+		}
+		alloc, err := c.SelectCollateral(o.Value)
+		if err != nil {
+			return 0, err
+		}
+		costs = append(costs, Observation{o.Date, alloc.AnnualCost})
+	}
+	return -discountedIntegral(costs, curve, valuationDate), nil
+}
@@ -0,0 +1,69 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// CSA
+// -----------------------------------------------------------------------------
+func testCSA() CSA {
+	return CSA{
+		Threshold: 100,
+		Eligible: []CollateralAsset{
+			{"cash", 2000, 0, 0.05, 0.03},
+			{"equity", 5000, 0.15, 0.04, 0},
+			{"gilt", 500, 0.02, 0.01, 0},
+		},
+	}
+}
+
+func TestCSASelectCollateral(t *testing.T) {
+	alloc, err := testCSA().SelectCollateral(1100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// gilt is cheapest at 0.0102 per unit, then cash at 0.02, then equity at 0.047
+	want := []CollateralPosting{
+		{"gilt", 500, 490, 5},
+		{"cash", 510, 510, 10.2},
+	}
+	if alloc.Requirement != 1000 || len(alloc.Postings) != len(want) {
+		t.Fatalf("SelectCollateral got %+v", alloc)
+	}
+	for i, p := range alloc.Postings {
+		w := want[i]
+		if p.Asset != w.Asset || !almostEq(p.MarketValue, w.MarketValue, 1e-9) ||
+			!almostEq(p.CollateralValue, w.CollateralValue, 1e-9) || !almostEq(p.AnnualCost, w.AnnualCost, 1e-9) {
+			t.Errorf("posting %d got %+v, want %+v", i, p, w)
+		}
+	}
+	if !almostEq(alloc.AnnualCost, 15.2, 1e-9) {
+		t.Errorf("AnnualCost got %f, want 15.2", alloc.AnnualCost)
+	}
+
+	below, err := testCSA().SelectCollateral(50)
+	if err != nil || below.Requirement != 0 || len(below.Postings) != 0 {
+		t.Errorf("exposure below threshold got %+v, %v", below, err)
+	}
+	if _, err := testCSA().SelectCollateral(1e6); err == nil {
+		t.Error("SelectCollateral covered a requirement beyond the eligible assets")
+	}
+}
+
+func TestCSAColVA(t *testing.T) {
+	curve := RateAnnualContinuous{0.02}
+	profile := TimeSeries{
+		{anchor.AddDate(1, 0, 0), 1100},
+		{anchor.AddDate(0, 6, 0), 100},
+		{anchor.AddDate(-1, 0, 0), 1e9}, // before valuation, ignored
+	}
+	got, err := testCSA().ColVA(profile, curve, anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t1 := yearsBetween(anchor, anchor.AddDate(1, 0, 0))
+	dt := yearsBetween(anchor.AddDate(0, 6, 0), anchor.AddDate(1, 0, 0))
+	want := -15.2 * dt * curve.DiscountFactor(t1)
+	if !almostEq(got, want, 1e-9) {
+		t.Errorf("ColVA got %f, want %f", got, want)
+	}
+}
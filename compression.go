@@ -0,0 +1,151 @@
+package gofinance
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+)
+
+// CompressionProposal is a proposed compression of a swap portfolio:
+// the Trades left afterwards, the gross notional and the DV01 before and
+// after, and the change in NPV to be settled in cash between the parties
+// so that compression leaves them no better or worse off.
+type CompressionProposal struct {
+	Trades      []Swap
+	GrossBefore float64
+	GrossAfter  float64
+	DV01Before  float64
+	DV01After   float64
+	NPVChange   float64
+}
+
+// compressionKey identifies swaps that net exactly: the same terms
+// apart from Notional and side.
+type compressionKey struct {
+	fixedRate, spread          float64
+	start, end                 time.Time
+	fixedPeriods, floatPeriods int
+}
+
+// signedNotional is positive for the payer of the fixed leg.
+func signedNotional(s Swap) float64 {
+	if s.PayFixed {
+		return s.Notional
+	}
+	return -s.Notional
+}
+
+// withSignedNotional sets side and Notional from a signed notional.
+func withSignedNotional(s Swap, n float64) Swap {
+	s.Notional, s.PayFixed = math.Abs(n), n > 0
+	return s
+}
+
+// swapsValue sums NPV and DV01 over a portfolio.
+func swapsValue(swaps []Swap, curve YieldCurve, valuationDate time.Time) (npv, dv01 float64, err error) {
+	for _, s := range swaps {
+		v, err := s.NPV(curve, valuationDate)
+		if err != nil {
+			return 0, 0, err
+		}
+		d, err := s.DV01(curve, valuationDate)
+		if err != nil {
+			return 0, 0, err
+		}
+		npv, dv01 = npv+v, dv01+d
+	}
+	return npv, dv01, nil
+}
+
+// ProposeCompression proposes how to reduce the gross notional of a swap
+// portfolio while keeping its DV01 within dv01Tolerance of the original.
+// First, swaps with identical terms are netted into one, which leaves all
+// risk unchanged. Then pairs of opposite swaps are torn up partially or
+// fully, most similar DV01 per unit of notional first, as long as the
+// accumulated DV01 change stays within tolerance.
+// NPV and DV01 are those of the portfolio holder, each swap valued for its side.
+func ProposeCompression(swaps []Swap, curve YieldCurve, valuationDate time.Time, dv01Tolerance float64) (CompressionProposal, error) {
+	var p CompressionProposal
+	npvBefore, dv01Before, err := swapsValue(swaps, curve, valuationDate)
+	if err != nil {
+		return p, err
+	}
+	for _, s := range swaps {
+		p.GrossBefore += s.Notional
+	}
+	p.DV01Before = dv01Before
+
+	// exact netting
+	var netted []Swap
+	index := make(map[compressionKey]int)
+	for _, s := range swaps {
+		k := compressionKey{s.FixedRate, s.Spread, s.Start.UTC(), s.End.UTC(), s.FixedPeriodsPerYear, s.FloatPeriodsPerYear}
+		if i, ok := index[k]; ok {
+			netted[i] = withSignedNotional(netted[i], signedNotional(netted[i])+signedNotional(s))
+			continue
+		}
+		index[k] = len(netted)
+		netted = append(netted, s)
+	}
+
+	// greedy tear-ups of opposite pairs
+	notional := make([]float64, len(netted))
+	unit := make([]float64, len(netted)) // DV01 per unit of fixed payer notional
+	for i, s := range netted {
+		notional[i] = signedNotional(s)
+		u, err := withSignedNotional(s, 1).DV01(curve, valuationDate)
+		if err != nil {
+			return p, err
+		}
+		unit[i] = u
+	}
+	type pair struct {
+		i, j int
+		w    float64
+	}
+	var pairs []pair
+	for i := range netted {
+		for j := i + 1; j < len(netted); j++ {
+			if notional[i]*notional[j] < 0 {
+				pairs = append(pairs, pair{i, j, 0})
+			}
+		}
+	}
+	// tearing up a of each side changes DV01 by -a * w
+	for k := range pairs {
+		pi, pj := pairs[k].i, pairs[k].j
+		pairs[k].w = math.Copysign(1, notional[pi])*unit[pi] + math.Copysign(1, notional[pj])*unit[pj]
+	}
+	slices.SortStableFunc(pairs, func(a, b pair) int { return cmp.Compare(math.Abs(a.w), math.Abs(b.w)) })
+	drift := 0.0
+	for _, pr := range pairs {
+		a := math.Min(math.Abs(notional[pr.i]), math.Abs(notional[pr.j]))
+		switch {
+		case pr.w > 0:
+			a = math.Min(a, (drift+dv01Tolerance)/pr.w)
+		case pr.w < 0:
+			a = math.Min(a, (dv01Tolerance-drift)/-pr.w)
+		}
+		if a <= 0 {
+			continue
+		}
+		notional[pr.i] -= math.Copysign(a, notional[pr.i])
+		notional[pr.j] -= math.Copysign(a, notional[pr.j])
+		drift -= a * pr.w
+	}
+
+	for i, s := range netted {
+		if math.Abs(notional[i]) > 1e-9*p.GrossBefore {
+			p.Trades = append(p.Trades, withSignedNotional(s, notional[i]))
+			p.GrossAfter += math.Abs(notional[i])
+		}
+	}
+	npvAfter, dv01After, err := swapsValue(p.Trades, curve, valuationDate)
+	if err != nil {
+		return p, err
+	}
+	p.DV01After = dv01After
+	p.NPVChange = npvAfter - npvBefore
+	return p, nil
+}
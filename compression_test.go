@@ -0,0 +1,72 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Swap compression
+// -----------------------------------------------------------------------------
+func TestProposeCompressionExact(t *testing.T) {
+	curve := RateAnnualContinuous{0.03}
+	base := Swap{FixedRate: 0.03, Start: anchor, End: anchor.AddDate(5, 0, 0), FixedPeriodsPerYear: 1, FloatPeriodsPerYear: 4}
+	pay, receive, receive2 := base, base, base
+	pay.Notional, pay.PayFixed = 100, true
+	receive.Notional = 60
+	receive2.Notional = 40
+
+	p, err := ProposeCompression([]Swap{pay, receive, receive2}, curve, anchor, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Trades) != 0 || p.GrossBefore != 200 || p.GrossAfter != 0 {
+		t.Errorf("fully offsetting trades got %+v", p)
+	}
+	if !almostEq(p.NPVChange, 0, 1e-9) || !almostEq(p.DV01After, p.DV01Before, 1e-12) {
+		t.Errorf("exact netting changed risk: %+v", p)
+	}
+}
+
+func TestProposeCompressionTolerance(t *testing.T) {
+	curve := RateAnnualContinuous{0.03}
+	five := Swap{Notional: 100, FixedRate: 0.03, Start: anchor, End: anchor.AddDate(5, 0, 0), FixedPeriodsPerYear: 1, FloatPeriodsPerYear: 4, PayFixed: true}
+	six := five
+	six.End, six.PayFixed, six.FixedRate = anchor.AddDate(6, 0, 0), false, 0.031
+	ten := six
+	ten.End = anchor.AddDate(10, 0, 0)
+	swaps := []Swap{five, six, ten}
+
+	// no tolerance: different maturities cannot be torn up
+	p, err := ProposeCompression(swaps, curve, anchor, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !almostEq(p.GrossAfter, 300, 1e-9) {
+		t.Errorf("zero tolerance compressed to %f", p.GrossAfter)
+	}
+
+	// ample tolerance: the five year payer offsets the closer six year
+	// receiver rather than the ten year one
+	p, err = ProposeCompression(swaps, curve, anchor, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Trades) != 1 || !p.Trades[0].End.Equal(ten.End) || !almostEq(p.GrossAfter, 100, 1e-9) {
+		t.Errorf("compression got %+v", p.Trades)
+	}
+	if math.Abs(p.DV01After-p.DV01Before) > 1 {
+		t.Errorf("DV01 moved by %f, tolerance 1", p.DV01After-p.DV01Before)
+	}
+	npvBefore, _, _ := swapsValue(swaps, curve, anchor)
+	npvAfter, _, _ := swapsValue(p.Trades, curve, anchor)
+	if !almostEq(p.NPVChange, npvAfter-npvBefore, 1e-9) {
+		t.Errorf("NPVChange got %f, want %f", p.NPVChange, npvAfter-npvBefore)
+	}
+
+	// tight tolerance: partial tear-up, DV01 change exactly at the limit
+	p, _ = ProposeCompression(swaps, curve, anchor, 0.002)
+	if !(p.GrossAfter > 100 && p.GrossAfter < 300) || !almostEq(math.Abs(p.DV01After-p.DV01Before), 0.002, 1e-9) {
+		t.Errorf("partial compression got gross %f, DV01 change %f", p.GrossAfter, p.DV01After-p.DV01Before)
+	}
+}
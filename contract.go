@@ -0,0 +1,117 @@
+package gofinance
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// The Check functions below verify the contracts the package relies on
+// when it takes a [Rate] or a [YieldCurve], so that other implementations
+// can test themselves against them:
+//
+//	func TestMyCurve(t *testing.T) {
+//		years := []float64{0.25, 1, 5, 30}
+//		if err := gofinance.CheckDiscountFactor(myCurve, years); err != nil {
+//			t.Error(err)
+//		}
+//	}
+//
+// Each returns nil or an error wrapping [ErrContractViolation] that names
+// the first violation found. Values are compared to a relative tolerance
+// of 1e-12.
+
+// contractTolerance is the relative tolerance of the Check functions.
+const contractTolerance = 1e-12
+
+// violation returns an error wrapping [ErrContractViolation].
+func violation(format string, args ...any) error {
+	return fmt.Errorf("%w: "+format, append([]any{ErrContractViolation}, args...)...)
+}
+
+// CheckDiscountFactor checks that a curve discounts nothing at time zero
+// and returns a finite positive discount factor at each of years.
+func CheckDiscountFactor(curve YieldCurve, years []float64) error {
+	if df := curve.DiscountFactor(0); !almostEq(df, 1, contractTolerance) {
+		return violation("DiscountFactor(0) = %g, want 1", df)
+	}
+	for _, t := range years {
+		if df := curve.DiscountFactor(t); !(df > 0) || math.IsInf(df, 0) {
+			return violation("DiscountFactor(%g) = %g, want finite and positive", t, df)
+		}
+	}
+	return nil
+}
+
+// CheckDiscountMonotone checks that discount factors do not rise with
+// maturity over years, which holds when forward rates are not negative.
+func CheckDiscountMonotone(curve YieldCurve, years []float64) error {
+	sorted := slices.Clone(years)
+	slices.Sort(sorted)
+	for i := 1; i < len(sorted); i++ {
+		prev, next := curve.DiscountFactor(sorted[i-1]), curve.DiscountFactor(sorted[i])
+		if next > prev*(1+contractTolerance) {
+			return violation("DiscountFactor(%g) = %g exceeds DiscountFactor(%g) = %g", sorted[i], next, sorted[i-1], prev)
+		}
+	}
+	return nil
+}
+
+// CheckRoundTrip checks that compounding the present value of an amount
+// back over each of years returns the amount, so that past cash-flows are
+// compounded at the rate future ones are discounted at, as
+// [CashFlow.PresentValue] assumes. Compounding rates and flat curves
+// satisfy it; simple and bank discount rates, which are quoted for a
+// single period, do not.
+// Math details:
+//
+// DiscountFactor(Years) * DiscountFactor(-Years) = 1
+func CheckRoundTrip(curve YieldCurve, years []float64) error {
+	for _, t := range years {
+		if got := curve.DiscountFactor(t) * curve.DiscountFactor(-t); !almostEq(got, 1, contractTolerance) {
+			return violation("DiscountFactor(%g) * DiscountFactor(%g) = %g, want 1", t, -t, got)
+		}
+	}
+	return nil
+}
+
+// CheckRateConsistency checks that a rate's annual effective and
+// continuous equivalents discount one year as the rate itself does.
+// Math details:
+//
+// DiscountFactor(1) = 1 / (1 + EffectiveAnnualRate) = e^{-ContinuousRate}
+func CheckRateConsistency(r Rate) error {
+	df := r.DiscountFactor(1)
+	if want := 1 / (1 + r.RateAnnualEffective()); !almostEq(df, want, contractTolerance) {
+		return violation("DiscountFactor(1) = %g, want %g from RateAnnualEffective", df, want)
+	}
+	if want := math.Exp(-r.RateAnnualContinuous()); !almostEq(df, want, contractTolerance) {
+		return violation("DiscountFactor(1) = %g, want %g from RateAnnualContinuous", df, want)
+	}
+	return nil
+}
+
+// CheckNPVLinearity checks that valuing with the curve is linear:
+// scaling cash-flows scales their value, and the value of two streams
+// together is the sum of their values. It catches curves whose discount
+// factors depend on earlier calls, e.g. through a faulty cache.
+// Math details:
+//
+// NPV(k * A) = k * NPV(A),   NPV(A ∪ B) = NPV(A) + NPV(B)
+func CheckNPVLinearity(curve YieldCurve, a, b CashFlows, valuationDate time.Time) error {
+	npvA, npvB := a.NPVCurve(curve, valuationDate), b.NPVCurve(curve, valuationDate)
+	scaled := slices.Clone(a)
+	for i := range scaled {
+		scaled[i].Value *= -2.5
+	}
+	if got := scaled.NPVCurve(curve, valuationDate); !almostEq(got, -2.5*npvA, contractTolerance) {
+		return violation("NPV of scaled cash-flows = %g, want %g", got, -2.5*npvA)
+	}
+	// the tolerance allows for cancellation between the two streams
+	both := slices.Concat(a, b)
+	if got := both.NPVCurve(curve, valuationDate); !almostEq(got, npvA+npvB, contractTolerance*max(1, math.Abs(npvA)+math.Abs(npvB))) {
+		return violation("NPV of combined cash-flows = %g, want %g", got, npvA+npvB)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package gofinance
+
+import (
+	"errors"
+	"testing"
+)
+
+// contractYears is the maturity grid the package's own types are checked on.
+var contractYears = []float64{0.01, 0.25, 0.5, 1, 2, 5, 10, 30}
+
+// -----------------------------------------------------------------------------
+// Rates
+// -----------------------------------------------------------------------------
+func TestRateContracts(t *testing.T) {
+	compounding := []Rate{
+		RateAnnualPercentage{0.05, 12},
+		RateEffective{0.004, 12},
+		RateAnnualContinuous{0.03},
+		RateReal{0.01},
+		RateAnnualPercentage{-0.005, 1},
+	}
+	moneyMarket := []Rate{
+		RateSimple{0.05, 360},
+		RateBankDiscount{0.02, 360},
+	}
+	for _, r := range append(compounding, moneyMarket...) {
+		if err := CheckDiscountFactor(r, contractYears); err != nil {
+			t.Errorf("%#v: %v", r, err)
+		}
+		if err := CheckRateConsistency(r); err != nil {
+			t.Errorf("%#v: %v", r, err)
+		}
+		if err := CheckNPVLinearity(r, CashFlows{{-100, anchor}, {60, anchor.AddDate(1, 0, 0)}}, CashFlows{{70, anchor.AddDate(2, 6, 0)}}, anchor.AddDate(0, 3, 0)); err != nil {
+			t.Errorf("%#v: %v", r, err)
+		}
+	}
+	for _, r := range compounding {
+		if err := CheckRoundTrip(r, contractYears); err != nil {
+			t.Errorf("%#v: %v", r, err)
+		}
+	}
+	for _, r := range compounding[:4] {
+		if err := CheckDiscountMonotone(r, contractYears); err != nil {
+			t.Errorf("%#v: %v", r, err)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Curves
+// -----------------------------------------------------------------------------
+func TestCurveContracts(t *testing.T) {
+	zero := YieldCurveZero{[]float64{1, 5, 10}, []float64{0.02, 0.03, 0.035}}
+	curves := []YieldCurve{
+		zero,
+		YieldCurveShifted{zero, 0.01},
+		YieldCurveShocked{zero, ShockSteepener(0.01, 0.02)},
+		Vasicek{Kappa: 0.3, Theta: 0.04, Sigma: 0.01, R0: 0.02},
+		CIR{Kappa: 0.3, Theta: 0.04, Sigma: 0.05, R0: 0.02},
+	}
+	for _, c := range curves {
+		if err := CheckDiscountFactor(c, contractYears); err != nil {
+			t.Errorf("%#v: %v", c, err)
+		}
+		if err := CheckDiscountMonotone(c, contractYears); err != nil {
+			t.Errorf("%#v: %v", c, err)
+		}
+		if err := CheckNPVLinearity(c, CashFlows{{-100, anchor}, {60, anchor.AddDate(1, 0, 0)}}, CashFlows{{70, anchor.AddDate(7, 0, 0)}}, anchor); err != nil {
+			t.Errorf("%#v: %v", c, err)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Violations
+// -----------------------------------------------------------------------------
+
+// brokenCurve discounts by a fixed table, whatever the contract says.
+type brokenCurve func(years float64) float64
+
+func (c brokenCurve) DiscountFactor(years float64) float64 { return c(years) }
+
+// brokenRate reports an effective rate inconsistent with its discount factor.
+type brokenRate struct{ RateEffective }
+
+func (r brokenRate) RateAnnualEffective() float64 { return r.Value + 0.01 }
+
+func TestContractViolations(t *testing.T) {
+	calls := 0 // a curve whose discount factors drift with use
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"DiscountFactor(0)", CheckDiscountFactor(brokenCurve(func(float64) float64 { return 0.99 }), contractYears)},
+		{"negative discount factor", CheckDiscountFactor(RateBankDiscount{0.1, 360}, contractYears)},
+		{"rising discount factor", CheckDiscountMonotone(RateAnnualContinuous{-0.01}, contractYears)},
+		{"simple round trip", CheckRoundTrip(RateSimple{0.05, 365}, contractYears)},
+		{"inconsistent rate", CheckRateConsistency(brokenRate{RateEffective{0.05, 1}})},
+		{"stateful curve", CheckNPVLinearity(brokenCurve(func(float64) float64 { calls++; return 1 / float64(calls) }), CashFlows{{100, anchor.AddDate(1, 0, 0)}}, nil, anchor)},
+	}
+	for _, tt := range tests {
+		if !errors.Is(tt.err, ErrContractViolation) {
+			t.Errorf("%s: got %v, want ErrContractViolation", tt.name, tt.err)
+		}
+	}
+}
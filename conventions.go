@@ -0,0 +1,249 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// DayCount is a day count convention, how a period between two dates
+// converts to a fraction of a year.
+type DayCount int
+
+const (
+	// DayCountActualActual counts whole calendar years plus the remaining
+	// days over the length of the year they fall in, the default basis of
+	// [Bond] and [Swap] and the one [CashFlow.YearsFrom] uses.
+	DayCountActualActual DayCount = iota
+	// DayCountActual360 counts actual days over 360, money markets.
+	DayCountActual360
+	// DayCountActual365Fixed counts actual days over 365.
+	DayCountActual365Fixed
+	// DayCountThirty360 is the US 30/360 bond basis.
+	DayCountThirty360
+	// DayCountThirtyE360 is the Eurobond 30E/360 basis.
+	DayCountThirtyE360
+)
+
+// YearFraction returns the years from start to end under the convention.
+// Math details:
+//
+// Actual360 = Days / 360,   Actual365Fixed = Days / 365
+//
+// Thirty360 = (360 * (Y2 - Y1) + 30 * (M2 - M1) + (D2 - D1)) / 360, with D1 and D2 capped at 30 by the rules of the basis
+func (d DayCount) YearFraction(start, end time.Time) float64 {
+	days := end.Sub(start).Hours() / 24
+	switch d {
+	case DayCountActual360:
+		return days / 360
+	case DayCountActual365Fixed:
+		return days / 365
+	case DayCountThirty360, DayCountThirtyE360:
+		y1, m1, d1 := start.Date()
+		y2, m2, d2 := end.Date()
+		if d == DayCountThirtyE360 {
+			d1, d2 = min(d1, 30), min(d2, 30)
+		} else {
+			d1 = min(d1, 30)
+			if d1 == 30 {
+				d2 = min(d2, 30)
+			}
+		}
+		return float64(360*(y2-y1)+30*(int(m2)-int(m1))+(d2-d1)) / 360
+	}
+	return yearsBetween(start, end)
+}
+
+// BusinessCalendar tells business days from weekends and Holidays,
+// dates compared by their calendar day.
+type BusinessCalendar struct {
+	Name     string
+	Holidays []time.Time
+}
+
+// IsBusinessDay reports whether date is neither a weekend nor a holiday.
+func (c BusinessCalendar) IsBusinessDay(date time.Time) bool {
+	if wd := date.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	y, m, d := date.Date()
+	return !slices.ContainsFunc(c.Holidays, func(h time.Time) bool {
+		hy, hm, hd := h.Date()
+		return hy == y && hm == m && hd == d
+	})
+}
+
+// AddBusinessDays returns the date n business days after date,
+// or the first business day on or after date when n is 0.
+func (c BusinessCalendar) AddBusinessDays(date time.Time, n int) time.Time {
+	for !c.IsBusinessDay(date) {
+		date = date.AddDate(0, 0, 1)
+	}
+	for ; n > 0; n-- {
+		date = date.AddDate(0, 0, 1)
+		for !c.IsBusinessDay(date) {
+			date = date.AddDate(0, 0, 1)
+		}
+	}
+	return date
+}
+
+// MarketConvention is the market-standard terms of a kind of bond or swap
+// from EffectiveFrom onwards: coupons PeriodsPerYear times a year on
+// DayCount, a floating leg of FloatPeriodsPerYear on FloatDayCount for
+// swaps, and settlement SettlementDays business days after trade,
+// payments rolled to the business days of Calendar.
+type MarketConvention struct {
+	Name                string
+	EffectiveFrom       time.Time
+	DayCount            DayCount
+	PeriodsPerYear      int
+	FloatDayCount       DayCount
+	FloatPeriodsPerYear int
+	SettlementDays      int
+	Calendar            BusinessCalendar
+}
+
+// SettlementDate returns the settlement date of a trade on tradeDate.
+func (c MarketConvention) SettlementDate(tradeDate time.Time) time.Time {
+	return c.Calendar.AddBusinessDays(tradeDate, c.SettlementDays)
+}
+
+// Bond returns a fixed-rate bullet bond on the convention's frequency,
+// day count, and calendar.
+func (c MarketConvention) Bond(face, couponRate float64, issue, maturity time.Time) Bond {
+	calendar := c.Calendar
+	return Bond{
+		Face:           face,
+		CouponRate:     couponRate,
+		PeriodsPerYear: c.PeriodsPerYear,
+		DayCount:       c.DayCount,
+		Issue:          issue,
+		Maturity:       maturity,
+		Calendar:       &calendar,
+	}
+}
+
+// Swap returns a vanilla swap on the convention's fixed and floating
+// frequencies and day counts, and its calendar.
+func (c MarketConvention) Swap(notional, fixedRate float64, start, end time.Time, payFixed bool) Swap {
+	calendar := c.Calendar
+	return Swap{
+		Notional:            notional,
+		FixedRate:           fixedRate,
+		Start:               start,
+		End:                 end,
+		FixedPeriodsPerYear: c.PeriodsPerYear,
+		FloatPeriodsPerYear: c.FloatPeriodsPerYear,
+		FixedDayCount:       c.DayCount,
+		FloatDayCount:       c.FloatDayCount,
+		Calendar:            &calendar,
+		PayFixed:            payFixed,
+	}
+}
+
+// ConventionRegistry holds versions of market conventions by name, so
+// that trades are built on the terms in force on their trade date.
+// The zero value is an empty registry, [DefaultConventions] a populated one.
+type ConventionRegistry struct {
+	versions map[string][]MarketConvention
+}
+
+// Register adds a version of a convention, replacing one of the same
+// Name and EffectiveFrom.
+func (r *ConventionRegistry) Register(c MarketConvention) error {
+	if c.Name == "" {
+		return errors.New("ConventionRegistry.Register requires a Name")
+	}
+	if c.PeriodsPerYear <= 0 || 12%c.PeriodsPerYear != 0 || c.FloatPeriodsPerYear < 0 || c.FloatPeriodsPerYear > 0 && 12%c.FloatPeriodsPerYear != 0 {
+		return errors.New("ConventionRegistry.Register requires periods per year that divide 12")
+	}
+	if c.SettlementDays < 0 {
+		return errors.New("ConventionRegistry.Register requires non-negative SettlementDays")
+	}
+	if r.versions == nil {
+		r.versions = make(map[string][]MarketConvention)
+	}
+	versions := slices.DeleteFunc(r.versions[c.Name], func(v MarketConvention) bool { return v.EffectiveFrom.Equal(c.EffectiveFrom) })
+	versions = append(versions, c)
+	slices.SortFunc(versions, func(a, b MarketConvention) int { return a.EffectiveFrom.Compare(b.EffectiveFrom) })
+	r.versions[c.Name] = versions
+	return nil
+}
+
+// Lookup returns the version of the named convention in force on asOf,
+// the latest effective on or before it, or an error wrapping
+// [ErrNoSuchEntry].
+func (r ConventionRegistry) Lookup(name string, asOf time.Time) (MarketConvention, error) {
+	versions := r.versions[name]
+	for i := len(versions) - 1; i >= 0; i-- {
+		if !versions[i].EffectiveFrom.After(asOf) {
+			return versions[i], nil
+		}
+	}
+	return MarketConvention{}, fmt.Errorf("convention %q on %s: %w", name, asOf.Format(time.DateOnly), ErrNoSuchEntry)
+}
+
+// Names returns the names of the registered conventions in order.
+func (r ConventionRegistry) Names() []string {
+	names := make([]string, 0, len(r.versions))
+	for name := range r.versions {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Bond returns a bond on the named convention in force on issue,
+// see [MarketConvention.Bond].
+func (r ConventionRegistry) Bond(name string, face, couponRate float64, issue, maturity time.Time) (Bond, error) {
+	c, err := r.Lookup(name, issue)
+	if err != nil {
+		return Bond{}, err
+	}
+	return c.Bond(face, couponRate, issue, maturity), nil
+}
+
+// Swap returns a swap on the named convention in force on start,
+// see [MarketConvention.Swap].
+func (r ConventionRegistry) Swap(name string, notional, fixedRate float64, start, end time.Time, payFixed bool) (Swap, error) {
+	c, err := r.Lookup(name, start)
+	if err != nil {
+		return Swap{}, err
+	}
+	if c.FloatPeriodsPerYear == 0 {
+		return Swap{}, fmt.Errorf("convention %q has no floating leg", name)
+	}
+	return c.Swap(notional, fixedRate, start, end, payFixed), nil
+}
+
+// DefaultConventions returns a registry of common market conventions.
+// Their calendars count weekends only, without any public holidays:
+// register versions with the holidays of the markets as needed.
+//
+//   - UST: US Treasuries, semiannual, actual/actual, T+1
+//   - Gilt: UK gilts, semiannual, actual/actual, T+1
+//   - Bund: German government bonds, annual, actual/actual, T+2
+//   - JGB: Japanese government bonds, semiannual, actual/365 fixed,
+//     T+3 until 2018-05-01 and T+2 from then
+//   - EUR-swap: annual 30E/360 fixed against semiannual actual/360 EURIBOR, T+2
+//   - USD-SOFR: annual actual/360 on both legs, T+2
+func DefaultConventions() ConventionRegistry {
+	var r ConventionRegistry
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	for _, c := range []MarketConvention{
+		{Name: "UST", DayCount: DayCountActualActual, PeriodsPerYear: 2, SettlementDays: 1, Calendar: BusinessCalendar{Name: "US"}},
+		{Name: "Gilt", DayCount: DayCountActualActual, PeriodsPerYear: 2, SettlementDays: 1, Calendar: BusinessCalendar{Name: "UK"}},
+		{Name: "Bund", DayCount: DayCountActualActual, PeriodsPerYear: 1, SettlementDays: 2, Calendar: BusinessCalendar{Name: "TARGET"}},
+		{Name: "JGB", DayCount: DayCountActual365Fixed, PeriodsPerYear: 2, SettlementDays: 3, Calendar: BusinessCalendar{Name: "JP"}},
+		{Name: "JGB", EffectiveFrom: date(2018, time.May, 1), DayCount: DayCountActual365Fixed, PeriodsPerYear: 2, SettlementDays: 2, Calendar: BusinessCalendar{Name: "JP"}},
+		{Name: "EUR-swap", DayCount: DayCountThirtyE360, PeriodsPerYear: 1, FloatDayCount: DayCountActual360, FloatPeriodsPerYear: 2, SettlementDays: 2, Calendar: BusinessCalendar{Name: "TARGET"}},
+		{Name: "USD-SOFR", DayCount: DayCountActual360, PeriodsPerYear: 1, FloatDayCount: DayCountActual360, FloatPeriodsPerYear: 1, SettlementDays: 2, Calendar: BusinessCalendar{Name: "US"}},
+	} {
+		// every convention above has a Name, periods per year dividing 12,
+		// and non-negative SettlementDays, so Register cannot fail
+		_ = r.Register(c)
+	}
+	return r
+}
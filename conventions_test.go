@@ -0,0 +1,118 @@
+package gofinance
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// Day counts
+// -----------------------------------------------------------------------------
+func TestDayCountYearFraction(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name       string
+		dc         DayCount
+		start, end time.Time
+		want       float64
+	}{
+		{"act/360", DayCountActual360, date(2020, 1, 1), date(2020, 7, 1), 182.0 / 360},
+		{"act/365f", DayCountActual365Fixed, date(2020, 1, 1), date(2021, 1, 1), 366.0 / 365},
+		{"act/act", DayCountActualActual, date(2020, 1, 1), date(2021, 1, 1), 1},
+		{"30/360", DayCountThirty360, date(2020, 1, 31), date(2020, 3, 31), 60.0 / 360},
+		{"30/360 end of February", DayCountThirty360, date(2020, 2, 29), date(2020, 3, 31), 32.0 / 360},
+		{"30E/360", DayCountThirtyE360, date(2020, 2, 29), date(2020, 3, 31), 31.0 / 360},
+		{"30E/360 years", DayCountThirtyE360, date(2020, 5, 15), date(2023, 5, 15), 3},
+	}
+	for _, tt := range tests {
+		if got := tt.dc.YearFraction(tt.start, tt.end); !almostEq(got, tt.want, epsilon) {
+			t.Errorf("%s: got %f, want %f", tt.name, got, tt.want)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Calendars
+// -----------------------------------------------------------------------------
+func TestBusinessCalendar(t *testing.T) {
+	// 2020-01-01 is a Wednesday and a holiday
+	c := BusinessCalendar{Holidays: []time.Time{anchor}}
+	tests := []struct {
+		date time.Time
+		n    int
+		want time.Time
+	}{
+		{anchor, 0, anchor.AddDate(0, 0, 1)},
+		{anchor.AddDate(0, 0, 1), 1, anchor.AddDate(0, 0, 2)},
+		{anchor.AddDate(0, 0, 2), 1, anchor.AddDate(0, 0, 5)}, // Friday to Monday
+		{anchor.AddDate(0, 0, 3), 2, anchor.AddDate(0, 0, 7)}, // Saturday rolls to Monday first
+	}
+	for _, tt := range tests {
+		if got := c.AddBusinessDays(tt.date, tt.n); !got.Equal(tt.want) {
+			t.Errorf("AddBusinessDays(%s, %d) got %s, want %s", tt.date.Format(time.DateOnly), tt.n, got.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+	}
+	if c.IsBusinessDay(anchor.In(time.FixedZone("EST", -5*3600)).Add(10 * time.Hour)) {
+		t.Error("holiday missed in another time zone")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Convention registry
+// -----------------------------------------------------------------------------
+func TestConventionRegistry(t *testing.T) {
+	r := DefaultConventions()
+	if names := r.Names(); len(names) != 6 || names[0] != "Bund" {
+		t.Errorf("Names got %v", names)
+	}
+
+	// JGB settlement moved from T+3 to T+2 in May 2018
+	before, _ := r.Lookup("JGB", time.Date(2018, 4, 30, 0, 0, 0, 0, time.UTC))
+	after, _ := r.Lookup("JGB", time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC))
+	if before.SettlementDays != 3 || after.SettlementDays != 2 {
+		t.Errorf("JGB settlement got T+%d and T+%d, want T+3 and T+2", before.SettlementDays, after.SettlementDays)
+	}
+	ust, _ := r.Lookup("UST", anchor)
+	if got := ust.SettlementDate(anchor.AddDate(0, 0, 2)); !got.Equal(anchor.AddDate(0, 0, 5)) {
+		t.Errorf("UST trade on Friday settles %s, want Monday", got.Format(time.DateOnly))
+	}
+
+	b, err := r.Bond("UST", 100, 0.04, anchor, anchor.AddDate(10, 0, 0))
+	if err != nil || b.PeriodsPerYear != 2 || b.CouponRate != 0.04 || !b.Maturity.Equal(anchor.AddDate(10, 0, 0)) ||
+		b.DayCount != DayCountActualActual || b.Calendar == nil || b.Calendar.Name != "US" {
+		t.Errorf("UST bond got %+v, %v", b, err)
+	}
+	s, err := r.Swap("EUR-swap", 1e6, 0.03, anchor, anchor.AddDate(5, 0, 0), true)
+	if err != nil || s.FixedPeriodsPerYear != 1 || s.FloatPeriodsPerYear != 2 || !s.PayFixed ||
+		s.FixedDayCount != DayCountThirtyE360 || s.FloatDayCount != DayCountActual360 || s.Calendar == nil || s.Calendar.Name != "TARGET" {
+		t.Errorf("EUR swap got %+v, %v", s, err)
+	}
+	if _, err := r.Swap("Bund", 1e6, 0.03, anchor, anchor.AddDate(5, 0, 0), true); err == nil {
+		t.Error("Swap accepted a bond convention")
+	}
+	if _, err := r.Bond("OAT", 100, 0.04, anchor, anchor.AddDate(10, 0, 0)); !errors.Is(err, ErrNoSuchEntry) {
+		t.Errorf("unknown convention got %v, want ErrNoSuchEntry", err)
+	}
+
+	// a new version replaces one of the same date and applies from then on
+	holiday := BusinessCalendar{Name: "US", Holidays: []time.Time{anchor.AddDate(0, 0, 5)}}
+	if err := r.Register(MarketConvention{Name: "UST", EffectiveFrom: anchor, PeriodsPerYear: 2, SettlementDays: 1, Calendar: holiday}); err != nil {
+		t.Fatal(err)
+	}
+	ust, _ = r.Lookup("UST", anchor.AddDate(0, 0, 2))
+	if got := ust.SettlementDate(anchor.AddDate(0, 0, 2)); !got.Equal(anchor.AddDate(0, 0, 6)) {
+		t.Errorf("UST settlement over a holiday got %s", got.Format(time.DateOnly))
+	}
+	if old, _ := r.Lookup("UST", anchor.AddDate(-1, 0, 0)); len(old.Calendar.Holidays) != 0 {
+		t.Error("new version applied before its EffectiveFrom")
+	}
+
+	var empty ConventionRegistry
+	if err := empty.Register(MarketConvention{Name: "bad", PeriodsPerYear: 5}); err == nil {
+		t.Error("Register accepted 5 periods per year")
+	}
+	if err := empty.Register(MarketConvention{PeriodsPerYear: 2}); err == nil {
+		t.Error("Register accepted a convention without a name")
+	}
+}
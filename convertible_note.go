@@ -0,0 +1,99 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"time"
+)
+
+// ConvertibleNote is a convertible note accruing simple interest at
+// InterestRate a year. It converts into shares at the first qualified
+// financing before Maturity, one raising at least QualifyingAmount, at the
+// lower of the round price less Discount and the price implied by the
+// pre-money ValuationCap; a zero Discount or ValuationCap does not apply.
+// If it has not converted by Maturity it is repaid with interest.
+type ConvertibleNote struct {
+	Principal        float64
+	InterestRate     float64
+	Issue            time.Time
+	Maturity         time.Time
+	Discount         float64
+	ValuationCap     float64
+	QualifyingAmount float64
+}
+
+// Financing is an equity round: Amount of new money raised on Date at
+// PricePerShare, with PreMoneyShares fully diluted shares outstanding before it.
+type Financing struct {
+	Date           time.Time
+	Amount         float64
+	PricePerShare  float64
+	PreMoneyShares float64
+}
+
+// NoteOutcome is what became of a [ConvertibleNote]: whether it Converted
+// and on which Date, the interest Accrued until then, the ConversionPrice
+// and the Shares issued, and the holder's CashFlows, the investment and,
+// if repaid, the repayment.
+type NoteOutcome struct {
+	Converted       bool
+	Date            time.Time
+	Accrued         float64
+	ConversionPrice float64
+	Shares          float64
+	CashFlows       CashFlows
+}
+
+// AccruedInterest returns the simple interest accrued from Issue to asOf,
+// not beyond Maturity.
+// Math details:
+//
+// AccruedInterest = Principal * InterestRate * YearsBetween(Issue, min(AsOf, Maturity))
+func (n ConvertibleNote) AccruedInterest(asOf time.Time) float64 {
+	if asOf.After(n.Maturity) {
+		asOf = n.Maturity
+	}
+	if !asOf.After(n.Issue) {
+		return 0
+	}
+	return n.Principal * n.InterestRate * yearsBetween(n.Issue, asOf)
+}
+
+// ConversionPrice returns the price per share at which the note converts in f.
+// Math details:
+//
+// ConversionPrice = min(PricePerShare * (1 - Discount), ValuationCap / PreMoneyShares)
+func (n ConvertibleNote) ConversionPrice(f Financing) float64 {
+	price := f.PricePerShare * (1 - n.Discount)
+	if n.ValuationCap > 0 && f.PreMoneyShares > 0 {
+		price = math.Min(price, n.ValuationCap/f.PreMoneyShares)
+	}
+	return price
+}
+
+// Resolve runs the note through the financings in date order and returns
+// its outcome: conversion at the first qualified financing after Issue and
+// not after Maturity, or else repayment at Maturity.
+func (n ConvertibleNote) Resolve(financings []Financing) (NoteOutcome, error) {
+	if !n.Maturity.After(n.Issue) {
+		return NoteOutcome{}, errors.New("ConvertibleNote requires maturity after issue")
+	}
+	ordered := slices.Clone(financings)
+	slices.SortStableFunc(ordered, func(a, b Financing) int { return a.Date.Compare(b.Date) })
+	invest := CashFlow{-n.Principal, n.Issue}
+	for _, f := range ordered {
+		if !f.Date.After(n.Issue) || f.Date.After(n.Maturity) || f.Amount < n.QualifyingAmount {
+			continue
+		}
+		price := n.ConversionPrice(f)
+		if price <= 0 {
+			return NoteOutcome{}, errors.New("ConvertibleNote: non-positive conversion price")
+		}
+		accrued := n.AccruedInterest(f.Date)
+		return NoteOutcome{true, f.Date, accrued, price, (n.Principal + accrued) / price, CashFlows{invest}}, nil
+	}
+	accrued := n.AccruedInterest(n.Maturity)
+	repay := CashFlow{n.Principal + accrued, n.Maturity}
+	return NoteOutcome{false, n.Maturity, accrued, 0, 0, CashFlows{invest, repay}}, nil
+}
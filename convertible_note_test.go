@@ -0,0 +1,55 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// ConvertibleNote
+// -----------------------------------------------------------------------------
+func TestConvertibleNote(t *testing.T) {
+	n := ConvertibleNote{
+		Principal:        100000,
+		InterestRate:     0.08,
+		Issue:            anchor,
+		Maturity:         anchor.AddDate(2, 0, 0),
+		Discount:         0.2,
+		ValuationCap:     4e6,
+		QualifyingAmount: 1e6,
+	}
+	oneYear := anchor.AddDate(1, 0, 0)
+	if got, want := n.AccruedInterest(oneYear), 8000*yearsBetween(anchor, oneYear); !almostEq(got, want, 1e-9) {
+		t.Errorf("AccruedInterest got %f, want %f", got, want)
+	}
+	if n.AccruedInterest(anchor.AddDate(5, 0, 0)) != n.AccruedInterest(n.Maturity) {
+		t.Error("AccruedInterest accrued past maturity")
+	}
+
+	// discount binds: 1.00 * 0.8 < 4e6 / 2e6
+	if got := n.ConversionPrice(Financing{PricePerShare: 1, PreMoneyShares: 2e6}); !almostEq(got, 0.8, 1e-12) {
+		t.Errorf("ConversionPrice with binding discount got %f, want 0.8", got)
+	}
+	// cap binds: 4e6 / 2e6 = 2 < 5 * 0.8
+	if got := n.ConversionPrice(Financing{PricePerShare: 5, PreMoneyShares: 2e6}); !almostEq(got, 2, 1e-12) {
+		t.Errorf("ConversionPrice with binding cap got %f, want 2", got)
+	}
+
+	financings := []Financing{
+		{Date: oneYear, Amount: 2e6, PricePerShare: 5, PreMoneyShares: 2e6},
+		{Date: anchor.AddDate(0, 6, 0), Amount: 5e5, PricePerShare: 3, PreMoneyShares: 2e6}, // not qualified
+	}
+	out, err := n.Resolve(financings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantShares := (100000 + n.AccruedInterest(oneYear)) / 2
+	if !out.Converted || !out.Date.Equal(oneYear) || !almostEq(out.Shares, wantShares, 1e-9) || len(out.CashFlows) != 1 {
+		t.Errorf("Resolve got %+v, want conversion into %f shares", out, wantShares)
+	}
+
+	out, _ = n.Resolve(financings[1:])
+	if out.Converted || len(out.CashFlows) != 2 || !almostEq(out.CashFlows[1].Value, 100000+n.AccruedInterest(n.Maturity), 1e-9) {
+		t.Errorf("Resolve without qualified financing got %+v", out)
+	}
+	if _, err := (ConvertibleNote{Issue: anchor, Maturity: anchor}).Resolve(nil); err == nil {
+		t.Error("Resolve accepted maturity at issue")
+	}
+}
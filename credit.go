@@ -0,0 +1,124 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"time"
+)
+
+// SurvivalCurve gives the probability that a counterparty has not defaulted
+// by a horizon, 1 at or before zero years and falling with time.
+type SurvivalCurve interface {
+	SurvivalProbability(years float64) float64
+}
+
+// HazardCurve implements [SurvivalCurve] with piecewise-constant hazard rates
+// (annual default intensities): Hazards[i] applies from Years[i-1] to Years[i],
+// Hazards[0] from zero years, the last hazard rate beyond the last pillar.
+// Use [NewHazardCurve] to get the inputs validated.
+type HazardCurve struct {
+	Years   []float64
+	Hazards []float64
+}
+
+// NewHazardCurve builds a [HazardCurve] from strictly increasing positive pillar
+// years and non-negative hazard rates of the same length. The slices are copied.
+//
+// A flat curve has one pillar:
+//
+//	flat, _ := NewHazardCurve([]float64{1}, []float64{0.02})
+func NewHazardCurve(years, hazards []float64) (HazardCurve, error) {
+	if len(years) == 0 || len(years) != len(hazards) {
+		return HazardCurve{}, errors.New("NewHazardCurve requires the same non-zero number of years and hazards")
+	}
+	for i := range years {
+		if years[i] <= 0 || i > 0 && years[i] <= years[i-1] {
+			return HazardCurve{}, errors.New("NewHazardCurve requires strictly increasing positive years")
+		}
+		if hazards[i] < 0 {
+			return HazardCurve{}, errors.New("NewHazardCurve requires non-negative hazards")
+		}
+	}
+	return HazardCurve{slices.Clone(years), slices.Clone(hazards)}, nil
+}
+
+// SurvivalProbability implements [SurvivalCurve].
+// Math details:
+//
+// SurvivalProbability = e^{-\int_0^{Years} Hazard(s) ds}
+func (h HazardCurve) SurvivalProbability(years float64) float64 {
+	if years <= 0 || len(h.Years) == 0 {
+		return 1
+	}
+	integral, prev := 0.0, 0.0
+	for i, pillar := range h.Years {
+		if years <= pillar {
+			return math.Exp(-(integral + h.Hazards[i]*(years-prev)))
+		}
+		integral += h.Hazards[i] * (pillar - prev)
+		prev = pillar
+	}
+	return math.Exp(-(integral + h.Hazards[len(h.Hazards)-1]*(years-prev)))
+}
+
+// HazardFromSpread converts a credit spread into a flat hazard rate with the
+// "credit triangle": the spread compensates for expected losses.
+// Math details:
+//
+// Hazard = Spread / (1 - Recovery)
+func HazardFromSpread(spread, recovery float64) float64 {
+	return spread / (1 - recovery)
+}
+
+// NPVRisky computes the net present value of cash-flows owed by a
+// counterparty that may default, discounting each flow on curve and weighting
+// it by the probability that it is paid. If the counterparty has defaulted
+// by the date of a flow, the fraction recovery of that flow is received.
+// Math details:
+//
+// NPVRisky = \sum_i Value_i * DiscountFactor(t_i) * (Survival(t_i) + Recovery * (1 - Survival(t_i)))
+func (cfs CashFlows) NPVRisky(curve YieldCurve, survival SurvivalCurve, recovery float64, valuationDate time.Time) float64 {
+	npv := 0.0
+	for _, cf := range cfs {
+		t := cf.YearsFrom(valuationDate)
+		q := survival.SurvivalProbability(t)
+		npv += cf.Value * curve.DiscountFactor(t) * (q + recovery*(1-q))
+	}
+	return npv
+}
+
+// ExpectedLoss returns the present value lost to default:
+// the risk-free value of the cash-flows less their [CashFlows.NPVRisky].
+func (cfs CashFlows) ExpectedLoss(curve YieldCurve, survival SurvivalCurve, recovery float64, valuationDate time.Time) float64 {
+	return cfs.NPVCurve(curve, valuationDate) - cfs.NPVRisky(curve, survival, recovery, valuationDate)
+}
+
+// CDSParSpread returns the annual premium, as a simple rate on the notional,
+// that makes a credit default swap of the given maturity worth zero.
+// Premiums are paid periodsPerYear times a year, default is assumed to occur
+// in the middle of a period and the premium accrued until then is paid.
+// Math details:
+//
+// Default_i = Survival(t_{i-1}) - Survival(t_i),   tMid_i = (t_{i-1} + t_i) / 2
+//
+// Protection = (1 - Recovery) * \sum_i DiscountFactor(tMid_i) * Default_i
+//
+// Annuity = \sum_i Tau_i * (DiscountFactor(t_i) * Survival(t_i) + DiscountFactor(tMid_i) * Default_i / 2)
+//
+// ParSpread = Protection / Annuity
+func CDSParSpread(curve YieldCurve, survival SurvivalCurve, recovery, years float64, periodsPerYear int) float64 {
+	n := int(math.Ceil(years*float64(periodsPerYear) - 1e-9))
+	protection, annuity := 0.0, 0.0
+	prev := 0.0
+	for i := 1; i <= n; i++ {
+		t := math.Min(float64(i)/float64(periodsPerYear), years)
+		tau := t - prev
+		mid := (prev + t) / 2
+		dflt := survival.SurvivalProbability(prev) - survival.SurvivalProbability(t)
+		protection += curve.DiscountFactor(mid) * dflt
+		annuity += tau * (curve.DiscountFactor(t)*survival.SurvivalProbability(t) + curve.DiscountFactor(mid)*dflt/2)
+		prev = t
+	}
+	return (1 - recovery) * protection / annuity
+}
@@ -0,0 +1,101 @@
+package gofinance
+
+import "math"
+
+// CreditExposure is a single loan or counterparty exposure for credit-risk
+// metrics: the probability of default PD over the horizon, the loss given
+// default LGD as a fraction of exposure, and the exposure at default EAD.
+// LGDVolatility is the standard deviation of the LGD, zero for a fixed LGD.
+type CreditExposure struct {
+	PD            float64
+	LGD           float64
+	EAD           float64
+	LGDVolatility float64
+}
+
+// ExpectedLoss returns the mean credit loss over the horizon.
+// Math details:
+//
+// ExpectedLoss = PD * LGD * EAD
+func (e CreditExposure) ExpectedLoss() float64 {
+	return e.PD * e.LGD * e.EAD
+}
+
+// UnexpectedLoss returns the standard deviation of the credit loss,
+// with default and LGD independent.
+// Math details:
+//
+// UnexpectedLoss = EAD * \sqrt{PD * LGDVolatility^2 + LGD^2 * PD * (1 - PD)}
+func (e CreditExposure) UnexpectedLoss() float64 {
+	return e.EAD * math.Sqrt(e.PD*e.LGDVolatility*e.LGDVolatility+e.LGD*e.LGD*e.PD*(1-e.PD))
+}
+
+// CreditPortfolio is a helper alias for a slice of [CreditExposure].
+type CreditPortfolio []CreditExposure
+
+// ExpectedLoss returns the sum of the exposures' expected losses.
+func (p CreditPortfolio) ExpectedLoss() float64 {
+	el := 0.0
+	for _, e := range p {
+		el += e.ExpectedLoss()
+	}
+	return el
+}
+
+// VasicekLoss returns the portfolio loss not exceeded with probability
+// confidence under the Vasicek single-factor model, in which defaults are
+// driven by one systematic factor with asset correlation correlation.
+// The portfolio is assumed fine-grained, idiosyncratic risk diversified away.
+// Math details:
+//
+// VasicekLoss = \sum_i EAD_i * LGD_i * VasicekConditionalPD(PD_i, Correlation, Confidence)
+func (p CreditPortfolio) VasicekLoss(correlation, confidence float64) float64 {
+	loss := 0.0
+	for _, e := range p {
+		loss += e.EAD * e.LGD * VasicekConditionalPD(e.PD, correlation, confidence)
+	}
+	return loss
+}
+
+// VasicekCapital returns the loss at confidence in excess of the expected
+// loss, the unexpected loss to be covered by capital as in the Basel IRB formula.
+func (p CreditPortfolio) VasicekCapital(correlation, confidence float64) float64 {
+	return p.VasicekLoss(correlation, confidence) - p.ExpectedLoss()
+}
+
+// VasicekConditionalPD returns the default probability conditional on the
+// systematic factor at its confidence-quantile stress, which is also the
+// confidence-quantile of the default rate of a large homogeneous pool.
+// Math details:
+//
+// VasicekConditionalPD = N((N^{-1}(PD) + \sqrt{Correlation} * N^{-1}(Confidence)) / \sqrt{1 - Correlation})
+//
+// N = standard normal cumulative distribution function
+func VasicekConditionalPD(pd, correlation, confidence float64) float64 {
+	return normCDF((normInv(pd) + math.Sqrt(correlation)*normInv(confidence)) / math.Sqrt(1-correlation))
+}
+
+// VasicekLossCDF returns the probability that the default rate of a large
+// homogeneous pool does not exceed x, the Vasicek loss distribution.
+// Math details:
+//
+// VasicekLossCDF = N((\sqrt{1 - Correlation} * N^{-1}(x) - N^{-1}(PD)) / \sqrt{Correlation})
+func VasicekLossCDF(x, pd, correlation float64) float64 {
+	switch {
+	case x <= 0:
+		return 0
+	case x >= 1:
+		return 1
+	}
+	return normCDF((math.Sqrt(1-correlation)*normInv(x) - normInv(pd)) / math.Sqrt(correlation))
+}
+
+// normCDF is the standard normal cumulative distribution function.
+func normCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+// normInv is the inverse of [normCDF].
+func normInv(p float64) float64 {
+	return math.Sqrt2 * math.Erfinv(2*p-1)
+}
@@ -0,0 +1,67 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// CreditExposure
+// -----------------------------------------------------------------------------
+func TestCreditExposure(t *testing.T) {
+	e := CreditExposure{PD: 0.02, LGD: 0.45, EAD: 1e6}
+	if got := e.ExpectedLoss(); !almostEq(got, 9000, 1e-9) {
+		t.Errorf("ExpectedLoss got %f, want 9000", got)
+	}
+	want := 1e6 * 0.45 * math.Sqrt(0.02*0.98)
+	if got := e.UnexpectedLoss(); !almostEq(got, want, 1e-9) {
+		t.Errorf("UnexpectedLoss got %f, want %f", got, want)
+	}
+	e.LGDVolatility = 0.2
+	want = 1e6 * math.Sqrt(0.02*0.04+0.45*0.45*0.02*0.98)
+	if got := e.UnexpectedLoss(); !almostEq(got, want, 1e-9) {
+		t.Errorf("UnexpectedLoss with LGD volatility got %f, want %f", got, want)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Vasicek
+// -----------------------------------------------------------------------------
+func TestVasicek(t *testing.T) {
+	// zero correlation: the pool default rate is the PD
+	if got := VasicekConditionalPD(0.01, 0, 0.999); !almostEq(got, 0.01, 1e-12) {
+		t.Errorf("VasicekConditionalPD with zero correlation got %f, want 0.01", got)
+	}
+	// Basel IRB corporate example: PD 1%, correlation 0.19278, 99.9%
+	if got := VasicekConditionalPD(0.01, 0.19278, 0.999); !almostEq(got, 0.1403, 5e-4) {
+		t.Errorf("VasicekConditionalPD got %f, want about 0.1403", got)
+	}
+	// the conditional PD at confidence q is the q-quantile of the loss distribution
+	for _, q := range []float64{0.5, 0.9, 0.999} {
+		x := VasicekConditionalPD(0.03, 0.15, q)
+		if got := VasicekLossCDF(x, 0.03, 0.15); !almostEq(got, q, 1e-9) {
+			t.Errorf("VasicekLossCDF(quantile %f) got %f", q, got)
+		}
+	}
+	if VasicekLossCDF(0, 0.03, 0.15) != 0 || VasicekLossCDF(1, 0.03, 0.15) != 1 {
+		t.Error("VasicekLossCDF bounds wrong")
+	}
+}
+
+func TestCreditPortfolio(t *testing.T) {
+	p := CreditPortfolio{
+		{PD: 0.01, LGD: 0.4, EAD: 100},
+		{PD: 0.05, LGD: 0.6, EAD: 50},
+	}
+	if got := p.ExpectedLoss(); !almostEq(got, 0.4+1.5, 1e-12) {
+		t.Errorf("ExpectedLoss got %f, want 1.9", got)
+	}
+	loss := p.VasicekLoss(0.2, 0.999)
+	want := 40*VasicekConditionalPD(0.01, 0.2, 0.999) + 30*VasicekConditionalPD(0.05, 0.2, 0.999)
+	if !almostEq(loss, want, 1e-12) {
+		t.Errorf("VasicekLoss got %f, want %f", loss, want)
+	}
+	if got := p.VasicekCapital(0.2, 0.999); !almostEq(got, loss-1.9, 1e-12) || got <= 0 {
+		t.Errorf("VasicekCapital got %f", got)
+	}
+}
@@ -0,0 +1,110 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// HazardCurve
+// -----------------------------------------------------------------------------
+func TestHazardCurve(t *testing.T) {
+	h, err := NewHazardCurve([]float64{1, 3}, []float64{0.01, 0.03})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		years float64
+		want  float64
+	}{
+		{-1, 1},
+		{0, 1},
+		{0.5, math.Exp(-0.005)},
+		{1, math.Exp(-0.01)},
+		{2, math.Exp(-0.01 - 0.03)},
+		{5, math.Exp(-0.01 - 0.06 - 0.06)}, // last hazard held beyond 3 years
+	}
+	for _, tc := range tests {
+		if got := h.SurvivalProbability(tc.years); !almostEq(got, tc.want, epsilon) {
+			t.Errorf("SurvivalProbability(%v) got %v, want %v", tc.years, got, tc.want)
+		}
+	}
+}
+
+func TestNewHazardCurveErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		years, hazards []float64
+	}{
+		{"empty", nil, nil},
+		{"length mismatch", []float64{1}, []float64{0.01, 0.02}},
+		{"zero pillar", []float64{0}, []float64{0.01}},
+		{"not increasing", []float64{2, 1}, []float64{0.01, 0.02}},
+		{"negative hazard", []float64{1}, []float64{-0.01}},
+	}
+	for _, tc := range tests {
+		if _, err := NewHazardCurve(tc.years, tc.hazards); err == nil {
+			t.Errorf("%s: expected error, got nil", tc.name)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Risky NPV & expected loss
+// -----------------------------------------------------------------------------
+func TestNPVRisky(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.03}
+	h, _ := NewHazardCurve([]float64{1}, []float64{0.02})
+	cfs := CashFlows{
+		{Value: 100, Date: anchor.AddDate(1, 0, 0)},
+		{Value: 100, Date: anchor.AddDate(2, 0, 0)},
+	}
+
+	want := 0.0
+	for _, y := range []float64{1, 2} {
+		q := math.Exp(-0.02 * y)
+		want += 100 * math.Exp(-0.03*y) * (q + 0.4*(1-q))
+	}
+	if got := cfs.NPVRisky(r, h, 0.4, anchor); !almostEq(got, want, epsilon) {
+		t.Errorf("NPVRisky got %.10f, want %.10f", got, want)
+	}
+
+	// full recovery or no hazard: risk-free value
+	if got := cfs.NPVRisky(r, h, 1, anchor); !almostEq(got, cfs.NPV(r, anchor), epsilon) {
+		t.Errorf("NPVRisky with full recovery got %f, want %f", got, cfs.NPV(r, anchor))
+	}
+
+	el := cfs.ExpectedLoss(r, h, 0.4, anchor)
+	if !almostEq(el, cfs.NPV(r, anchor)-want, epsilon) || el <= 0 {
+		t.Errorf("ExpectedLoss got %f, want %f", el, cfs.NPV(r, anchor)-want)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// CDS par spread & credit triangle
+// -----------------------------------------------------------------------------
+func TestCDSParSpread(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.03}
+	lambda := 0.02
+	h, _ := NewHazardCurve([]float64{1}, []float64{lambda})
+
+	// with a flat hazard the spread is close to the credit triangle
+	got := CDSParSpread(r, h, 0.4, 5, 4)
+	if math.Abs(got-lambda*0.6) > 1e-4 {
+		t.Errorf("CDSParSpread got %.6f, want about %.6f", got, lambda*0.6)
+	}
+	if back := HazardFromSpread(got, 0.4); math.Abs(back-lambda) > 2e-4 {
+		t.Errorf("HazardFromSpread got %.6f, want about %.6f", back, lambda)
+	}
+
+	// odd maturity keeps a short last period and stays consistent
+	if odd := CDSParSpread(r, h, 0.4, 4.9, 4); math.Abs(odd-got) > 1e-4 {
+		t.Errorf("CDSParSpread 4.9y got %.6f, want about %.6f", odd, got)
+	}
+
+	// no default risk, no spread
+	none, _ := NewHazardCurve([]float64{1}, []float64{0})
+	if s := CDSParSpread(r, none, 0.4, 5, 4); s != 0 {
+		t.Errorf("CDSParSpread without hazard got %f, want 0", s)
+	}
+}
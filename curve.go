@@ -0,0 +1,130 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// YieldCurve represents a term structure of interest rates:
+// a discount factor for every horizon instead of one flat rate.
+//
+// Every [Rate] is also a YieldCurve, a flat one,
+// so functions taking a YieldCurve accept a plain Rate as well.
+type YieldCurve interface {
+	// DiscountFactor returns discount factor based on number of years.
+	// See [Rate] for the math.
+	DiscountFactor(years float64) float64
+}
+
+// YieldCurveZero implements [YieldCurve] from continuously compounded zero rates
+// at pillar maturities. Years must be strictly increasing and of the same length as Rates.
+//
+// Between pillars the zero rate is interpolated linearly,
+// before the first and after the last pillar it is held flat.
+// Use [NewYieldCurveZero] to get the inputs validated.
+type YieldCurveZero struct {
+	Years []float64
+	Rates []float64
+}
+
+// NewYieldCurveZero builds a [YieldCurveZero] from pillar maturities in years and
+// continuously compounded zero rates. The slices are copied.
+func NewYieldCurveZero(years, rates []float64) (YieldCurveZero, error) {
+	if len(years) == 0 || len(years) != len(rates) {
+		return YieldCurveZero{}, errors.New("NewYieldCurveZero requires the same non-zero number of years and rates")
+	}
+	for i := 1; i < len(years); i++ {
+		if years[i] <= years[i-1] {
+			return YieldCurveZero{}, errors.New("NewYieldCurveZero requires strictly increasing years")
+		}
+	}
+	return YieldCurveZero{slices.Clone(years), slices.Clone(rates)}, nil
+}
+
+// ZeroRate returns the continuously compounded zero rate for the given horizon.
+// Math details:
+//
+// ZeroRate = Rate_i + (Rate_{i+1} - Rate_i) * (Years - Years_i) / (Years_{i+1} - Years_i)
+func (c YieldCurveZero) ZeroRate(years float64) float64 {
+	n := len(c.Years)
+	switch {
+	case n == 0:
+		return 0
+	case years <= c.Years[0]:
+		return c.Rates[0]
+	case years >= c.Years[n-1]:
+		return c.Rates[n-1]
+	}
+	i, _ := slices.BinarySearch(c.Years, years) // c.Years[i-1] < years <= c.Years[i]
+	w := (years - c.Years[i-1]) / (c.Years[i] - c.Years[i-1])
+	return c.Rates[i-1] + w*(c.Rates[i]-c.Rates[i-1])
+}
+
+// DiscountFactor implements [YieldCurve].
+// Math details:
+//
+// DiscountFactor = e^{ZeroRate(Years) * -Years}
+func (c YieldCurveZero) DiscountFactor(years float64) float64 {
+	return math.Exp(c.ZeroRate(years) * -years)
+}
+
+// YieldCurveShifted implements [YieldCurve] by adding a parallel continuously
+// compounded Spread to every zero rate of Curve. A Spread of 0.0001 is a
+// one basis point shift up.
+type YieldCurveShifted struct {
+	Curve  YieldCurve
+	Spread float64
+}
+
+// DiscountFactor implements [YieldCurve].
+// Math details:
+//
+// DiscountFactor = DiscountFactor_curve(Years) * e^{Spread * -Years}
+func (c YieldCurveShifted) DiscountFactor(years float64) float64 {
+	return c.Curve.DiscountFactor(years) * math.Exp(c.Spread*-years)
+}
+
+// NPVCurve is [CashFlows.NPV] with discount factors read off a [YieldCurve],
+// so each cash-flow is discounted at the zero rate of its own maturity.
+// Math details:
+//
+// NPV = \sum_i Value_i * DiscountFactor_curve(Years_i)
+func (cfs CashFlows) NPVCurve(curve YieldCurve, valuationDate time.Time) float64 {
+	npv := 0.0
+	for _, cf := range cfs {
+		npv += cf.Value * curve.DiscountFactor(cf.YearsFrom(valuationDate))
+	}
+	return npv
+}
+
+// ZSpread returns the parallel continuously compounded spread over the
+// zero rates of the curve at which the cash-flows are worth target at
+// valuationDate, the [YieldCurveShifted] spread that reprices them.
+// The spread is bracketed starting from ±50% and refined with Brent's
+// method, tuned with an optional [SolverOptions].
+// Math details:
+//
+// \sum_i Value_i * DiscountFactor_curve(Years_i) * e^{-ZSpread * Years_i} = Target
+func (cfs CashFlows) ZSpread(target float64, curve YieldCurve, valuationDate time.Time, opts ...SolverOptions) (float64, error) {
+	if len(cfs) == 0 {
+		return math.NaN(), fmt.Errorf("ZSpread: %w", ErrEmptyCashFlows)
+	}
+	f := func(spread float64) float64 {
+		return cfs.NPVCurve(YieldCurveShifted{curve, spread}, valuationDate) - target
+	}
+	lo, hi := -0.5, 0.5
+	for f(lo)*f(hi) > 0 && hi < 10 {
+		lo, hi = lo*2, hi*2
+	}
+	if f(lo)*f(hi) > 0 {
+		return math.NaN(), fmt.Errorf("ZSpread: %w", ErrNoRootBracketed)
+	}
+	spread, err := brent(f, lo, hi, solverOptions(opts))
+	if err != nil {
+		return math.NaN(), fmt.Errorf("ZSpread: %w", err)
+	}
+	return spread, nil
+}
@@ -0,0 +1,115 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// YieldCurveZero
+// -----------------------------------------------------------------------------
+func TestYieldCurveZero(t *testing.T) {
+	c, err := NewYieldCurveZero([]float64{1, 2, 5}, []float64{0.02, 0.03, 0.04})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		years    float64
+		wantRate float64
+	}{
+		{0.5, 0.02},  // flat before the first pillar
+		{1, 0.02},    // on a pillar
+		{1.5, 0.025}, // linear between pillars
+		{3.5, 0.035}, // second segment
+		{5, 0.04},    // last pillar
+		{10, 0.04},   // flat after the last pillar
+	}
+	for _, tc := range tests {
+		if got := c.ZeroRate(tc.years); !almostEq(got, tc.wantRate, epsilon) {
+			t.Errorf("ZeroRate(%v) got %v, want %v", tc.years, got, tc.wantRate)
+		}
+		if got, want := c.DiscountFactor(tc.years), math.Exp(-tc.wantRate*tc.years); !almostEq(got, want, epsilon) {
+			t.Errorf("DiscountFactor(%v) got %v, want %v", tc.years, got, want)
+		}
+	}
+
+	if got := (YieldCurveZero{}).ZeroRate(1); got != 0 {
+		t.Errorf("empty curve ZeroRate got %v, want 0", got)
+	}
+}
+
+func TestNewYieldCurveZeroErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		years, rates []float64
+	}{
+		{"empty", nil, nil},
+		{"length mismatch", []float64{1, 2}, []float64{0.01}},
+		{"not increasing", []float64{1, 1}, []float64{0.01, 0.02}},
+	}
+	for _, tc := range tests {
+		if _, err := NewYieldCurveZero(tc.years, tc.rates); err == nil {
+			t.Errorf("%s: expected error, got nil", tc.name)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Rates as flat curves & YieldCurveShifted
+// -----------------------------------------------------------------------------
+func TestYieldCurveShifted(t *testing.T) {
+	var flat YieldCurve = RateAnnualContinuous{Value: 0.03}
+	shifted := YieldCurveShifted{Curve: flat, Spread: 0.01}
+	if got, want := shifted.DiscountFactor(2), math.Exp(-0.04*2); !almostEq(got, want, epsilon) {
+		t.Errorf("shifted DiscountFactor got %v, want %v", got, want)
+	}
+}
+
+func TestCashFlowsNPVCurveMatchesFlatRate(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.05}
+	cfs := CashFlows{
+		{-100, anchor},
+		{60, anchor.AddDate(1, 0, 0)},
+		{60, anchor.AddDate(2, 3, 0)},
+	}
+	if got, want := cfs.NPVCurve(r, anchor), cfs.NPV(r, anchor); !almostEq(got, want, epsilon) {
+		t.Errorf("NPVCurve got %v, want %v", got, want)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// ZSpread
+// -----------------------------------------------------------------------------
+func TestCashFlowsZSpread(t *testing.T) {
+	curve, _ := NewYieldCurveZero([]float64{1, 3, 10}, []float64{0.01, 0.025, 0.035})
+	cfs := CashFlows{
+		{5, anchor.AddDate(1, 0, 0)},
+		{5, anchor.AddDate(2, 0, 0)},
+		{105, anchor.AddDate(3, 6, 0)},
+	}
+	for _, spread := range []float64{0.0125, -0.004, 0.9} {
+		target := cfs.NPVCurve(YieldCurveShifted{curve, spread}, anchor)
+		got, err := cfs.ZSpread(target, curve, anchor)
+		if err != nil || !almostEq(got, spread, 1e-10) {
+			t.Errorf("ZSpread got %v, %v, want %v", got, err, spread)
+		}
+	}
+
+	// a flat curve's Z-spread is the difference to the IRR
+	flat := RateAnnualContinuous{Value: 0.03}
+	stream := append(CashFlows{{-100, anchor}}, cfs...)
+	irr, _ := stream.IRR()
+	got, err := cfs.ZSpread(100, flat, anchor)
+	if err != nil || !almostEq(got, irr.RateAnnualContinuous()-0.03, 1e-10) {
+		t.Errorf("ZSpread on a flat curve got %v, %v, want %v", got, err, irr.RateAnnualContinuous()-0.03)
+	}
+
+	if _, err := (CashFlows{}).ZSpread(100, curve, anchor); !errors.Is(err, ErrEmptyCashFlows) {
+		t.Errorf("empty ZSpread got %v, want ErrEmptyCashFlows", err)
+	}
+	if _, err := cfs.ZSpread(-1, curve, anchor); !errors.Is(err, ErrNoRootBracketed) {
+		t.Errorf("unreachable ZSpread got %v, want ErrNoRootBracketed", err)
+	}
+}
@@ -0,0 +1,146 @@
+package gofinance
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// IssueKind classifies a problem found by [CheckStatements].
+type IssueKind int
+
+const (
+	// IssueDuplicate marks a transaction identical to an earlier one:
+	// same day, Amount, Counterparty, and Description.
+	IssueDuplicate IssueKind = iota
+	// IssueSequenceGap marks a statement whose Sequence does not follow
+	// the previous statement's.
+	IssueSequenceGap
+	// IssueDateGap marks a statement that does not start where the
+	// previous one ended.
+	IssueDateGap
+	// IssueBalanceBreak marks a statement whose opening balance differs from
+	// the previous closing balance, or whose transactions do not add up
+	// from opening to closing balance.
+	IssueBalanceBreak
+	// IssueOutOfPeriod marks a transaction dated outside its statement.
+	IssueOutOfPeriod
+	// IssueAnomaly marks a transaction Amount far from the typical amounts.
+	IssueAnomaly
+)
+
+// DataQualityIssue is one finding of [CheckStatements].
+// Transaction is the index within the statement's Transactions,
+// -1 for issues about the statement as a whole.
+type DataQualityIssue struct {
+	Kind        IssueKind
+	Statement   int
+	Transaction int
+	Message     string
+}
+
+// DataQualityOptions tunes [CheckStatements].
+type DataQualityOptions struct {
+	// BalanceTolerance is the largest balance difference not reported,
+	// for example 0.005 to absorb rounding to cents.
+	BalanceTolerance float64
+
+	// AnomalyThreshold is the number of median absolute deviations from the
+	// median Amount beyond which a transaction is reported as an anomaly.
+	// Zero disables anomaly detection.
+	AnomalyThreshold float64
+}
+
+// CheckStatements runs data quality checks over imported statements before
+// their transactions reach the analytics: duplicate transactions, gaps in
+// statement sequence numbers and dates, breaks in balance continuity,
+// transactions dated outside their statement, and amount anomalies.
+// Statements are checked in Sequence order, the input is left untouched.
+// An empty report means no issues were found.
+func CheckStatements(statements []Statement, opts DataQualityOptions) []DataQualityIssue {
+	ordered := slices.Clone(statements)
+	slices.SortStableFunc(ordered, func(a, b Statement) int { return a.Sequence - b.Sequence })
+
+	var issues []DataQualityIssue
+	report := func(kind IssueKind, s Statement, i int, format string, args ...any) {
+		issues = append(issues, DataQualityIssue{kind, s.Sequence, i, fmt.Sprintf(format, args...)})
+	}
+
+	type key struct {
+		day          string
+		amount       float64
+		counterparty string
+		description  string
+	}
+	seen := make(map[key]bool)
+	var amounts []float64
+
+	for k, s := range ordered {
+		if k > 0 {
+			prev := ordered[k-1]
+			if s.Sequence != prev.Sequence+1 {
+				report(IssueSequenceGap, s, -1, "statement %d follows statement %d", s.Sequence, prev.Sequence)
+			}
+			if !s.Start.Equal(prev.End) {
+				report(IssueDateGap, s, -1, "statement starts %v, previous ended %v", s.Start, prev.End)
+			}
+			if math.Abs(s.OpeningBalance-prev.ClosingBalance) > opts.BalanceTolerance {
+				report(IssueBalanceBreak, s, -1, "opening balance %.2f, previous closing balance %.2f", s.OpeningBalance, prev.ClosingBalance)
+			}
+		}
+
+		balance := s.OpeningBalance
+		for i, t := range s.Transactions {
+			balance += t.Amount
+			amounts = append(amounts, t.Amount)
+			if t.Date.Before(s.Start) || t.Date.After(s.End) {
+				report(IssueOutOfPeriod, s, i, "transaction dated %v outside the statement", t.Date)
+			}
+			kk := key{t.Date.UTC().Format(time.DateOnly), t.Amount, t.Counterparty, t.Description}
+			if seen[kk] {
+				report(IssueDuplicate, s, i, "duplicate of an earlier transaction of %.2f on %s", t.Amount, kk.day)
+			}
+			seen[kk] = true
+		}
+		if math.Abs(balance-s.ClosingBalance) > opts.BalanceTolerance {
+			report(IssueBalanceBreak, s, -1, "transactions add up to %.2f, closing balance %.2f", balance, s.ClosingBalance)
+		}
+	}
+
+	if opts.AnomalyThreshold > 0 && len(amounts) > 2 {
+		median, mad := medianAbsoluteDeviation(amounts)
+		for _, s := range ordered {
+			for i, t := range s.Transactions {
+				if mad > 0 && math.Abs(t.Amount-median) > opts.AnomalyThreshold*mad {
+					report(IssueAnomaly, s, i, "amount %.2f is %.1f deviations from the median %.2f", t.Amount, math.Abs(t.Amount-median)/mad, median)
+				}
+			}
+		}
+	}
+	return issues
+}
+
+// median returns the median of xs, which it sorts in place.
+func median(xs []float64) float64 {
+	slices.Sort(xs)
+	n := len(xs)
+	if n%2 == 1 {
+		return xs[n/2]
+	}
+	return (xs[n/2-1] + xs[n/2]) / 2
+}
+
+// medianAbsoluteDeviation returns the median of xs and the median absolute
+// deviation from it, a spread measure robust to the outliers it looks for.
+// Math details:
+//
+// MAD = Median(|x_i - Median(x)|)
+func medianAbsoluteDeviation(xs []float64) (float64, float64) {
+	m := median(slices.Clone(xs))
+	dev := make([]float64, len(xs))
+	for i, x := range xs {
+		dev[i] = math.Abs(x - m)
+	}
+	return m, median(dev)
+}
@@ -0,0 +1,96 @@
+package gofinance
+
+import (
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// CheckStatements
+// -----------------------------------------------------------------------------
+func TestCheckStatementsClean(t *testing.T) {
+	jan, feb, mar := anchor, anchor.AddDate(0, 1, 0), anchor.AddDate(0, 2, 0)
+	statements := []Statement{
+		{Sequence: 2, Start: feb, End: mar, OpeningBalance: 150, ClosingBalance: 100, Transactions: Transactions{
+			{Date: feb.AddDate(0, 0, 1), Amount: -50, Counterparty: "rent"},
+		}},
+		{Sequence: 1, Start: jan, End: feb, OpeningBalance: 100, ClosingBalance: 150, Transactions: Transactions{
+			{Date: jan.AddDate(0, 0, 1), Amount: 70, Counterparty: "salary"},
+			{Date: jan.AddDate(0, 0, 2), Amount: -20, Counterparty: "shop"},
+		}},
+	}
+	if issues := CheckStatements(statements, DataQualityOptions{BalanceTolerance: 0.005, AnomalyThreshold: 10}); len(issues) != 0 {
+		t.Errorf("clean statements reported %+v", issues)
+	}
+}
+
+func TestCheckStatementsIssues(t *testing.T) {
+	jan, feb := anchor, anchor.AddDate(0, 1, 0)
+	mar, apr := anchor.AddDate(0, 2, 0), anchor.AddDate(0, 3, 0)
+	day := func(m time.Time, d int) time.Time { return m.AddDate(0, 0, d) }
+	statements := []Statement{
+		{Sequence: 1, Start: jan, End: feb, OpeningBalance: 0, ClosingBalance: 30, Transactions: Transactions{
+			{Date: day(jan, 1), Amount: 10, Counterparty: "a"},
+			{Date: day(jan, 1).Add(time.Hour), Amount: 10, Counterparty: "a"}, // duplicate, same day
+			{Date: day(jan, 3), Amount: 12, Counterparty: "b"},                // breaks the closing balance
+		}},
+		// sequence 2 missing, March starts after the February gap
+		{Sequence: 3, Start: mar, End: apr, OpeningBalance: 31, ClosingBalance: 5052, Transactions: Transactions{
+			{Date: day(mar, 2), Amount: 11, Counterparty: "c"},
+			{Date: day(mar, 3), Amount: 5000, Counterparty: "d"}, // anomaly
+			{Date: day(apr, 5), Amount: 10, Counterparty: "e"},   // outside the statement
+		}},
+	}
+	issues := CheckStatements(statements, DataQualityOptions{AnomalyThreshold: 5})
+
+	want := map[IssueKind]int{
+		IssueDuplicate:    1,
+		IssueSequenceGap:  1,
+		IssueDateGap:      1,
+		IssueBalanceBreak: 2, // closing of 1, opening of 3
+		IssueOutOfPeriod:  1,
+		IssueAnomaly:      1,
+	}
+	got := make(map[IssueKind]int)
+	for _, is := range issues {
+		got[is.Kind]++
+		if is.Message == "" {
+			t.Errorf("issue without message: %+v", is)
+		}
+	}
+	for kind, n := range want {
+		if got[kind] != n {
+			t.Errorf("issue kind %d: got %d, want %d (all issues: %+v)", kind, got[kind], n, issues)
+		}
+	}
+	for _, is := range issues {
+		if is.Kind == IssueAnomaly && (is.Statement != 3 || is.Transaction != 1) {
+			t.Errorf("anomaly located at statement %d transaction %d, want 3/1", is.Statement, is.Transaction)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Transactions & robust statistics
+// -----------------------------------------------------------------------------
+func TestTransactionsCashFlows(t *testing.T) {
+	ts := Transactions{{Date: anchor, Amount: -5}, {Date: anchor.AddDate(0, 0, 1), Amount: 7}}
+	cfs := ts.CashFlows()
+	if len(cfs) != 2 || cfs[0].Value != -5 || !cfs[1].Date.Equal(anchor.AddDate(0, 0, 1)) {
+		t.Errorf("Transactions.CashFlows got %+v", cfs)
+	}
+}
+
+func TestMedianAbsoluteDeviation(t *testing.T) {
+	xs := []float64{1, 2, 3, 4, 100}
+	m, mad := medianAbsoluteDeviation(xs)
+	if m != 3 || mad != 1 {
+		t.Errorf("medianAbsoluteDeviation got (%f, %f), want (3, 1)", m, mad)
+	}
+	if xs[4] != 100 {
+		t.Error("medianAbsoluteDeviation reordered its input")
+	}
+	if got := median([]float64{4, 1, 3, 2}); got != 2.5 {
+		t.Errorf("median even length got %f, want 2.5", got)
+	}
+}
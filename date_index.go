@@ -0,0 +1,79 @@
+package gofinance
+
+import (
+	"slices"
+	"sort"
+	"time"
+)
+
+// DateIndex is a read-only view over a dated collection that answers
+// range queries by date in O(log n), for example over millions of [CashFlow].
+//
+// It keeps its own copy of the items, stably sorted by date, so later changes
+// to the source collection are not reflected. Items sharing a date keep their
+// original order.
+type DateIndex[T any] struct {
+	items []T
+	dates []time.Time
+}
+
+// NewDateIndex builds a [DateIndex] over items, reading each item's date with dateOf.
+//
+//	idx := NewDateIndex(cfs, func(cf CashFlow) time.Time { return cf.Date })
+func NewDateIndex[T any](items []T, dateOf func(T) time.Time) *DateIndex[T] {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b T) int {
+		return dateOf(a).Compare(dateOf(b))
+	})
+	dates := make([]time.Time, len(sorted))
+	for i, item := range sorted {
+		dates[i] = dateOf(item)
+	}
+	return &DateIndex[T]{sorted, dates}
+}
+
+// Index builds a [DateIndex] over the cash-flows.
+func (cfs CashFlows) Index() *DateIndex[CashFlow] {
+	return NewDateIndex(cfs, func(cf CashFlow) time.Time { return cf.Date })
+}
+
+// Len returns the number of indexed items.
+func (idx *DateIndex[T]) Len() int {
+	return len(idx.items)
+}
+
+// firstNotBefore returns the position of the first item dated on or after date.
+func (idx *DateIndex[T]) firstNotBefore(date time.Time) int {
+	return sort.Search(len(idx.dates), func(i int) bool { return !idx.dates[i].Before(date) })
+}
+
+// firstAfter returns the position of the first item dated strictly after date.
+func (idx *DateIndex[T]) firstAfter(date time.Time) int {
+	return sort.Search(len(idx.dates), func(i int) bool { return idx.dates[i].After(date) })
+}
+
+// Between returns the items dated from from to to, both inclusive, in date order.
+// The returned slice shares memory with the index and must not be modified.
+func (idx *DateIndex[T]) Between(from, to time.Time) []T {
+	lo, hi := idx.firstNotBefore(from), idx.firstAfter(to)
+	if lo >= hi {
+		return nil
+	}
+	return idx.items[lo:hi:hi]
+}
+
+// At returns the items dated exactly at date.
+// The returned slice shares memory with the index and must not be modified.
+func (idx *DateIndex[T]) At(date time.Time) []T {
+	return idx.Between(date, date)
+}
+
+// LatestBefore returns the last item dated strictly before date.
+// ok is false when there is no such item.
+func (idx *DateIndex[T]) LatestBefore(date time.Time) (item T, ok bool) {
+	i := idx.firstNotBefore(date)
+	if i == 0 {
+		return item, false
+	}
+	return idx.items[i-1], true
+}
@@ -0,0 +1,80 @@
+package gofinance
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// DateIndex over CashFlows
+// -----------------------------------------------------------------------------
+func TestDateIndex(t *testing.T) {
+	d := func(days int) time.Time { return anchor.AddDate(0, 0, days) }
+	cfs := CashFlows{
+		{Value: 4, Date: d(30)},
+		{Value: 1, Date: d(0)},
+		{Value: 2, Date: d(10)},
+		{Value: 3, Date: d(10)},
+		{Value: 5, Date: d(60)},
+	}
+	idx := cfs.Index()
+	cfs[0].Value = 999 // the index keeps its own copy
+
+	if idx.Len() != 5 {
+		t.Errorf("Len got %d, want 5", idx.Len())
+	}
+
+	values := func(got CashFlows) []float64 {
+		v := make([]float64, len(got))
+		for i, cf := range got {
+			v[i] = cf.Value
+		}
+		return v
+	}
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		want     []float64
+	}{
+		{"all", d(-1), d(100), []float64{1, 2, 3, 4, 5}},
+		{"inclusive bounds", d(10), d(30), []float64{2, 3, 4}},
+		{"empty window", d(11), d(29), nil},
+		{"reversed bounds", d(30), d(10), nil},
+	}
+	for _, tc := range tests {
+		if got := values(idx.Between(tc.from, tc.to)); !slices.Equal(got, tc.want) {
+			t.Errorf("%s: Between got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	if got := values(idx.At(d(10))); !slices.Equal(got, []float64{2, 3}) {
+		t.Errorf("At got %v, want [2 3] in insertion order", got)
+	}
+
+	if cf, ok := idx.LatestBefore(d(30)); !ok || cf.Value != 3 {
+		t.Errorf("LatestBefore got (%+v, %v), want value 3", cf, ok)
+	}
+	if cf, ok := idx.LatestBefore(d(1000)); !ok || cf.Value != 5 {
+		t.Errorf("LatestBefore after all got (%+v, %v), want value 5", cf, ok)
+	}
+	if _, ok := idx.LatestBefore(d(0)); ok {
+		t.Error("LatestBefore first date should find nothing")
+	}
+}
+
+func TestDateIndexGeneric(t *testing.T) {
+	type fixing struct {
+		on    time.Time
+		value float64
+	}
+	idx := NewDateIndex([]fixing{
+		{anchor.AddDate(0, 2, 0), 0.02},
+		{anchor.AddDate(0, 1, 0), 0.01},
+	}, func(f fixing) time.Time { return f.on })
+
+	if f, ok := idx.LatestBefore(anchor.AddDate(0, 1, 15)); !ok || f.value != 0.01 {
+		t.Errorf("LatestBefore got (%+v, %v), want 0.01", f, ok)
+	}
+}
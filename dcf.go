@@ -0,0 +1,98 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DCF values a firm from its forecast free cash-flows to the firm plus a
+// terminal value at the date of the last forecast flow, computed either with
+// perpetual TerminalGrowth of the final year's cash-flow (Gordon growth) or,
+// if ExitMultiple is set, as ExitMultiple times TerminalMetric,
+// for example final-year EBITDA.
+type DCF struct {
+	Forecast       CashFlows
+	TerminalGrowth float64
+	ExitMultiple   float64
+	TerminalMetric float64
+}
+
+// DCFValuation breaks a [DCF] enterprise value into the present value of the
+// explicit forecast and of the terminal value. TerminalValue is undiscounted,
+// as of the last forecast date.
+type DCFValuation struct {
+	EnterpriseValue float64
+	Explicit        float64
+	Terminal        float64
+	TerminalValue   float64
+}
+
+// Value discounts the forecast and the terminal value on curve, to which any
+// [Rate] can be passed as a flat curve. The Gordon growth terminal value
+// discounts at the curve's one-year forward rate after the last forecast date,
+// and grows the cash-flows of the final twelve months of the forecast.
+// Math details:
+//
+// TerminalValue = FCF_{final year} * (1 + g) / (k - g),   k = DiscountFactor(T) / DiscountFactor(T + 1) - 1
+//
+// TerminalValue = ExitMultiple * TerminalMetric   (exit multiple method)
+//
+// EnterpriseValue = \sum_i FCF_i * DiscountFactor(t_i) + TerminalValue * DiscountFactor(T)
+func (d DCF) Value(curve YieldCurve, valuationDate time.Time) (DCFValuation, error) {
+	if len(d.Forecast) == 0 {
+		return DCFValuation{}, fmt.Errorf("DCF requires a forecast: %w", ErrInsufficientData)
+	}
+	last := d.Forecast[0].Date
+	for _, cf := range d.Forecast {
+		if cf.Date.After(last) {
+			last = cf.Date
+		}
+	}
+	t := yearsBetween(valuationDate, last)
+
+	var tv float64
+	if d.ExitMultiple != 0 {
+		tv = d.ExitMultiple * d.TerminalMetric
+	} else {
+		k := curve.DiscountFactor(t)/curve.DiscountFactor(t+1) - 1
+		if k <= d.TerminalGrowth {
+			return DCFValuation{}, errors.New("DCF requires the discount rate to exceed terminal growth")
+		}
+		finalYear := 0.0
+		yearBefore := last.AddDate(-1, 0, 0)
+		for _, cf := range d.Forecast {
+			if cf.Date.After(yearBefore) {
+				finalYear += cf.Value
+			}
+		}
+		tv = finalYear * (1 + d.TerminalGrowth) / (k - d.TerminalGrowth)
+	}
+
+	v := DCFValuation{
+		Explicit:      d.Forecast.NPVCurve(curve, valuationDate),
+		Terminal:      tv * curve.DiscountFactor(t),
+		TerminalValue: tv,
+	}
+	v.EnterpriseValue = v.Explicit + v.Terminal
+	return v, nil
+}
+
+// Sensitivity returns the enterprise value over a grid of terminal growth
+// rates and discount rates, values[i][j] using growths[i] and rates[j].
+// Grid points where the rate does not exceed growth are an error.
+func (d DCF) Sensitivity(growths []float64, rates []Rate, valuationDate time.Time) ([][]float64, error) {
+	values := make([][]float64, len(growths))
+	for i, g := range growths {
+		values[i] = make([]float64, len(rates))
+		d.TerminalGrowth = g
+		for j, r := range rates {
+			v, err := d.Value(r, valuationDate)
+			if err != nil {
+				return nil, err
+			}
+			values[i][j] = v.EnterpriseValue
+		}
+	}
+	return values, nil
+}
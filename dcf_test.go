@@ -0,0 +1,59 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// DCF
+// -----------------------------------------------------------------------------
+func TestDCF(t *testing.T) {
+	r := RateEffective{0.10, 1}
+	forecast := CashFlows{
+		{100, anchor.AddDate(1, 0, 0)},
+		{110, anchor.AddDate(2, 0, 0)},
+		{121, anchor.AddDate(3, 0, 0)},
+	}
+	d := DCF{Forecast: forecast, TerminalGrowth: 0.03}
+	v, err := d.Value(r, anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	df3 := r.DiscountFactor(forecast[2].YearsFrom(anchor))
+	wantTV := 121 * 1.03 / (0.10 - 0.03)
+	if !almostEq(v.TerminalValue, wantTV, 1e-6) || !almostEq(v.Terminal, wantTV*df3, 1e-6) {
+		t.Errorf("terminal value got %f (PV %f), want %f", v.TerminalValue, v.Terminal, wantTV)
+	}
+	if !almostEq(v.Explicit, forecast.NPV(r, anchor), 1e-9) || !almostEq(v.EnterpriseValue, v.Explicit+v.Terminal, 1e-12) {
+		t.Errorf("breakdown got %+v", v)
+	}
+
+	exit := DCF{Forecast: forecast, ExitMultiple: 8, TerminalMetric: 150}
+	v, _ = exit.Value(r, anchor)
+	if !almostEq(v.TerminalValue, 1200, 1e-12) {
+		t.Errorf("exit multiple terminal value got %f, want 1200", v.TerminalValue)
+	}
+
+	if _, err := (DCF{Forecast: forecast, TerminalGrowth: 0.2}).Value(r, anchor); err == nil {
+		t.Error("DCF accepted growth above the discount rate")
+	}
+	if _, err := (DCF{}).Value(r, anchor); err == nil {
+		t.Error("DCF accepted an empty forecast")
+	}
+}
+
+func TestDCFSensitivity(t *testing.T) {
+	d := DCF{Forecast: CashFlows{{100, anchor.AddDate(1, 0, 0)}}}
+	grid, err := d.Sensitivity([]float64{0.01, 0.03}, []Rate{RateEffective{0.08, 1}, RateEffective{0.12, 1}}, anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(grid) != 2 || len(grid[0]) != 2 {
+		t.Fatalf("Sensitivity got %v", grid)
+	}
+	if !(grid[1][0] > grid[0][0] && grid[0][0] > grid[0][1]) {
+		t.Errorf("Sensitivity not increasing in growth and decreasing in rate: %v", grid)
+	}
+	one, _ := DCF{Forecast: d.Forecast, TerminalGrowth: 0.03}.Value(RateEffective{0.12, 1}, anchor)
+	if grid[1][1] != one.EnterpriseValue {
+		t.Errorf("Sensitivity[1][1] got %f, want %f", grid[1][1], one.EnterpriseValue)
+	}
+}
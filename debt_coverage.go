@@ -0,0 +1,129 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DebtServicePeriod is one payment date of a [DebtSchedule]:
+// the Interest and the Principal repaid on Date.
+type DebtServicePeriod struct {
+	Date      time.Time
+	Interest  float64
+	Principal float64
+}
+
+// DebtSchedule is the debt service of a loan in increasing date order,
+// the loan being repaid by the sum of its Principal payments.
+type DebtSchedule []DebtServicePeriod
+
+// CoveragePeriod holds the project-finance ratios of one [DebtSchedule]
+// period: CFADS is the cash-flow available for debt service of the period,
+// Outstanding the debt before the period's repayment.
+type CoveragePeriod struct {
+	Date          time.Time
+	CFADS         float64
+	DebtService   float64
+	Outstanding   float64
+	DSCR          float64
+	InterestCover float64
+	LLCR          float64
+	PLCR          float64
+}
+
+// Coverage computes the ratios of every period, CFADS being the sum of
+// the operating cash-flows after the previous payment date, up to and
+// including the period's Date. Loan and project life cover ratios
+// discount CFADS at r, usually the cost of debt, to the period's Date:
+// LLCR up to the last payment, PLCR to the last operating cash-flow.
+// Math details:
+//
+// DSCR = CFADS / (Interest + Principal),   InterestCover = CFADS / Interest
+//
+// LLCR_i = \sum_{j >= i, j in loan life} CFADS_j * DF(t_j - t_i) / Outstanding_i
+//
+// PLCR_i = \sum_{t >= t_{i-1}} CFADS(t) * DF(t - t_i) / Outstanding_i
+func (s DebtSchedule) Coverage(cfads CashFlows, r Rate) ([]CoveragePeriod, error) {
+	if len(s) == 0 {
+		return nil, fmt.Errorf("DebtSchedule.Coverage requires at least one period: %w", ErrInsufficientData)
+	}
+	outstanding := 0.0
+	for i, p := range s {
+		if i > 0 && !p.Date.After(s[i-1].Date) {
+			return nil, errors.New("DebtSchedule.Coverage requires periods in increasing date order")
+		}
+		outstanding += p.Principal
+	}
+
+	periods := make([]CoveragePeriod, len(s))
+	for i, p := range s {
+		periods[i] = CoveragePeriod{
+			Date:        p.Date,
+			DebtService: p.Interest + p.Principal,
+			Outstanding: outstanding,
+		}
+		for _, cf := range cfads {
+			if !cf.Date.After(p.Date) && (i == 0 || cf.Date.After(s[i-1].Date)) {
+				periods[i].CFADS += cf.Value
+			}
+		}
+		periods[i].DSCR = periods[i].CFADS / periods[i].DebtService
+		periods[i].InterestCover = periods[i].CFADS / p.Interest
+		outstanding -= p.Principal
+	}
+
+	for i := range periods {
+		loan, project := 0.0, 0.0
+		for j := i; j < len(periods); j++ {
+			loan += periods[j].CFADS * r.DiscountFactor(yearsBetween(periods[i].Date, periods[j].Date))
+		}
+		for _, cf := range cfads {
+			if i == 0 || cf.Date.After(s[i-1].Date) {
+				project += cf.Value * r.DiscountFactor(yearsBetween(periods[i].Date, cf.Date))
+			}
+		}
+		periods[i].LLCR = loan / periods[i].Outstanding
+		periods[i].PLCR = project / periods[i].Outstanding
+	}
+	return periods, nil
+}
+
+// Covenant sets minimum levels of the coverage ratios, a zero level
+// leaving that ratio untested.
+type Covenant struct {
+	MinDSCR          float64
+	MinInterestCover float64
+	MinLLCR          float64
+	MinPLCR          float64
+}
+
+// CovenantBreach is a ratio falling below its covenant level on Date.
+type CovenantBreach struct {
+	Date      time.Time
+	Ratio     string
+	Value     float64
+	Threshold float64
+}
+
+// Breaches returns every ratio of periods below its covenant level,
+// in date order.
+func (c Covenant) Breaches(periods []CoveragePeriod) []CovenantBreach {
+	var breaches []CovenantBreach
+	for _, p := range periods {
+		for _, test := range []struct {
+			ratio            string
+			value, threshold float64
+		}{
+			{"DSCR", p.DSCR, c.MinDSCR},
+			{"InterestCover", p.InterestCover, c.MinInterestCover},
+			{"LLCR", p.LLCR, c.MinLLCR},
+			{"PLCR", p.PLCR, c.MinPLCR},
+		} {
+			if test.threshold != 0 && test.value < test.threshold {
+				breaches = append(breaches, CovenantBreach{p.Date, test.ratio, test.value, test.threshold})
+			}
+		}
+	}
+	return breaches
+}
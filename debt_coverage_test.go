@@ -0,0 +1,94 @@
+package gofinance
+
+import (
+	"testing"
+	"time"
+)
+
+// testDebt is a loan of 300 repaid in three annual instalments of 100,
+// serviced from operating cash-flows that run a year past the loan.
+func testDebt() (DebtSchedule, CashFlows) {
+	year := func(n int) time.Time { return anchor.AddDate(n, 0, 0) }
+	schedule := DebtSchedule{
+		{year(1), 30, 100},
+		{year(2), 20, 100},
+		{year(3), 10, 100},
+	}
+	cfads := CashFlows{
+		{150, year(1)},
+		{90, year(2).AddDate(0, -6, 0)},
+		{50, year(2)},
+		{120, year(3)},
+		{100, year(4)},
+	}
+	return schedule, cfads
+}
+
+// -----------------------------------------------------------------------------
+// DebtSchedule.Coverage
+// -----------------------------------------------------------------------------
+func TestDebtCoverage(t *testing.T) {
+	schedule, cfads := testDebt()
+	periods, err := schedule.Coverage(cfads, RateEffective{0, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []CoveragePeriod{
+		{CFADS: 150, DebtService: 130, Outstanding: 300, DSCR: 150.0 / 130, InterestCover: 5, LLCR: 410.0 / 300, PLCR: 510.0 / 300},
+		{CFADS: 140, DebtService: 120, Outstanding: 200, DSCR: 140.0 / 120, InterestCover: 7, LLCR: 260.0 / 200, PLCR: 360.0 / 200},
+		{CFADS: 120, DebtService: 110, Outstanding: 100, DSCR: 120.0 / 110, InterestCover: 12, LLCR: 1.2, PLCR: 2.2},
+	}
+	for i, w := range want {
+		p := periods[i]
+		if !p.Date.Equal(schedule[i].Date) || !almostEq(p.CFADS, w.CFADS, epsilon) ||
+			!almostEq(p.DebtService, w.DebtService, epsilon) || !almostEq(p.Outstanding, w.Outstanding, epsilon) ||
+			!almostEq(p.DSCR, w.DSCR, epsilon) || !almostEq(p.InterestCover, w.InterestCover, epsilon) ||
+			!almostEq(p.LLCR, w.LLCR, epsilon) || !almostEq(p.PLCR, w.PLCR, epsilon) {
+			t.Errorf("period %d got %+v, want %+v", i, p, w)
+		}
+	}
+
+	// discounting lowers the life cover ratios
+	r := RateEffective{0.08, 1}
+	discounted, _ := schedule.Coverage(cfads, r)
+	llcr := 0.0
+	loanLife := CashFlows{{150, anchor.AddDate(1, 0, 0)}, {140, anchor.AddDate(2, 0, 0)}, {120, anchor.AddDate(3, 0, 0)}}
+	for _, cf := range loanLife {
+		llcr += cf.PresentValue(r, anchor.AddDate(1, 0, 0))
+	}
+	if got := discounted[0].LLCR; !almostEq(got, llcr/300, epsilon) {
+		t.Errorf("discounted LLCR got %v, want %v", got, llcr/300)
+	}
+	if discounted[0].PLCR >= periods[0].PLCR || discounted[0].DSCR != periods[0].DSCR {
+		t.Errorf("discounted PLCR %v, DSCR %v", discounted[0].PLCR, discounted[0].DSCR)
+	}
+
+	if _, err := (DebtSchedule{}).Coverage(cfads, r); err == nil {
+		t.Error("empty schedule: expected error")
+	}
+	if _, err := (DebtSchedule{schedule[1], schedule[0]}).Coverage(cfads, r); err == nil {
+		t.Error("unordered schedule: expected error")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Covenant.Breaches
+// -----------------------------------------------------------------------------
+func TestCovenantBreaches(t *testing.T) {
+	schedule, cfads := testDebt()
+	periods, _ := schedule.Coverage(cfads, RateEffective{0, 1})
+
+	breaches := Covenant{MinDSCR: 1.15, MinLLCR: 1.3}.Breaches(periods)
+	if len(breaches) != 2 {
+		t.Fatalf("Breaches got %+v, want DSCR and LLCR in the last period", breaches)
+	}
+	for i, ratio := range []string{"DSCR", "LLCR"} {
+		b := breaches[i]
+		if b.Ratio != ratio || !b.Date.Equal(schedule[2].Date) || b.Value != periods[2].DSCR && b.Value != periods[2].LLCR {
+			t.Errorf("breach %d got %+v, want %s", i, b, ratio)
+		}
+	}
+	if got := (Covenant{}).Breaches(periods); got != nil {
+		t.Errorf("no covenant got %+v, want none", got)
+	}
+}
@@ -0,0 +1,179 @@
+package gofinance
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+	"time"
+)
+
+// RevolvingDebt is a credit card or other revolving balance with interest
+// at APR accrued and compounded daily on a 365-day year. Its minimum
+// payment is MinimumRate of the balance after interest, at least
+// MinimumFloor, and never more than the balance.
+type RevolvingDebt struct {
+	Name         string
+	Balance      float64
+	APR          float64
+	MinimumRate  float64
+	MinimumFloor float64
+}
+
+// minimum returns the minimum payment on balance.
+func (d RevolvingDebt) minimum(balance float64) float64 {
+	return math.Min(balance, math.Max(d.MinimumFloor, d.MinimumRate*balance))
+}
+
+// PayoffStrategy decides where a monthly budget beyond the minimum
+// payments goes.
+type PayoffStrategy int
+
+const (
+	// PayoffAvalanche pays the extra to the highest APR first,
+	// the cheapest in interest.
+	PayoffAvalanche PayoffStrategy = iota
+	// PayoffSnowball pays the extra to the smallest balance first,
+	// clearing debts soonest.
+	PayoffSnowball
+	// PayoffFixed pays each debt a fixed share of the budget in proportion
+	// to its opening balance, or its minimum if higher, and does not roll
+	// the payments of cleared debts over to the others.
+	PayoffFixed
+)
+
+// DebtPayoff is when one debt is cleared and the interest it cost.
+type DebtPayoff struct {
+	Name     string
+	Date     time.Time
+	Interest float64
+}
+
+// PayoffPlan is the outcome of paying debts down with a strategy: the
+// Date the last one is cleared after Months payments, the TotalInterest
+// and TotalPaid, and the payoff of each debt in the order given.
+type PayoffPlan struct {
+	Strategy      PayoffStrategy
+	Date          time.Time
+	Months        int
+	TotalInterest float64
+	TotalPaid     float64
+	Debts         []DebtPayoff
+}
+
+// maxPayoffMonths caps a plan at a century of payments.
+const maxPayoffMonths = 1200
+
+// PlanPayoff pays debts with budget a month, the first payment one month
+// after start, until they are all cleared. Each month interest accrues
+// daily for the days of the month, every open debt gets its minimum
+// payment, and the rest of the budget goes where strategy says.
+// It fails when the budget does not cover the minimum payments or the
+// debts are not cleared within a hundred years.
+// Math details:
+//
+// Interest = Balance * ((1 + APR / 365)^{Days} - 1)
+func PlanPayoff(debts []RevolvingDebt, budget float64, strategy PayoffStrategy, start time.Time) (PayoffPlan, error) {
+	if len(debts) == 0 {
+		return PayoffPlan{}, errors.New("PlanPayoff requires debts")
+	}
+	if strategy < PayoffAvalanche || strategy > PayoffFixed {
+		return PayoffPlan{}, errors.New("PlanPayoff: unknown strategy")
+	}
+	balances := make([]float64, len(debts))
+	fixed := make([]float64, len(debts))
+	total := 0.0
+	for i, d := range debts {
+		if d.Balance < 0 || d.APR < 0 || d.MinimumRate < 0 || d.MinimumFloor < 0 {
+			return PayoffPlan{}, errors.New("PlanPayoff requires non-negative balances, APRs, and minimums")
+		}
+		balances[i] = d.Balance
+		total += d.Balance
+	}
+	for i, d := range debts {
+		if total > 0 {
+			fixed[i] = budget * d.Balance / total
+		}
+	}
+
+	// order in which extra payments are targeted
+	order := make([]int, len(debts))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		if strategy == PayoffSnowball {
+			return cmp.Compare(debts[a].Balance, debts[b].Balance)
+		}
+		return cmp.Compare(debts[b].APR, debts[a].APR)
+	})
+
+	plan := PayoffPlan{Strategy: strategy, Date: start, Debts: make([]DebtPayoff, len(debts))}
+	for i, d := range debts {
+		plan.Debts[i] = DebtPayoff{Name: d.Name, Date: start}
+	}
+	open := total > 0
+	for month := 1; open; month++ {
+		if month > maxPayoffMonths {
+			return PayoffPlan{}, errors.New("PlanPayoff: debts are not cleared within a hundred years")
+		}
+		date := addMonths(start, month)
+		days := date.Sub(addMonths(start, month-1)).Hours() / 24
+		payments := make([]float64, len(debts))
+		left := budget
+		for i, d := range debts {
+			if balances[i] == 0 {
+				continue
+			}
+			interest := balances[i] * math.Expm1(days*math.Log1p(d.APR/365))
+			balances[i] += interest
+			plan.Debts[i].Interest += interest
+			plan.TotalInterest += interest
+			payments[i] = d.minimum(balances[i])
+			if strategy == PayoffFixed {
+				payments[i] = math.Min(balances[i], math.Max(payments[i], fixed[i]))
+			}
+			left -= payments[i]
+		}
+		if left < -1e-9 {
+			return PayoffPlan{}, errors.New("PlanPayoff: budget does not cover the minimum payments")
+		}
+		if strategy != PayoffFixed {
+			for _, i := range order {
+				extra := math.Min(math.Max(left, 0), balances[i]-payments[i])
+				payments[i] += extra
+				left -= extra
+			}
+		}
+		open = false
+		for i, p := range payments {
+			if balances[i] == 0 {
+				continue
+			}
+			balances[i] -= p
+			plan.TotalPaid += p
+			if balances[i] <= 1e-9 {
+				balances[i] = 0
+				plan.Debts[i].Date = date
+			} else {
+				open = true
+			}
+		}
+		plan.Date, plan.Months = date, month
+	}
+	return plan, nil
+}
+
+// ComparePayoffs plans the payoff of debts with each strategy, in the
+// order avalanche, snowball, fixed.
+func ComparePayoffs(debts []RevolvingDebt, budget float64, start time.Time) ([]PayoffPlan, error) {
+	plans := make([]PayoffPlan, 0, 3)
+	for _, s := range []PayoffStrategy{PayoffAvalanche, PayoffSnowball, PayoffFixed} {
+		plan, err := PlanPayoff(debts, budget, s, start)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
@@ -0,0 +1,97 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// Single debt
+// -----------------------------------------------------------------------------
+func TestPlanPayoffSingle(t *testing.T) {
+	plan, err := PlanPayoff([]RevolvingDebt{{Name: "card", Balance: 1000}}, 100, PayoffAvalanche, anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.Months != 10 || !plan.Date.Equal(anchor.AddDate(0, 10, 0)) || plan.TotalInterest != 0 || !almostEq(plan.TotalPaid, 1000, epsilon) {
+		t.Errorf("interest-free plan got %+v", plan)
+	}
+
+	// cleared in January: 31 days of daily compounding
+	plan, _ = PlanPayoff([]RevolvingDebt{{Name: "card", Balance: 1000, APR: 0.2}}, 2000, PayoffAvalanche, anchor)
+	interest := 1000 * (math.Pow(1+0.2/365, 31) - 1)
+	if plan.Months != 1 || !almostEq(plan.TotalInterest, interest, epsilon) || !almostEq(plan.TotalPaid, 1000+interest, epsilon) {
+		t.Errorf("one-month plan got %+v, want interest %f", plan, interest)
+	}
+	if !almostEq(plan.Debts[0].Interest, interest, epsilon) || !plan.Debts[0].Date.Equal(anchor.AddDate(0, 1, 0)) {
+		t.Errorf("debt payoff got %+v", plan.Debts[0])
+	}
+
+	// payments from January 31 fall on the last day of shorter months
+	endOfMonth := anchor.AddDate(0, 0, 30)
+	plan, _ = PlanPayoff([]RevolvingDebt{{Name: "card", Balance: 300}}, 100, PayoffAvalanche, endOfMonth)
+	if want := anchor.AddDate(0, 3, 29); !plan.Date.Equal(want) {
+		t.Errorf("month-end plan cleared on %s, want %s", plan.Date.Format(time.DateOnly), want.Format(time.DateOnly))
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Strategies
+// -----------------------------------------------------------------------------
+func TestComparePayoffs(t *testing.T) {
+	debts := []RevolvingDebt{
+		{Name: "store", Balance: 800, APR: 0.12, MinimumRate: 0.02, MinimumFloor: 25},
+		{Name: "card", Balance: 5000, APR: 0.24, MinimumRate: 0.02, MinimumFloor: 25},
+		{Name: "line", Balance: 3000, APR: 0.18, MinimumRate: 0.02, MinimumFloor: 25},
+	}
+	plans, err := ComparePayoffs(debts, 400, anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	avalanche, snowball, fixed := plans[0], plans[1], plans[2]
+	if avalanche.Strategy != PayoffAvalanche || snowball.Strategy != PayoffSnowball || fixed.Strategy != PayoffFixed {
+		t.Fatalf("strategies out of order: %v", plans)
+	}
+	// avalanche pays the least interest, snowball clears the smallest debt first
+	if !(avalanche.TotalInterest < snowball.TotalInterest && avalanche.TotalInterest < fixed.TotalInterest) {
+		t.Errorf("interest got avalanche %f, snowball %f, fixed %f", avalanche.TotalInterest, snowball.TotalInterest, fixed.TotalInterest)
+	}
+	if !snowball.Debts[0].Date.Before(avalanche.Debts[0].Date) || !avalanche.Debts[1].Date.Before(snowball.Debts[1].Date) {
+		t.Errorf("payoff order got snowball %v, avalanche %v", snowball.Debts, avalanche.Debts)
+	}
+	for _, p := range plans {
+		interest := 0.0
+		for _, d := range p.Debts {
+			interest += d.Interest
+			if d.Date.After(p.Date) {
+				t.Errorf("strategy %d: %s cleared after the plan ends", p.Strategy, d.Name)
+			}
+		}
+		if !almostEq(interest, p.TotalInterest, 1e-9) || !almostEq(p.TotalPaid, 8800+p.TotalInterest, 1e-9) {
+			t.Errorf("strategy %d totals got %+v", p.Strategy, p)
+		}
+	}
+	// a bigger budget finishes sooner and cheaper
+	faster, _ := PlanPayoff(debts, 800, PayoffAvalanche, anchor)
+	if faster.Months >= avalanche.Months || faster.TotalInterest >= avalanche.TotalInterest {
+		t.Errorf("bigger budget got %+v", faster)
+	}
+}
+
+func TestPlanPayoffErrors(t *testing.T) {
+	debts := []RevolvingDebt{{Balance: 5000, APR: 0.2, MinimumRate: 0.03, MinimumFloor: 25}}
+	if _, err := PlanPayoff(debts, 100, PayoffAvalanche, anchor); err == nil {
+		t.Error("PlanPayoff accepted a budget below the minimum payment")
+	}
+	debts = []RevolvingDebt{{Balance: 10000, APR: 0.3}}
+	if _, err := PlanPayoff(debts, 100, PayoffSnowball, anchor); err == nil {
+		t.Error("PlanPayoff accepted a budget below the interest")
+	}
+	if _, err := PlanPayoff(nil, 100, PayoffSnowball, anchor); err == nil {
+		t.Error("PlanPayoff accepted no debts")
+	}
+	if _, err := PlanPayoff(debts, 1e6, PayoffStrategy(7), anchor); err == nil {
+		t.Error("PlanPayoff accepted an unknown strategy")
+	}
+}
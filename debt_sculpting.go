@@ -0,0 +1,53 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// SculptDebt sizes the largest loan, drawn at close, that the cash-flows
+// available for debt service can repay at exactly the target DSCR in
+// every period, each cash-flow of cfads being one repayment date.
+// It returns the loan amount and its sculpted schedule, interest accruing
+// at r between payment dates, so that [DebtSchedule.Coverage] of the
+// schedule gives the target DSCR throughout.
+// Math details:
+//
+// DebtService_i = CFADS_i / TargetDSCR,   Debt = \sum_i DebtService_i * DF(t_i)
+//
+// Interest_i = Outstanding_{i-1} * (DF(t_{i-1}) / DF(t_i) - 1),   Principal_i = DebtService_i - Interest_i
+func SculptDebt(cfads CashFlows, r Rate, targetDSCR float64, close time.Time) (float64, DebtSchedule, error) {
+	if len(cfads) == 0 {
+		return math.NaN(), nil, fmt.Errorf("SculptDebt: %w", ErrEmptyCashFlows)
+	}
+	if targetDSCR <= 0 {
+		return math.NaN(), nil, errors.New("SculptDebt requires a positive target DSCR")
+	}
+	flows := cfads.SortedCopy()
+	for i, cf := range flows {
+		if !cf.Date.After(close) || i > 0 && !cf.Date.After(flows[i-1].Date) {
+			return math.NaN(), nil, errors.New("SculptDebt requires one cash-flow per date, all after close")
+		}
+		if cf.Value <= 0 {
+			return math.NaN(), nil, errors.New("SculptDebt requires positive cash-flows available for debt service")
+		}
+	}
+
+	debt := 0.0
+	for _, cf := range flows {
+		debt += cf.Value / targetDSCR * r.DiscountFactor(yearsBetween(close, cf.Date))
+	}
+	schedule := make(DebtSchedule, len(flows))
+	outstanding, previous := debt, close
+	for i, cf := range flows {
+		growth := r.DiscountFactor(yearsBetween(close, previous)) / r.DiscountFactor(yearsBetween(close, cf.Date))
+		interest := outstanding * (growth - 1)
+		principal := cf.Value/targetDSCR - interest
+		schedule[i] = DebtServicePeriod{cf.Date, interest, principal}
+		outstanding -= principal
+		previous = cf.Date
+	}
+	return debt, schedule, nil
+}
@@ -0,0 +1,82 @@
+package gofinance
+
+import (
+	"errors"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// SculptDebt
+// -----------------------------------------------------------------------------
+func TestSculptDebt(t *testing.T) {
+	r := RateEffective{0.06, 1}
+	cfads := CashFlows{
+		{130, anchor.AddDate(3, 0, 0)},
+		{100, anchor.AddDate(1, 0, 0)},
+		{120, anchor.AddDate(2, 0, 0)},
+		{90, anchor.AddDate(4, 0, 0)},
+	}
+	debt, schedule, err := SculptDebt(cfads, r, 1.3, anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the loan is the present value of the debt service at the loan rate
+	want := 0.0
+	for _, cf := range cfads {
+		want += cf.PresentValue(r, anchor) / 1.3
+	}
+	if !almostEq(debt, want, 1e-9) {
+		t.Errorf("debt got %v, want %v", debt, want)
+	}
+
+	// it is fully repaid, and every period covers its debt service at 1.3
+	repaid := 0.0
+	for _, p := range schedule {
+		repaid += p.Principal
+	}
+	if !almostEq(repaid, debt, 1e-9) {
+		t.Errorf("principal repaid %v, want %v", repaid, debt)
+	}
+	periods, err := schedule.Coverage(cfads, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, p := range periods {
+		if !almostEq(p.DSCR, 1.3, 1e-12) {
+			t.Errorf("period %d DSCR got %v, want 1.3", i, p.DSCR)
+		}
+	}
+	// first year interest on the full loan
+	if !almostEq(schedule[0].Interest, 0.06*debt, 1e-9) || !schedule[0].Date.Equal(anchor.AddDate(1, 0, 0)) {
+		t.Errorf("first period got %+v", schedule[0])
+	}
+
+	// goal seeking the largest loan repaid by the covered cash-flows agrees
+	seek, err := Solve(func(d float64) float64 {
+		balance := d
+		for _, cf := range cfads.SortedCopy() {
+			balance = balance*(1+r.Value) - cf.Value/1.3
+		}
+		return balance
+	}, SolverOptions{InitialGuess: 100})
+	if err != nil || !almostEq(seek, debt, 1e-6) {
+		t.Errorf("Solve got %v, %v, want %v", seek, err, debt)
+	}
+
+	if _, _, err := SculptDebt(nil, r, 1.3, anchor); !errors.Is(err, ErrEmptyCashFlows) {
+		t.Errorf("no cash-flows got %v, want ErrEmptyCashFlows", err)
+	}
+	for _, bad := range []CashFlows{
+		{{100, anchor}},
+		{{-5, anchor.AddDate(1, 0, 0)}},
+		{{100, anchor.AddDate(1, 0, 0)}, {100, anchor.AddDate(1, 0, 0)}},
+	} {
+		if _, _, err := SculptDebt(bad, r, 1.3, anchor); err == nil {
+			t.Errorf("SculptDebt(%v): expected error", bad)
+		}
+	}
+	if _, _, err := SculptDebt(cfads, r, 0, anchor); err == nil {
+		t.Error("zero DSCR: expected error")
+	}
+}
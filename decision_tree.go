@@ -0,0 +1,99 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// NodeKind selects how a [DecisionTree] node rolls back its children.
+type NodeKind int
+
+const (
+	// NodeChance weights its children by their Probability.
+	NodeChance NodeKind = iota
+	// NodeDecision takes the child of highest value, the choice of
+	// the decision maker.
+	NodeDecision
+)
+
+// DecisionTree is a node of a scenario tree for project decisions,
+// the root being the whole tree. CashFlows are incurred on reaching the
+// node, Probability is the chance of reaching it from a parent of kind
+// [NodeChance], ignored under [NodeDecision] parents.
+//
+// CanAbandon gives the option to abandon the project at the node, after
+// its own CashFlows, receiving the Salvage flows instead of continuing.
+type DecisionTree struct {
+	Name        string
+	Probability float64
+	CashFlows   CashFlows
+	Kind        NodeKind
+	Children    []DecisionTree
+	CanAbandon  bool
+	Salvage     CashFlows
+}
+
+// TreeValuation is the rolled-back value of a [DecisionTree] node:
+// Value is the expected NPV of reaching the node, its own flows included,
+// Continuation the value of its children alone. Choice is the index of
+// the chosen child of a decision node, -1 otherwise, and Abandoned is set
+// when abandoning beats continuing.
+type TreeValuation struct {
+	Name         string
+	Value        float64
+	Continuation float64
+	Choice       int
+	Abandoned    bool
+	Children     []TreeValuation
+}
+
+// probabilityTolerance is the slack allowed on chance probabilities summing to 1.
+const probabilityTolerance = 1e-9
+
+// Rollback values the tree from the leaves back to the root, discounting
+// every flow on curve to valuationDate: chance nodes take the probability
+// weighted value of their children, decision nodes the best child, and an
+// abandonment option replaces the continuation when Salvage is worth more.
+// The expected NPV of the project is the Value of the result.
+// Math details:
+//
+// Value = PV(CashFlows) + max(Continuation, PV(Salvage)),   the max only if CanAbandon
+//
+// Continuation = \sum_i p_i * Value_i   (chance),   max_i Value_i   (decision),   0 at a leaf
+func (n DecisionTree) Rollback(curve YieldCurve, valuationDate time.Time) (TreeValuation, error) {
+	v := TreeValuation{Name: n.Name, Choice: -1, Children: make([]TreeValuation, len(n.Children))}
+	total := 0.0
+	for i, child := range n.Children {
+		cv, err := child.Rollback(curve, valuationDate)
+		if err != nil {
+			return TreeValuation{}, err
+		}
+		v.Children[i] = cv
+		switch n.Kind {
+		case NodeChance:
+			if child.Probability < 0 || child.Probability > 1 {
+				return TreeValuation{}, errors.New("DecisionTree requires probabilities between 0 and 1")
+			}
+			total += child.Probability
+			v.Continuation += child.Probability * cv.Value
+		case NodeDecision:
+			if v.Choice < 0 || cv.Value > v.Continuation {
+				v.Choice, v.Continuation = i, cv.Value
+			}
+		default:
+			return TreeValuation{}, errors.New("DecisionTree: unknown node kind")
+		}
+	}
+	if n.Kind == NodeChance && len(n.Children) > 0 && math.Abs(total-1) > probabilityTolerance {
+		return TreeValuation{}, errors.New("DecisionTree requires the probabilities of chance outcomes to sum to 1")
+	}
+	v.Value = n.CashFlows.NPVCurve(curve, valuationDate) + v.Continuation
+	if n.CanAbandon {
+		if salvage := n.Salvage.NPVCurve(curve, valuationDate); salvage > v.Continuation {
+			v.Abandoned = true
+			v.Value += salvage - v.Continuation
+		}
+	}
+	return v, nil
+}
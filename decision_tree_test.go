@@ -0,0 +1,82 @@
+package gofinance
+
+import "testing"
+
+// testProject is an investment of 100 that pays 150 after a year with
+// probability 0.6, or else 40 after two years unless abandoned for a
+// salvage of 60 after one year.
+func testProject(canAbandon bool) DecisionTree {
+	y1, y2 := anchor.AddDate(1, 0, 0), anchor.AddDate(2, 0, 0)
+	return DecisionTree{
+		Name:      "invest",
+		CashFlows: CashFlows{{-100, anchor}},
+		Children: []DecisionTree{
+			{Name: "good", Probability: 0.6, CashFlows: CashFlows{{150, y1}}},
+			{
+				Name: "bad", Probability: 0.4,
+				CanAbandon: canAbandon, Salvage: CashFlows{{60, y1}},
+				Children: []DecisionTree{{Name: "run off", Probability: 1, CashFlows: CashFlows{{40, y2}}}},
+			},
+		},
+	}
+}
+
+// -----------------------------------------------------------------------------
+// DecisionTree.Rollback
+// -----------------------------------------------------------------------------
+func TestDecisionTreeRollback(t *testing.T) {
+	zero := RateEffective{0, 1}
+
+	v, err := testProject(false).Rollback(zero, anchor)
+	if err != nil || !almostEq(v.Value, -100+0.6*150+0.4*40, epsilon) || v.Choice != -1 {
+		t.Errorf("without abandonment got %+v, %v, want 6", v, err)
+	}
+	v, _ = testProject(true).Rollback(zero, anchor)
+	if !almostEq(v.Value, -100+0.6*150+0.4*60, epsilon) || !v.Children[1].Abandoned || v.Children[0].Abandoned {
+		t.Errorf("with abandonment got %+v, want 14", v)
+	}
+	if !almostEq(v.Children[1].Continuation, 40, epsilon) || !almostEq(v.Children[1].Value, 60, epsilon) {
+		t.Errorf("bad branch got %+v", v.Children[1])
+	}
+
+	// discounting applies to every flow at its own date
+	r := RateEffective{0.1, 1}
+	v, _ = testProject(true).Rollback(r, anchor)
+	pv := func(x float64, years int) float64 {
+		return CashFlows{{x, anchor.AddDate(years, 0, 0)}}.NPV(r, anchor)
+	}
+	want := -100 + 0.6*pv(150, 1) + 0.4*max(pv(40, 2), pv(60, 1))
+	if !almostEq(v.Value, want, epsilon) {
+		t.Errorf("discounted value got %v, want %v", v.Value, want)
+	}
+
+	// a decision node picks the better of investing and walking away
+	choice := DecisionTree{Kind: NodeDecision, Children: []DecisionTree{{Name: "pass"}, testProject(true)}}
+	v, _ = choice.Rollback(zero, anchor)
+	if v.Choice != 1 || !almostEq(v.Value, 14, epsilon) {
+		t.Errorf("decision got %+v, want invest worth 14", v)
+	}
+	choice.Children[1].CashFlows[0].Value = -120
+	v, _ = choice.Rollback(zero, anchor)
+	if v.Choice != 0 || v.Value != 0 {
+		t.Errorf("decision got %+v, want pass worth 0", v)
+	}
+}
+
+func TestDecisionTreeErrors(t *testing.T) {
+	zero := RateEffective{0, 1}
+	bad := testProject(false)
+	bad.Children[0].Probability = 0.5
+	if _, err := bad.Rollback(zero, anchor); err == nil {
+		t.Error("probabilities not summing to 1: expected error")
+	}
+	bad = testProject(false)
+	bad.Children[1].Children[0].Probability = 1.5
+	if _, err := bad.Rollback(zero, anchor); err == nil {
+		t.Error("probability above 1 in a subtree: expected error")
+	}
+	bad = DecisionTree{Kind: NodeKind(5), Children: []DecisionTree{{}}}
+	if _, err := bad.Rollback(zero, anchor); err == nil {
+		t.Error("unknown node kind: expected error")
+	}
+}
@@ -0,0 +1,148 @@
+package gofinance
+
+import (
+	"errors"
+	"time"
+)
+
+// RateTier is a band of a tiered deposit rate: the simple annual Rate
+// paid from balances of Threshold upwards.
+type RateTier struct {
+	Threshold float64
+	Rate      float64
+}
+
+// TierMethod selects how a tiered rate applies to a balance.
+type TierMethod int
+
+const (
+	// TieredWholeBalance pays the whole balance the rate of the highest
+	// tier it reaches.
+	TieredWholeBalance TierMethod = iota
+	// TieredBlended pays each band of the balance the rate of its own
+	// tier, a blended rate on the whole.
+	TieredBlended
+)
+
+// DepositAccount is a savings or deposit account paying interest on
+// positive balances by Tiers, sorted by Threshold with the first at 0,
+// on a year of DaysPerYear days, usually 365.
+type DepositAccount struct {
+	Tiers       []RateTier
+	Method      TierMethod
+	DaysPerYear float64
+}
+
+// validate checks the tiers and the day count.
+func (a DepositAccount) validate() error {
+	if len(a.Tiers) == 0 || a.Tiers[0].Threshold != 0 {
+		return errors.New("DepositAccount requires tiers starting at a threshold of 0")
+	}
+	for k := 1; k < len(a.Tiers); k++ {
+		if a.Tiers[k].Threshold <= a.Tiers[k-1].Threshold {
+			return errors.New("DepositAccount requires strictly increasing tier thresholds")
+		}
+	}
+	if a.DaysPerYear <= 0 {
+		return errors.New("DepositAccount requires positive DaysPerYear")
+	}
+	return nil
+}
+
+// AnnualInterest returns the interest a year on balance at the tiered
+// rate, nothing on a balance that is not positive.
+// Math details:
+//
+// WholeBalance = Balance * Rate_k,   Threshold_k <= Balance < Threshold_{k+1}
+//
+// Blended = \sum_k Rate_k * (min(Balance, Threshold_{k+1}) - Threshold_k)^+
+func (a DepositAccount) AnnualInterest(balance float64) float64 {
+	interest := 0.0
+	for k, tier := range a.Tiers {
+		if balance <= tier.Threshold {
+			break
+		}
+		if a.Method == TieredWholeBalance {
+			interest = balance * tier.Rate
+			continue
+		}
+		top := balance
+		if k+1 < len(a.Tiers) {
+			top = min(balance, a.Tiers[k+1].Threshold)
+		}
+		interest += tier.Rate * (top - tier.Threshold)
+	}
+	return interest
+}
+
+// EffectiveRate returns the annual interest on balance as a fraction of it,
+// the blended rate under [TieredBlended].
+func (a DepositAccount) EffectiveRate(balance float64) float64 {
+	if balance <= 0 {
+		return 0
+	}
+	return a.AnnualInterest(balance) / balance
+}
+
+// InterestPosting is the interest posted to the account at the end of
+// a month of its statement, on Date, with the AverageBalance of the month
+// and the Balance after posting.
+type InterestPosting struct {
+	Date           time.Time
+	AverageBalance float64
+	Interest       float64
+	Balance        float64
+}
+
+// InterestStatement lists the monthly interest postings of a period with
+// the TotalInterest and the ClosingBalance.
+type InterestStatement struct {
+	Postings       []InterestPosting
+	TotalInterest  float64
+	ClosingBalance float64
+}
+
+// Statement accrues interest by the daily balance method from the start
+// of day from to the start of day to, on an opening balance changed by
+// deposits and withdrawals, the positive and negative transactions.
+// Every day accrues a day's interest on its closing balance, transactions
+// of the day included; the accrued interest is posted, and from then
+// compounds, on the last day of each calendar month and on the last day
+// of the statement.
+// Math details:
+//
+// Interest_month = \sum_{days} AnnualInterest(ClosingBalance_day) / DaysPerYear
+func (a DepositAccount) Statement(opening float64, transactions CashFlows, from, to time.Time) (InterestStatement, error) {
+	if err := a.validate(); err != nil {
+		return InterestStatement{}, err
+	}
+	if !to.After(from) {
+		return InterestStatement{}, errors.New("DepositAccount.Statement requires to after from")
+	}
+	txs := transactions.SortedCopy()
+	if len(txs) > 0 && (txs[0].Date.Before(from) || !txs[len(txs)-1].Date.Before(to)) {
+		return InterestStatement{}, errors.New("DepositAccount.Statement requires transactions within the statement period")
+	}
+
+	var s InterestStatement
+	balance, accrued, sum, days := opening, 0.0, 0.0, 0
+	next := 0
+	for day := from; day.Before(to); {
+		tomorrow := day.AddDate(0, 0, 1)
+		for ; next < len(txs) && txs[next].Date.Before(tomorrow); next++ {
+			balance += txs[next].Value
+		}
+		accrued += a.AnnualInterest(balance) / a.DaysPerYear
+		sum += balance
+		days++
+		if tomorrow.Month() != day.Month() || !tomorrow.Before(to) {
+			balance += accrued
+			s.TotalInterest += accrued
+			s.Postings = append(s.Postings, InterestPosting{day, sum / float64(days), accrued, balance})
+			accrued, sum, days = 0, 0, 0
+		}
+		day = tomorrow
+	}
+	s.ClosingBalance = balance
+	return s, nil
+}
@@ -0,0 +1,81 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// Tiered rates
+// -----------------------------------------------------------------------------
+func TestDepositAnnualInterest(t *testing.T) {
+	tiers := []RateTier{{0, 0.01}, {10000, 0.02}, {50000, 0.03}}
+	whole := DepositAccount{Tiers: tiers, Method: TieredWholeBalance, DaysPerYear: 365}
+	blended := DepositAccount{Tiers: tiers, Method: TieredBlended, DaysPerYear: 365}
+	tests := []struct {
+		balance      float64
+		whole, blend float64
+	}{
+		{-500, 0, 0},
+		{5000, 50, 50},
+		{10000, 100, 100},
+		{20000, 400, 100 + 200},
+		{60000, 1800, 100 + 800 + 300},
+	}
+	for _, tt := range tests {
+		if got := whole.AnnualInterest(tt.balance); !almostEq(got, tt.whole, epsilon) {
+			t.Errorf("whole balance %f got %f, want %f", tt.balance, got, tt.whole)
+		}
+		if got := blended.AnnualInterest(tt.balance); !almostEq(got, tt.blend, epsilon) {
+			t.Errorf("blended %f got %f, want %f", tt.balance, got, tt.blend)
+		}
+	}
+	if got := blended.EffectiveRate(60000); !almostEq(got, 1200.0/60000, epsilon) {
+		t.Errorf("EffectiveRate got %f, want 0.02", got)
+	}
+	if got := blended.EffectiveRate(0); got != 0 {
+		t.Errorf("EffectiveRate of an empty account got %f", got)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Interest statement
+// -----------------------------------------------------------------------------
+func TestDepositStatement(t *testing.T) {
+	a := DepositAccount{Tiers: []RateTier{{0, 0.0365}}, DaysPerYear: 365} // 0.01% a day
+	// 2020-01-01 to 2020-03-01: January and February, 31 and 29 days
+	s, err := a.Statement(10000, CashFlows{{5000, anchor.AddDate(0, 0, 10)}, {-2000, anchor.AddDate(0, 1, 0)}}, anchor, anchor.AddDate(0, 2, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Postings) != 2 {
+		t.Fatalf("got %d postings, want 2", len(s.Postings))
+	}
+	// January: 10 days at 10,000 and 21 at 15,000
+	jan := (10*10000 + 21*15000) * 0.0001
+	if p := s.Postings[0]; !p.Date.Equal(anchor.AddDate(0, 0, 30)) || !almostEq(p.Interest, jan, epsilon) ||
+		!almostEq(p.AverageBalance, (10*10000+21*15000)/31.0, epsilon) || !almostEq(p.Balance, 15000+jan, epsilon) {
+		t.Errorf("January posting got %+v, want interest %f", p, jan)
+	}
+	// February compounds January's interest
+	feb := 29 * (13000 + jan) * 0.0001
+	if p := s.Postings[1]; !p.Date.Equal(anchor.AddDate(0, 1, 28)) || !almostEq(p.Interest, feb, epsilon) {
+		t.Errorf("February posting got %+v, want interest %f", p, feb)
+	}
+	if !almostEq(s.TotalInterest, jan+feb, epsilon) || !almostEq(s.ClosingBalance, 13000+jan+feb, epsilon) {
+		t.Errorf("statement got total %f and closing %f", s.TotalInterest, s.ClosingBalance)
+	}
+
+	// a period ending mid-month posts on its last day
+	s, _ = a.Statement(10000, nil, anchor.AddDate(0, 0, 20), anchor.AddDate(0, 1, 5))
+	if len(s.Postings) != 2 || !s.Postings[1].Date.Equal(anchor.AddDate(0, 1, 4)) || !almostEq(s.Postings[1].Interest, 5*s.Postings[0].Balance*0.0001, epsilon) {
+		t.Errorf("mid-month statement got %+v", s.Postings)
+	}
+
+	if _, err := a.Statement(100, CashFlows{{10, anchor.AddDate(0, 3, 0)}}, anchor, anchor.AddDate(0, 1, 0)); err == nil {
+		t.Error("Statement accepted a transaction after the period")
+	}
+	if _, err := a.Statement(100, nil, anchor, anchor); err == nil {
+		t.Error("Statement accepted an empty period")
+	}
+	if _, err := (DepositAccount{Tiers: []RateTier{{0, 0.01}, {0, 0.02}}, DaysPerYear: 365}).Statement(100, nil, anchor, anchor.AddDate(0, 1, 0)); err == nil {
+		t.Error("Statement accepted repeated thresholds")
+	}
+}
@@ -0,0 +1,166 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+)
+
+// DepreciationPeriod is one period of a depreciation schedule: the Expense
+// charged in the period and the BookValue at its end. Period counts from 1.
+type DepreciationPeriod struct {
+	Period    int
+	Expense   float64
+	BookValue float64
+}
+
+// DepreciationSchedule is a helper alias for a slice of [DepreciationPeriod].
+type DepreciationSchedule []DepreciationPeriod
+
+// Expenses returns the expense of every period in order.
+func (s DepreciationSchedule) Expenses() []float64 {
+	expenses := make([]float64, len(s))
+	for i, p := range s {
+		expenses[i] = p.Expense
+	}
+	return expenses
+}
+
+// scheduleFromExpenses accumulates the book value from cost.
+func scheduleFromExpenses(cost float64, expenses []float64) DepreciationSchedule {
+	s := make(DepreciationSchedule, len(expenses))
+	book := cost
+	for i, e := range expenses {
+		book -= e
+		s[i] = DepreciationPeriod{i + 1, e, book}
+	}
+	return s
+}
+
+// validateDepreciation checks the inputs shared by the calculators.
+func validateDepreciation(cost, salvage float64, life int) error {
+	if life <= 0 {
+		return errors.New("depreciation requires a positive life")
+	}
+	if cost < 0 || salvage < 0 || salvage > cost {
+		return errors.New("depreciation requires 0 <= salvage <= cost")
+	}
+	return nil
+}
+
+// DepreciationStraightLine spreads cost less salvage evenly over life periods.
+// Math details:
+//
+// Expense = (Cost - Salvage) / Life
+func DepreciationStraightLine(cost, salvage float64, life int) (DepreciationSchedule, error) {
+	if err := validateDepreciation(cost, salvage, life); err != nil {
+		return nil, err
+	}
+	expenses := make([]float64, life)
+	for i := range expenses {
+		expenses[i] = (cost - salvage) / float64(life)
+	}
+	return scheduleFromExpenses(cost, expenses), nil
+}
+
+// DepreciationDecliningBalance charges factor / life of the opening book value
+// each period, factor 2 giving double-declining balance, never depreciating
+// below salvage. With switchToStraightLine the schedule switches to straight
+// line over the remaining life once that gives the larger expense, so the
+// asset is fully depreciated to salvage at the end of its life.
+// Math details:
+//
+// Expense_t = min(max(BookValue_{t-1} * Factor / Life, SL_t), BookValue_{t-1} - Salvage)
+//
+// SL_t = (BookValue_{t-1} - Salvage) / (Life - t + 1) if switching, otherwise 0
+func DepreciationDecliningBalance(cost, salvage float64, life int, factor float64, switchToStraightLine bool) (DepreciationSchedule, error) {
+	if err := validateDepreciation(cost, salvage, life); err != nil {
+		return nil, err
+	}
+	if factor <= 0 {
+		return nil, errors.New("DepreciationDecliningBalance requires a positive factor")
+	}
+	expenses := make([]float64, life)
+	book := cost
+	for i := range expenses {
+		e := book * factor / float64(life)
+		if switchToStraightLine {
+			e = math.Max(e, (book-salvage)/float64(life-i))
+		}
+		e = math.Min(e, book-salvage)
+		expenses[i] = e
+		book -= e
+	}
+	return scheduleFromExpenses(cost, expenses), nil
+}
+
+// DepreciationSumOfYearsDigits charges cost less salvage in proportion to the
+// remaining life, front-loading the expense.
+// Math details:
+//
+// Expense_t = (Cost - Salvage) * (Life - t + 1) / (Life * (Life + 1) / 2)
+func DepreciationSumOfYearsDigits(cost, salvage float64, life int) (DepreciationSchedule, error) {
+	if err := validateDepreciation(cost, salvage, life); err != nil {
+		return nil, err
+	}
+	digits := float64(life*(life+1)) / 2
+	expenses := make([]float64, life)
+	for i := range expenses {
+		expenses[i] = (cost - salvage) * float64(life-i) / digits
+	}
+	return scheduleFromExpenses(cost, expenses), nil
+}
+
+// DepreciationUnitsOfProduction charges cost less salvage in proportion to the
+// units produced in each period out of the totalUnits the asset is expected
+// to produce. Depreciation stops at salvage if production exceeds totalUnits.
+// Math details:
+//
+// Expense_t = (Cost - Salvage) * Units_t / TotalUnits
+func DepreciationUnitsOfProduction(cost, salvage, totalUnits float64, units []float64) (DepreciationSchedule, error) {
+	if err := validateDepreciation(cost, salvage, len(units)); err != nil {
+		return nil, err
+	}
+	if totalUnits <= 0 {
+		return nil, errors.New("DepreciationUnitsOfProduction requires positive total units")
+	}
+	expenses := make([]float64, len(units))
+	remaining := cost - salvage
+	for i, u := range units {
+		if u < 0 {
+			return nil, errors.New("DepreciationUnitsOfProduction requires non-negative units")
+		}
+		e := math.Min((cost-salvage)*u/totalUnits, remaining)
+		expenses[i] = e
+		remaining -= e
+	}
+	return scheduleFromExpenses(cost, expenses), nil
+}
+
+// macrsHalfYear holds the IRS MACRS percentages for the general depreciation
+// system, 200% or 150% declining balance with half-year convention
+// (Publication 946, Table A-1), by recovery period in years.
+var macrsHalfYear = map[int][]float64{
+	3:  {33.33, 44.45, 14.81, 7.41},
+	5:  {20.00, 32.00, 19.20, 11.52, 11.52, 5.76},
+	7:  {14.29, 24.49, 17.49, 12.49, 8.93, 8.92, 8.93, 4.46},
+	10: {10.00, 18.00, 14.40, 11.52, 9.22, 7.37, 6.55, 6.55, 6.56, 6.55, 3.28},
+	15: {5.00, 9.50, 8.55, 7.70, 6.93, 6.23, 5.90, 5.90, 5.91, 5.90, 5.91, 5.90, 5.91, 5.90, 5.91, 2.95},
+	20: {3.750, 7.219, 6.677, 6.177, 5.713, 5.285, 4.888, 4.522, 4.462, 4.461, 4.462,
+		4.461, 4.462, 4.461, 4.462, 4.461, 4.462, 4.461, 4.462, 4.461, 2.231},
+}
+
+// DepreciationMACRS returns the US MACRS schedule of an asset with the given
+// recovery period (3, 5, 7, 10, 15, or 20 years) under the half-year
+// convention. The schedule runs one year past the recovery period and,
+// as MACRS ignores salvage, depreciates the full cost.
+func DepreciationMACRS(cost float64, recoveryPeriod int) (DepreciationSchedule, error) {
+	rates, ok := macrsHalfYear[recoveryPeriod]
+	if !ok {
+		return nil, errors.New("DepreciationMACRS: recovery period must be 3, 5, 7, 10, 15, or 20 years")
+	}
+	expenses := make([]float64, len(rates))
+	for i, r := range rates {
+		expenses[i] = cost * r / 100
+	}
+	return scheduleFromExpenses(cost, expenses), nil
+}
@@ -0,0 +1,84 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// Depreciation schedules
+// -----------------------------------------------------------------------------
+func TestDepreciationSchedules(t *testing.T) {
+	sl, _ := DepreciationStraightLine(1000, 100, 3)
+	ddb, _ := DepreciationDecliningBalance(1000, 100, 5, 2, false)
+	ddbSwitch, _ := DepreciationDecliningBalance(1000, 100, 5, 2, true)
+	syd, _ := DepreciationSumOfYearsDigits(1000, 100, 3)
+	uop, _ := DepreciationUnitsOfProduction(1000, 100, 100, []float64{50, 30, 40})
+	macrs, _ := DepreciationMACRS(1000, 5)
+
+	tests := []struct {
+		name     string
+		schedule DepreciationSchedule
+		want     []float64
+	}{
+		{"straight line", sl, []float64{300, 300, 300}},
+		{"double declining", ddb, []float64{400, 240, 144, 86.4, 29.6}},
+		// straight line never exceeds DDB here, the last year is capped at salvage
+		{"double declining with switch", ddbSwitch, []float64{400, 240, 144, 86.4, 29.6}},
+		{"sum of years digits", syd, []float64{450, 300, 150}},
+		{"units of production", uop, []float64{450, 270, 180}},
+		{"MACRS 5 year", macrs, []float64{200, 320, 192, 115.2, 115.2, 57.6}},
+	}
+	for _, tt := range tests {
+		got := tt.schedule.Expenses()
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got %d periods, want %d", tt.name, len(got), len(tt.want))
+			continue
+		}
+		for i := range got {
+			if !almostEq(got[i], tt.want[i], 1e-9) {
+				t.Errorf("%s: period %d expense got %f, want %f", tt.name, i+1, got[i], tt.want[i])
+			}
+		}
+	}
+	if last := sl[len(sl)-1]; last.Period != 3 || !almostEq(last.BookValue, 100, 1e-9) {
+		t.Errorf("straight line last period got %+v", last)
+	}
+	if last := macrs[len(macrs)-1]; !almostEq(last.BookValue, 0, 1e-9) {
+		t.Errorf("MACRS final book value got %f, want 0", last.BookValue)
+	}
+}
+
+func TestDepreciationDecliningBalanceSwitch(t *testing.T) {
+	// 150% declining balance never reaches salvage 0 without the switch
+	plain, _ := DepreciationDecliningBalance(1000, 0, 5, 1.5, false)
+	switched, _ := DepreciationDecliningBalance(1000, 0, 5, 1.5, true)
+	if plain[4].BookValue < 1 {
+		t.Errorf("plain 150%% declining balance ended at %f", plain[4].BookValue)
+	}
+	if !almostEq(switched[4].BookValue, 0, 1e-9) {
+		t.Errorf("switched schedule ended at %f, want 0", switched[4].BookValue)
+	}
+	// year 3: straight line 490 / 3 exceeds declining balance 147
+	want := []float64{300, 210, 490.0 / 3, 490.0 / 3, 490.0 / 3}
+	for i, w := range want {
+		if !almostEq(switched[i].Expense, w, 1e-9) {
+			t.Errorf("switched period %d got %f, want %f", i+1, switched[i].Expense, w)
+		}
+	}
+}
+
+func TestDepreciationErrors(t *testing.T) {
+	if _, err := DepreciationStraightLine(100, 200, 3); err == nil {
+		t.Error("salvage above cost accepted")
+	}
+	if _, err := DepreciationSumOfYearsDigits(100, 0, 0); err == nil {
+		t.Error("zero life accepted")
+	}
+	if _, err := DepreciationDecliningBalance(100, 0, 3, 0, true); err == nil {
+		t.Error("zero factor accepted")
+	}
+	if _, err := DepreciationMACRS(100, 4); err == nil {
+		t.Error("unknown MACRS recovery period accepted")
+	}
+	if _, err := DepreciationUnitsOfProduction(100, 0, 10, []float64{-1}); err == nil {
+		t.Error("negative units accepted")
+	}
+}
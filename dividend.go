@@ -0,0 +1,96 @@
+package gofinance
+
+import "time"
+
+// Dividend is a cash dividend of Amount per share. Holders of the share at
+// the close before ExDate are entitled to it, paid on PayDate, so the share
+// trades without it from ExDate. Franking is the franked fraction of the
+// dividend, between 0 and 1, under an imputation system such as Australia's.
+type Dividend struct {
+	ExDate   time.Time
+	PayDate  time.Time
+	Amount   float64
+	Franking float64
+}
+
+// DividendTreatment is how dividends reach a holder: Withholding is the
+// tax withheld at source as a fraction of the Amount, and with
+// FrankingCredits the holder can use the credits for the CorporateTaxRate
+// already paid on franked dividends.
+// Comparing [DividendTreatment.Net] across holders gives the value of
+// moving a share around an ex-date, the basis of dividend arbitrage.
+type DividendTreatment struct {
+	Withholding      float64
+	FrankingCredits  bool
+	CorporateTaxRate float64
+}
+
+// Net returns the value of the dividend to the holder per share: the cash
+// after withholding plus any franking credit.
+// Math details:
+//
+// FrankingCredit = Amount * Franking * CorporateTaxRate / (1 - CorporateTaxRate)
+//
+// Net = Amount * (1 - Withholding) + FrankingCredit
+func (t DividendTreatment) Net(d Dividend) float64 {
+	net := d.Amount * (1 - t.Withholding)
+	if t.FrankingCredits {
+		net += d.Amount * d.Franking * t.CorporateTaxRate / (1 - t.CorporateTaxRate)
+	}
+	return net
+}
+
+// dividendsIn returns the dividends per share going ex after from and on or
+// before to, valued by value.
+func dividendsIn(dividends []Dividend, from, to time.Time, value func(Dividend) float64) float64 {
+	total := 0.0
+	for _, d := range dividends {
+		if d.ExDate.After(from) && !d.ExDate.After(to) {
+			total += value(d)
+		}
+	}
+	return total
+}
+
+// TotalReturns turns a price series into total returns, each dated at the
+// end of its period like [TimeSeries.SimpleReturns]. A dividend counts in the
+// period in which it goes ex, when the price drops by it, not when it is
+// paid, so returns are not misstated around ex-dates.
+// Math details:
+//
+// R_t = (P_t + \sum Net(D) for ExDate in (t-1, t]) / P_{t-1} - 1
+func (s TimeSeries) TotalReturns(dividends []Dividend, treatment DividendTreatment) TimeSeries {
+	if len(s) < 2 {
+		return nil
+	}
+	returns := make(TimeSeries, len(s)-1)
+	for i := 1; i < len(s); i++ {
+		div := dividendsIn(dividends, s[i-1].Date, s[i].Date, treatment.Net)
+		returns[i-1] = Observation{s[i].Date, (s[i].Value+div)/s[i-1].Value - 1}
+	}
+	return returns
+}
+
+// PositionPnL returns the profit and loss of holding quantity shares over
+// each period of the price series, negative quantity for a short.
+// A long position is entitled to the dividends going ex in the period,
+// valued with treatment; a short position pays the borrower's manufactured
+// dividend of the full Amount, neither withheld nor franked.
+// Math details:
+//
+// PnL_t = Quantity * (P_t - P_{t-1} + Dividends_t)
+func (s TimeSeries) PositionPnL(quantity float64, dividends []Dividend, treatment DividendTreatment) TimeSeries {
+	if len(s) < 2 {
+		return nil
+	}
+	value := treatment.Net
+	if quantity < 0 {
+		value = func(d Dividend) float64 { return d.Amount }
+	}
+	pnl := make(TimeSeries, len(s)-1)
+	for i := 1; i < len(s); i++ {
+		div := dividendsIn(dividends, s[i-1].Date, s[i].Date, value)
+		pnl[i-1] = Observation{s[i].Date, quantity * (s[i].Value - s[i-1].Value + div)}
+	}
+	return pnl
+}
@@ -0,0 +1,99 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// DividendModel values a share from its cost of equity,
+// for example a closure over [DDMGordonGrowth] or [DDMTwoStage].
+type DividendModel func(costOfEquity Rate) (float64, error)
+
+// DDMGordonGrowth values a share whose next annual dividend is d1 and whose
+// dividends grow at the annual rate growth forever.
+// Math details:
+//
+// Price = D1 / (k - g),   k = CostOfEquity as annual effective rate
+func DDMGordonGrowth(d1, growth float64, costOfEquity Rate) (float64, error) {
+	k := costOfEquity.RateAnnualEffective()
+	if k <= growth {
+		return 0, errors.New("DDMGordonGrowth requires the cost of equity to exceed growth")
+	}
+	return d1 / (k - growth), nil
+}
+
+// DDMTwoStage values a share that just paid the annual dividend d0,
+// with dividends growing at highGrowth for years years and at stableGrowth
+// afterwards.
+// Math details:
+//
+// Price = \sum_{t=1}^{n} D0 * (1 + g1)^t / (1 + k)^t + D0 * (1 + g1)^n * (1 + g2) / (k - g2) / (1 + k)^n
+func DDMTwoStage(d0, highGrowth float64, years int, stableGrowth float64, costOfEquity Rate) (float64, error) {
+	k := costOfEquity.RateAnnualEffective()
+	if k <= stableGrowth {
+		return 0, errors.New("DDMTwoStage requires the cost of equity to exceed stable growth")
+	}
+	price, d := 0.0, d0
+	for t := 1; t <= years; t++ {
+		d *= 1 + highGrowth
+		price += d / math.Pow(1+k, float64(t))
+	}
+	terminal := d * (1 + stableGrowth) / (k - stableGrowth)
+	return price + terminal/math.Pow(1+k, float64(years)), nil
+}
+
+// DDMHModel values a share that just paid the annual dividend d0 with the
+// H-model of Fuller and Hsia: growth declines linearly from shortGrowth to
+// longGrowth over 2 * halfLife years, and stays at longGrowth afterwards.
+// Math details:
+//
+// Price = D0 * (1 + gL) / (k - gL) + D0 * H * (gS - gL) / (k - gL)
+func DDMHModel(d0, shortGrowth, longGrowth, halfLife float64, costOfEquity Rate) (float64, error) {
+	k := costOfEquity.RateAnnualEffective()
+	if k <= longGrowth {
+		return 0, errors.New("DDMHModel requires the cost of equity to exceed long-term growth")
+	}
+	return (d0*(1+longGrowth) + d0*halfLife*(shortGrowth-longGrowth)) / (k - longGrowth), nil
+}
+
+// GordonImpliedGrowth returns the perpetual growth rate priced in by a share
+// trading at price that just paid the dividend d0.
+// Math details:
+//
+// g = (Price * k - D0) / (Price + D0)
+func GordonImpliedGrowth(price, d0 float64, costOfEquity Rate) float64 {
+	k := costOfEquity.RateAnnualEffective()
+	return (price*k - d0) / (price + d0)
+}
+
+// ImpliedCostOfEquity returns the annual effective cost of equity at which
+// model values the share at price. Rates where the model fails, such as
+// below the growth rate, are skipped when bracketing the root.
+func ImpliedCostOfEquity(price float64, model DividendModel, opts ...SolverOptions) (Rate, error) {
+	f := func(k float64) float64 {
+		v, err := model(RateEffective{k, 1})
+		if err != nil {
+			return math.NaN()
+		}
+		return v - price
+	}
+	// scan upward: values fall with k, so the root follows the last rate
+	// valued above price
+	lo, flo := math.NaN(), math.NaN()
+	for k := -0.5; k <= 10; k += 0.01 {
+		fk := f(k)
+		if math.IsNaN(fk) {
+			continue
+		}
+		if !math.IsNaN(flo) && flo*fk <= 0 {
+			root, err := brent(f, lo, k, solverOptions(opts))
+			if err != nil {
+				return nil, err
+			}
+			return RateEffective{root, 1}, nil
+		}
+		lo, flo = k, fk
+	}
+	return nil, fmt.Errorf("ImpliedCostOfEquity: %w", ErrNoRootBracketed)
+}
@@ -0,0 +1,56 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Dividend discount models
+// -----------------------------------------------------------------------------
+func TestDDM(t *testing.T) {
+	k := RateEffective{0.10, 1}
+
+	gordon, err := DDMGordonGrowth(2.1, 0.05, k)
+	if err != nil || !almostEq(gordon, 42, 1e-9) {
+		t.Errorf("DDMGordonGrowth got %f, %v, want 42", gordon, err)
+	}
+	if _, err := DDMGordonGrowth(1, 0.2, k); err == nil {
+		t.Error("DDMGordonGrowth accepted growth above cost of equity")
+	}
+
+	// two stages with equal growth collapse to Gordon
+	two, _ := DDMTwoStage(2, 0.05, 5, 0.05, k)
+	if !almostEq(two, 42, 1e-9) {
+		t.Errorf("DDMTwoStage with equal growth got %f, want 42", two)
+	}
+	two, _ = DDMTwoStage(2, 0.2, 2, 0.05, k)
+	want := 2.4/1.1 + 2.88/1.21 + 2.88*1.05/0.05/1.21
+	if !almostEq(two, want, 1e-9) {
+		t.Errorf("DDMTwoStage got %f, want %f", two, want)
+	}
+
+	h, _ := DDMHModel(2, 0.15, 0.05, 3, k)
+	if want := (2*1.05 + 2*3*0.10) / 0.05; !almostEq(h, want, 1e-9) {
+		t.Errorf("DDMHModel got %f, want %f", h, want)
+	}
+}
+
+func TestDDMImplied(t *testing.T) {
+	k := RateEffective{0.10, 1}
+	if g := GordonImpliedGrowth(42, 2, k); !almostEq(g, 0.05, 1e-12) {
+		t.Errorf("GordonImpliedGrowth got %f, want 0.05", g)
+	}
+	model := func(k Rate) (float64, error) { return DDMTwoStage(2, 0.2, 3, 0.04, k) }
+	price, _ := model(RateEffective{0.09, 1})
+	got, err := ImpliedCostOfEquity(price, model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !almostEq(got.RateAnnualEffective(), 0.09, 1e-10) {
+		t.Errorf("ImpliedCostOfEquity got %f, want 0.09", got.RateAnnualEffective())
+	}
+	if _, err := ImpliedCostOfEquity(math.Inf(1), model); err == nil {
+		t.Error("ImpliedCostOfEquity bracketed an infinite price")
+	}
+}
@@ -0,0 +1,65 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// DividendTreatment
+// -----------------------------------------------------------------------------
+func TestDividendTreatmentNet(t *testing.T) {
+	d := Dividend{Amount: 0.70, Franking: 1}
+	tests := []struct {
+		name      string
+		treatment DividendTreatment
+		want      float64
+	}{
+		{"gross", DividendTreatment{}, 0.70},
+		{"withheld", DividendTreatment{Withholding: 0.15}, 0.595},
+		{"fully franked", DividendTreatment{FrankingCredits: true, CorporateTaxRate: 0.30}, 1.00},
+		{"franking ignored", DividendTreatment{CorporateTaxRate: 0.30}, 0.70},
+	}
+	for _, tt := range tests {
+		if got := tt.treatment.Net(d); !almostEq(got, tt.want, epsilon) {
+			t.Errorf("%s: Net got %f, want %f", tt.name, got, tt.want)
+		}
+	}
+	half := Dividend{Amount: 0.70, Franking: 0.5}
+	if got := (DividendTreatment{FrankingCredits: true, CorporateTaxRate: 0.30}).Net(half); !almostEq(got, 0.85, epsilon) {
+		t.Errorf("half franked Net got %f, want 0.85", got)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Total returns and P&L around ex-dates
+// -----------------------------------------------------------------------------
+func TestTotalReturnsAndPositionPnL(t *testing.T) {
+	prices := TimeSeries{
+		{anchor, 100},
+		{anchor.AddDate(0, 0, 1), 101},
+		{anchor.AddDate(0, 0, 2), 99}, // ex-date, drops by the dividend
+		{anchor.AddDate(0, 0, 3), 99},
+	}
+	dividends := []Dividend{{ExDate: anchor.AddDate(0, 0, 2), PayDate: anchor.AddDate(0, 0, 30), Amount: 2, Franking: 1}}
+	treatment := DividendTreatment{Withholding: 0.25}
+
+	returns := prices.TotalReturns(dividends, treatment)
+	want := []float64{0.01, (99+1.5)/101.0 - 1, 0}
+	for i, r := range returns {
+		if !almostEq(r.Value, want[i], epsilon) || !r.Date.Equal(prices[i+1].Date) {
+			t.Errorf("TotalReturns %d got %+v, want %f", i, r, want[i])
+		}
+	}
+
+	long := prices.PositionPnL(100, dividends, treatment)
+	if !almostEq(long[1].Value, -50, epsilon) {
+		t.Errorf("long ex-date PnL got %f, want -50", long[1].Value)
+	}
+	// the short pays the full dividend and so gains nothing from the drop
+	short := prices.PositionPnL(-100, dividends, treatment)
+	if !almostEq(short[1].Value, 0, epsilon) || !almostEq(short[0].Value, -100, epsilon) {
+		t.Errorf("short PnL got %+v", short)
+	}
+
+	if prices[:1].TotalReturns(dividends, treatment) != nil || prices[:1].PositionPnL(1, nil, treatment) != nil {
+		t.Error("single price produced returns")
+	}
+}
@@ -2,4 +2,7 @@
 //
 // The public API is flat. Implementation is split across files like
 // rate.go, but callers import only this package.
+//
+// The package depends on the standard library only: numerical methods,
+// such as the Brent root finder behind [Solve], are implemented in-package.
 package gofinance
@@ -0,0 +1,91 @@
+package gofinance
+
+import (
+	"math"
+	"time"
+)
+
+// Drawdowns treats the series as an equity curve and returns, for every
+// observation, its fall below the running peak as a fraction of the peak.
+// For a series of simple returns use [TimeSeries.CumulativeGrowth] first.
+// Math details:
+//
+// Drawdown_t = 1 - Value_t / max_{s <= t} Value_s
+func (s TimeSeries) Drawdowns() TimeSeries {
+	out := make(TimeSeries, len(s))
+	peak := math.Inf(-1)
+	for i, o := range s {
+		peak = math.Max(peak, o.Value)
+		out[i] = Observation{o.Date, 1 - o.Value/peak}
+	}
+	return out
+}
+
+// MaxDrawdown returns the largest of the [TimeSeries.Drawdowns].
+func (s TimeSeries) MaxDrawdown() float64 {
+	worst := 0.0
+	for _, d := range s.Drawdowns() {
+		worst = math.Max(worst, d.Value)
+	}
+	return worst
+}
+
+// DrawdownEpisode is a spell below a previous peak of an equity curve:
+// from the Peak, down to the Trough with Depth, and back to the peak level
+// at Recovery if Recovered.
+type DrawdownEpisode struct {
+	Peak      time.Time
+	Trough    time.Time
+	Recovery  time.Time
+	Depth     float64
+	Recovered bool
+}
+
+// Duration returns the time from the peak to the recovery,
+// or to end if the episode has not recovered.
+func (e DrawdownEpisode) Duration(end time.Time) time.Duration {
+	if e.Recovered {
+		return e.Recovery.Sub(e.Peak)
+	}
+	return end.Sub(e.Peak)
+}
+
+// RecoveryTime returns the time from the trough back to the peak level,
+// and false if the episode has not recovered.
+func (e DrawdownEpisode) RecoveryTime() (time.Duration, bool) {
+	if !e.Recovered {
+		return 0, false
+	}
+	return e.Recovery.Sub(e.Trough), true
+}
+
+// DrawdownEpisodes returns every drawdown of the equity curve in date order,
+// the last one unrecovered if the curve ends below its peak.
+func (s TimeSeries) DrawdownEpisodes() []DrawdownEpisode {
+	var episodes []DrawdownEpisode
+	var current *DrawdownEpisode
+	peak := math.Inf(-1)
+	var peakDate time.Time
+	for _, o := range s {
+		if o.Value >= peak {
+			if current != nil {
+				current.Recovery, current.Recovered = o.Date, true
+				episodes = append(episodes, *current)
+				current = nil
+			}
+			peak, peakDate = o.Value, o.Date
+			continue
+		}
+		depth := 1 - o.Value/peak
+		if current == nil {
+			current = &DrawdownEpisode{Peak: peakDate}
+		}
+		if depth > current.Depth {
+			current.Trough, current.Depth = o.Date, depth
+		}
+	}
+	if current != nil {
+		episodes = append(episodes, *current)
+	}
+	return episodes
+}
@@ -0,0 +1,55 @@
+package gofinance
+
+import (
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// Drawdowns
+// -----------------------------------------------------------------------------
+func TestDrawdowns(t *testing.T) {
+	d := func(i int) time.Time { return anchor.AddDate(0, 0, i) }
+	equity := TimeSeries{
+		{d(0), 100}, {d(1), 110}, {d(2), 99}, {d(3), 88}, {d(4), 110},
+		{d(5), 120}, {d(6), 108}, {d(7), 114},
+	}
+	dd := equity.Drawdowns()
+	want := []float64{0, 0, 0.1, 0.2, 0, 0, 0.1, 0.05}
+	for i := range want {
+		if !almostEq(dd[i].Value, want[i], 1e-12) {
+			t.Errorf("drawdown %d got %f, want %f", i, dd[i].Value, want[i])
+		}
+	}
+	if got := equity.MaxDrawdown(); !almostEq(got, 0.2, 1e-12) {
+		t.Errorf("MaxDrawdown got %f, want 0.2", got)
+	}
+
+	episodes := equity.DrawdownEpisodes()
+	if len(episodes) != 2 {
+		t.Fatalf("DrawdownEpisodes got %+v", episodes)
+	}
+	first, last := episodes[0], episodes[1]
+	if !first.Peak.Equal(d(1)) || !first.Trough.Equal(d(3)) || !first.Recovery.Equal(d(4)) || !first.Recovered || !almostEq(first.Depth, 0.2, 1e-12) {
+		t.Errorf("first episode got %+v", first)
+	}
+	if first.Duration(d(7)) != 3*24*time.Hour {
+		t.Errorf("first episode duration got %v", first.Duration(d(7)))
+	}
+	if rt, ok := first.RecoveryTime(); !ok || rt != 24*time.Hour {
+		t.Errorf("first episode recovery time got %v, %v", rt, ok)
+	}
+	if last.Recovered || !last.Trough.Equal(d(6)) || last.Duration(d(7)) != 2*24*time.Hour {
+		t.Errorf("last episode got %+v", last)
+	}
+	if _, ok := last.RecoveryTime(); ok {
+		t.Error("unrecovered episode reported a recovery time")
+	}
+}
+
+func TestDrawdownsFromReturns(t *testing.T) {
+	returns := TimeSeries{{anchor, 0.1}, {anchor.AddDate(0, 0, 1), -0.5}, {anchor.AddDate(0, 0, 2), 0.2}}
+	if got := returns.CumulativeGrowth().MaxDrawdown(); !almostEq(got, 0.5, 1e-12) {
+		t.Errorf("MaxDrawdown of returns got %f, want 0.5", got)
+	}
+}
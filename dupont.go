@@ -0,0 +1,74 @@
+package gofinance
+
+import "time"
+
+// DuPontThreeFactor splits the return on equity of the period ending at
+// Date into profitability, efficiency, and leverage.
+type DuPontThreeFactor struct {
+	Date             time.Time
+	ROE              float64
+	NetMargin        float64
+	AssetTurnover    float64
+	EquityMultiplier float64
+}
+
+// DuPontFiveFactor further splits the net margin of a [DuPontThreeFactor]
+// into the tax burden, the interest burden, and the operating margin.
+type DuPontFiveFactor struct {
+	Date             time.Time
+	ROE              float64
+	TaxBurden        float64
+	InterestBurden   float64
+	OperatingMargin  float64
+	AssetTurnover    float64
+	EquityMultiplier float64
+}
+
+// DuPontThree returns the three-factor DuPont decomposition of every period,
+// on period-end balances as in [FinancialStatements.Ratios].
+// Periods must be in increasing date order.
+// Math details:
+//
+// ROE = NetIncome / Equity = NetMargin * AssetTurnover * EquityMultiplier
+//
+// NetMargin = NetIncome / Revenue,   AssetTurnover = Revenue / TotalAssets,   EquityMultiplier = TotalAssets / Equity
+func DuPontThree(periods []FinancialStatements) ([]DuPontThreeFactor, error) {
+	if err := checkPeriods("DuPontThree", periods); err != nil {
+		return nil, err
+	}
+	out := make([]DuPontThreeFactor, len(periods))
+	for i, s := range periods {
+		r := s.Ratios()
+		out[i] = DuPontThreeFactor{s.Date, r.ReturnOnEquity, r.NetMargin, r.AssetTurnover, r.EquityMultiplier}
+	}
+	return out, nil
+}
+
+// DuPontFive returns the five-factor DuPont decomposition of every period.
+// Pre-tax income is taken as EBIT less InterestExpense.
+// Periods must be in increasing date order.
+// Math details:
+//
+// ROE = TaxBurden * InterestBurden * OperatingMargin * AssetTurnover * EquityMultiplier
+//
+// TaxBurden = NetIncome / EBT,   InterestBurden = EBT / EBIT,   OperatingMargin = EBIT / Revenue,   EBT = EBIT - InterestExpense
+func DuPontFive(periods []FinancialStatements) ([]DuPontFiveFactor, error) {
+	if err := checkPeriods("DuPontFive", periods); err != nil {
+		return nil, err
+	}
+	out := make([]DuPontFiveFactor, len(periods))
+	for i, s := range periods {
+		r := s.Ratios()
+		ebt := s.Income.EBIT - s.Income.InterestExpense
+		out[i] = DuPontFiveFactor{
+			Date:             s.Date,
+			ROE:              r.ReturnOnEquity,
+			TaxBurden:        s.Income.NetIncome / ebt,
+			InterestBurden:   ebt / s.Income.EBIT,
+			OperatingMargin:  r.OperatingMargin,
+			AssetTurnover:    r.AssetTurnover,
+			EquityMultiplier: r.EquityMultiplier,
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,53 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// DuPont
+// -----------------------------------------------------------------------------
+func TestDuPont(t *testing.T) {
+	periods := testStatements()
+	three, err := DuPontThree(periods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	five, err := DuPontFive(periods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(three) != 2 || len(five) != 2 || !five[1].Date.Equal(periods[1].Date) {
+		t.Fatalf("got %d and %d periods, want 2", len(three), len(five))
+	}
+
+	// first year: 0.09 * 1 * 2.5
+	d := three[0]
+	if !almostEq(d.NetMargin, 0.09, epsilon) || !almostEq(d.AssetTurnover, 1, epsilon) ||
+		!almostEq(d.EquityMultiplier, 2.5, epsilon) || !almostEq(d.ROE, 0.225, epsilon) {
+		t.Errorf("three-factor got %+v", d)
+	}
+	// EBT = 150 - 30 = 120
+	f := five[0]
+	if !almostEq(f.TaxBurden, 0.75, epsilon) || !almostEq(f.InterestBurden, 0.8, epsilon) ||
+		!almostEq(f.OperatingMargin, 0.15, epsilon) {
+		t.Errorf("five-factor got %+v", f)
+	}
+
+	// the factors multiply back to ROE in every period
+	for i := range periods {
+		d, f := three[i], five[i]
+		if got := d.NetMargin * d.AssetTurnover * d.EquityMultiplier; !almostEq(got, d.ROE, epsilon) {
+			t.Errorf("period %d: three factors give %v, want ROE %v", i, got, d.ROE)
+		}
+		got := f.TaxBurden * f.InterestBurden * f.OperatingMargin * f.AssetTurnover * f.EquityMultiplier
+		if !almostEq(got, f.ROE, epsilon) || !almostEq(f.ROE, d.ROE, epsilon) {
+			t.Errorf("period %d: five factors give %v, want ROE %v", i, got, d.ROE)
+		}
+	}
+
+	if _, err := DuPontThree(nil); err == nil {
+		t.Error("DuPontThree(nil): expected error")
+	}
+	if _, err := DuPontFive([]FinancialStatements{periods[1], periods[0]}); err == nil {
+		t.Error("unordered periods: expected error")
+	}
+}
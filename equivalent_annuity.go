@@ -0,0 +1,92 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+)
+
+// AnnuityFactor returns the present value of 1 paid at the end of each
+// of years years.
+// Math details:
+//
+// AnnuityFactor = \sum_{t=1}^{Years} DiscountFactor(t)
+func AnnuityFactor(r Rate, years int) float64 {
+	factor := 0.0
+	for t := 1; t <= years; t++ {
+		factor += r.DiscountFactor(float64(t))
+	}
+	return factor
+}
+
+// EquivalentAnnualAnnuity returns the level annual cash-flow over years
+// years with the same NPV, so that projects of unequal lives, each
+// repeatable, compare by their EAA: the higher the better.
+// Math details:
+//
+// EAA = NPV / AnnuityFactor(Years)
+func EquivalentAnnualAnnuity(npv float64, r Rate, years int) (float64, error) {
+	if years <= 0 {
+		return math.NaN(), errors.New("EquivalentAnnualAnnuity requires positive years")
+	}
+	return npv / AnnuityFactor(r, years), nil
+}
+
+// EquivalentAnnualCost returns the level annual cost over years years
+// with the same present value as presentCost, the present value of
+// owning and running an asset, so that assets of unequal lives compare
+// by their EAC: the lower the better.
+// Math details:
+//
+// EAC = PresentCost / AnnuityFactor(Years)
+func EquivalentAnnualCost(presentCost float64, r Rate, years int) (float64, error) {
+	if years <= 0 {
+		return math.NaN(), errors.New("EquivalentAnnualCost requires positive years")
+	}
+	return presentCost / AnnuityFactor(r, years), nil
+}
+
+// Equipment is a recurring asset bought for Price, costing
+// OperatingCosts[t-1] to run in year t of its life and resold for
+// Salvage[t-1] at the end of year t, for a life of up to len(OperatingCosts)
+// years.
+type Equipment struct {
+	Price          float64
+	OperatingCosts []float64
+	Salvage        []float64
+}
+
+// ReplacementPlan is the result of [Equipment.ReplacementCycle]: the
+// optimal Years between replacements, its equivalent annual cost EAC,
+// and the EAC of replacing after every possible life, ByLife[n-1] for
+// n years.
+type ReplacementPlan struct {
+	Years  int
+	EAC    float64
+	ByLife []float64
+}
+
+// ReplacementCycle finds how often to replace the equipment, forever,
+// for the lowest equivalent annual cost.
+// Math details:
+//
+// PresentCost(n) = Price + \sum_{t=1}^{n} OperatingCost_t * DiscountFactor(t) - Salvage_n * DiscountFactor(n)
+//
+// EAC(n) = PresentCost(n) / AnnuityFactor(n),   Years = argmin_n EAC(n)
+func (e Equipment) ReplacementCycle(r Rate) (ReplacementPlan, error) {
+	if len(e.OperatingCosts) == 0 || len(e.Salvage) != len(e.OperatingCosts) {
+		return ReplacementPlan{}, errors.New("Equipment requires as many Salvage values as OperatingCosts, at least one")
+	}
+	plan := ReplacementPlan{EAC: math.Inf(1), ByLife: make([]float64, len(e.OperatingCosts))}
+	cost, factor := e.Price, 0.0
+	for n := 1; n <= len(e.OperatingCosts); n++ {
+		df := r.DiscountFactor(float64(n))
+		cost += e.OperatingCosts[n-1] * df
+		factor += df
+		eac := (cost - e.Salvage[n-1]*df) / factor
+		plan.ByLife[n-1] = eac
+		if eac < plan.EAC {
+			plan.Years, plan.EAC = n, eac
+		}
+	}
+	return plan, nil
+}
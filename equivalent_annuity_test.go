@@ -0,0 +1,78 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// AnnuityFactor, EAA, EAC
+// -----------------------------------------------------------------------------
+func TestEquivalentAnnualAnnuity(t *testing.T) {
+	r := RateEffective{0.1, 1}
+	if got, want := AnnuityFactor(r, 3), (1-math.Pow(1.1, -3))/0.1; !almostEq(got, want, epsilon) {
+		t.Errorf("AnnuityFactor got %v, want %v", got, want)
+	}
+	if got := AnnuityFactor(RateEffective{0, 1}, 5); !almostEq(got, 5, epsilon) {
+		t.Errorf("zero-rate AnnuityFactor got %v, want 5", got)
+	}
+
+	// a longer project with the higher NPV can lose on EAA
+	short, _ := EquivalentAnnualAnnuity(100, r, 2)
+	long, _ := EquivalentAnnualAnnuity(130, r, 4)
+	if !almostEq(short, 100/AnnuityFactor(r, 2), epsilon) || short <= long {
+		t.Errorf("EAA got short %v, long %v, want short > long", short, long)
+	}
+
+	// the annuity itself is its own EAA
+	if got, _ := EquivalentAnnualAnnuity(25*AnnuityFactor(r, 6), r, 6); !almostEq(got, 25, epsilon) {
+		t.Errorf("EAA of a 25 annuity got %v", got)
+	}
+	if got, _ := EquivalentAnnualCost(1000, r, 5); !almostEq(got, 1000/AnnuityFactor(r, 5), epsilon) {
+		t.Errorf("EAC got %v", got)
+	}
+
+	if _, err := EquivalentAnnualAnnuity(100, r, 0); err == nil {
+		t.Error("EAA over zero years: expected error")
+	}
+	if _, err := EquivalentAnnualCost(100, r, -1); err == nil {
+		t.Error("EAC over negative years: expected error")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Equipment.ReplacementCycle
+// -----------------------------------------------------------------------------
+func TestReplacementCycle(t *testing.T) {
+	r := RateEffective{0.1, 1}
+	machine := Equipment{
+		Price:          10000,
+		OperatingCosts: []float64{1000, 1500, 2500, 4000, 6000},
+		Salvage:        []float64{7000, 5500, 4200, 3000, 2000},
+	}
+	plan, err := machine.ReplacementCycle(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// every life by hand
+	for n := 1; n <= 5; n++ {
+		pv := machine.Price
+		for i := 1; i <= n; i++ {
+			pv += machine.OperatingCosts[i-1] / math.Pow(1.1, float64(i))
+		}
+		pv -= machine.Salvage[n-1] / math.Pow(1.1, float64(n))
+		if want, _ := EquivalentAnnualCost(pv, r, n); !almostEq(plan.ByLife[n-1], want, 1e-9) {
+			t.Errorf("EAC over %d years got %v, want %v", n, plan.ByLife[n-1], want)
+		}
+		if plan.ByLife[n-1] < plan.EAC {
+			t.Errorf("life %d beats the chosen cycle", n)
+		}
+	}
+	if plan.Years != 3 || plan.EAC != plan.ByLife[2] {
+		t.Errorf("ReplacementCycle got %d years at %v, want 3", plan.Years, plan.EAC)
+	}
+
+	if _, err := (Equipment{Price: 1, OperatingCosts: []float64{1}}).ReplacementCycle(r); err == nil {
+		t.Error("missing salvage values: expected error")
+	}
+}
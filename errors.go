@@ -0,0 +1,126 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors returned, possibly wrapped, by functions of the package.
+// Test for them with [errors.Is]. They cover solver failures, missing or
+// too few inputs, failed lookups, and contract checks; other invalid
+// arguments, such as a negative rate or a mismatched length, are reported
+// with plain errors.
+var (
+	// ErrNoRootBracketed is returned when a solver cannot find an interval
+	// over which its function changes sign, so no root can be searched for.
+	ErrNoRootBracketed = errors.New("root is not bracketed")
+
+	// ErrMaxIterations is returned when a solver hits its iteration cap
+	// before converging, see [SolverOptions].
+	ErrMaxIterations = errors.New("maximum iterations exceeded")
+
+	// ErrZeroDerivative is returned by Newton's method when the function
+	// is flat, or not differentiable, at an iterate.
+	ErrZeroDerivative = errors.New("zero derivative")
+
+	// ErrEmptyCashFlows is returned when a computation needs cash-flows
+	// and has none.
+	ErrEmptyCashFlows = errors.New("no cash-flows")
+
+	// ErrInsufficientData is returned when a computation gets no input,
+	// or fewer observations, periods, or paths than it needs to estimate
+	// from, such as an empty profit and loss sample for [HistoricalVaR].
+	ErrInsufficientData = errors.New("insufficient data")
+
+	// ErrNoSuchEntry is returned when an entry looked up by ID does not exist.
+	ErrNoSuchEntry = errors.New("no such entry")
+
+	// ErrContractViolation is returned by [CheckDiscountFactor] and the
+	// other Check functions when an implementation breaks a contract.
+	ErrContractViolation = errors.New("contract violation")
+)
+
+// ErrUnsupportedTimeFormat is returned by [StringToTime] when Input matches
+// none of the supported formats.
+// Test for it with [errors.As].
+type ErrUnsupportedTimeFormat struct {
+	Input string
+}
+
+// Error implements error.
+func (e ErrUnsupportedTimeFormat) Error() string {
+	return "unsupported time format: " + e.Input
+}
+
+// ErrSolve is returned by [Solve] and [SolveBracket] when no root is found.
+// X is the point with the smallest |f(X)| evaluated, FX its value,
+// and Err the cause, such as [ErrNoRootBracketed] or [ErrMaxIterations].
+// Test for it with [errors.As].
+type ErrSolve struct {
+	Method SolveMethod
+	X      float64
+	FX     float64
+	Err    error
+}
+
+// Error implements error.
+func (e ErrSolve) Error() string {
+	return fmt.Sprintf("Solve: %v, best x = %g with f(x) = %g", e.Err, e.X, e.FX)
+}
+
+// Unwrap returns the cause.
+func (e ErrSolve) Unwrap() error {
+	return e.Err
+}
+
+// ErrUnsupportedRateFormat is returned by [ParseRate] when Input is not
+// a supported rate specification.
+// Test for it with [errors.As].
+type ErrUnsupportedRateFormat struct {
+	Input string
+}
+
+// Error implements error.
+func (e ErrUnsupportedRateFormat) Error() string {
+	return "unsupported rate format: " + e.Input
+}
+
+// ErrRateCell is a failure to read or convert one cell of a [RateTable],
+// at Row and Column counted from zero. It wraps the cause.
+type ErrRateCell struct {
+	Row    int
+	Column int
+	Err    error
+}
+
+// Error implements error.
+func (e ErrRateCell) Error() string {
+	return fmt.Sprintf("rate table row %d, column %d: %v", e.Row, e.Column, e.Err)
+}
+
+// Unwrap returns the cause.
+func (e ErrRateCell) Unwrap() error {
+	return e.Err
+}
+
+// ErrRateTable lists every failed cell of a [RateTable] in row order.
+// Test for it with [errors.As]; [errors.Is] and [errors.As] also reach
+// the causes of the cells.
+type ErrRateTable []ErrRateCell
+
+// Error implements error.
+func (e ErrRateTable) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	return fmt.Sprintf("%v (and %d more failed cells)", e[0], len(e)-1)
+}
+
+// Unwrap returns the failed cells.
+func (e ErrRateTable) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, cell := range e {
+		errs[i] = cell
+	}
+	return errs
+}
@@ -0,0 +1,63 @@
+package gofinance
+
+import (
+	"errors"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Sentinel errors
+// -----------------------------------------------------------------------------
+func TestSentinelErrors(t *testing.T) {
+	_, irrEmpty := CashFlows{}.IRR()
+	_, irrBracket := CashFlows{{100, anchor}, {100, anchor.AddDate(1, 0, 0)}}.IRR()
+	_, brentBracket := brent(func(x float64) float64 { return 1 }, 0, 1, DefaultSolverOptions())
+	_, brentIterations := brent(func(x float64) float64 { return x*x*x - 0.3 }, 0, 1, SolverOptions{AbsTolerance: 1e-300, RelTolerance: 1e-300, MaxIterations: 1})
+	var a AmendedCashFlows
+	changeMissing := a.Change(7, CashFlow{}, anchor, "")
+	removeMissing := a.Remove(7, anchor, "")
+	_, dcfEmpty := DCF{}.Value(RateEffective{0.1, 1}, anchor)
+	_, varEmpty := HistoricalVaR(nil, 0.99)
+	_, runwayShort := ProjectRunway(1000, nil, 0, 0.9)
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"IRR empty", irrEmpty, ErrEmptyCashFlows},
+		{"IRR bracket", irrBracket, ErrNoRootBracketed},
+		{"brent bracket", brentBracket, ErrNoRootBracketed},
+		{"brent iterations", brentIterations, ErrMaxIterations},
+		{"Change missing", changeMissing, ErrNoSuchEntry},
+		{"Remove missing", removeMissing, ErrNoSuchEntry},
+		{"DCF empty", dcfEmpty, ErrInsufficientData},
+		{"VaR empty", varEmpty, ErrInsufficientData},
+		{"runway short", runwayShort, ErrInsufficientData},
+	}
+	for _, tt := range tests {
+		if !errors.Is(tt.err, tt.want) {
+			t.Errorf("%s: got %v, want errors.Is %v", tt.name, tt.err, tt.want)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Typed errors
+// -----------------------------------------------------------------------------
+func TestTypedErrors(t *testing.T) {
+	_, err := StringToTime("21-01-01")
+	var timeErr ErrUnsupportedTimeFormat
+	if !errors.As(err, &timeErr) || timeErr.Input != "21-01-01" {
+		t.Errorf("StringToTime got %v, want ErrUnsupportedTimeFormat", err)
+	}
+
+	_, err = ParseRate("5% sometimes")
+	var rateErr ErrUnsupportedRateFormat
+	if !errors.As(err, &rateErr) || rateErr.Input != "5% sometimes" {
+		t.Errorf("ParseRate got %v, want ErrUnsupportedRateFormat", err)
+	}
+	if rateErr.Error() != "unsupported rate format: 5% sometimes" {
+		t.Errorf("Error got %q", rateErr.Error())
+	}
+}
@@ -0,0 +1,110 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Escalation adjusts a payment agreed at start to the level it escalated
+// to at a later date, as the rent review clauses of a lease.
+type Escalation interface {
+	Factor(start, date time.Time) (float64, error)
+}
+
+// StepEscalation raises payments by Rate every Months months after start,
+// compounding: +3 % every 12 months is StepEscalation{0.03, 12}.
+type StepEscalation struct {
+	Rate   float64
+	Months int
+}
+
+// Factor implements [Escalation].
+// Math details:
+//
+// Factor = (1 + Rate)^k,   k = number of reviews on or before date
+func (e StepEscalation) Factor(start, date time.Time) (float64, error) {
+	if e.Months <= 0 {
+		return 0, errors.New("StepEscalation requires positive Months")
+	}
+	return math.Pow(1+e.Rate, float64(reviews(start, date, e.Months))), nil
+}
+
+// IndexEscalation indexes payments to a price index, such as an
+// [IndexSeries] of the CPI, reviewed every Months months after start.
+// Each review applies the change of the index since the previous one,
+// read Lag months before the review for publication delays; UpwardOnly
+// ignores falls of the index and a non-zero Cap limits each change.
+type IndexEscalation struct {
+	Index      PriceIndex
+	Months     int
+	Lag        int
+	UpwardOnly bool
+	Cap        float64
+}
+
+// Factor implements [Escalation].
+// Math details:
+//
+// Factor = \prod_{j=1}^{k} min(max(I(t_j - Lag) / I(t_{j-1} - Lag), 1 if UpwardOnly), 1 + Cap)
+func (e IndexEscalation) Factor(start, date time.Time) (float64, error) {
+	if e.Months <= 0 || e.Lag < 0 || e.Index == nil {
+		return 0, errors.New("IndexEscalation requires an Index, positive Months and a non-negative Lag")
+	}
+	factor := 1.0
+	prev, err := e.Index.Level(addMonths(start, -e.Lag))
+	if err != nil {
+		return 0, err
+	}
+	for j := 1; j <= reviews(start, date, e.Months); j++ {
+		level, err := e.Index.Level(addMonths(start, j*e.Months-e.Lag))
+		if err != nil {
+			return 0, err
+		}
+		change := level / prev
+		if e.UpwardOnly {
+			change = math.Max(change, 1)
+		}
+		if e.Cap != 0 {
+			change = math.Min(change, 1+e.Cap)
+		}
+		factor *= change
+		prev = level
+	}
+	return factor, nil
+}
+
+// reviews counts the reviews every months months after start falling on
+// or before date.
+func reviews(start, date time.Time, months int) int {
+	k := 0
+	for !addMonths(start, (k+1)*months).After(date) {
+		k++
+	}
+	return k
+}
+
+// EscalatedPayments returns the payments of base a period, on the
+// [Schedule] of periodsPerYear dates from start to end, paid in arrears
+// and escalated as of the start of each period, so that a review takes
+// effect for the periods beginning on or after it. A nil escalation
+// leaves the payments level.
+func EscalatedPayments(start, end time.Time, periodsPerYear int, base float64, escalation Escalation) (CashFlows, error) {
+	dates, err := Schedule(start, end, periodsPerYear)
+	if err != nil {
+		return nil, err
+	}
+	flows := make(CashFlows, len(dates))
+	periodStart := start
+	for i, d := range dates {
+		factor := 1.0
+		if escalation != nil {
+			if factor, err = escalation.Factor(start, periodStart); err != nil {
+				return nil, err
+			}
+		}
+		flows[i] = CashFlow{base * factor, d}
+		periodStart = d
+	}
+	return flows, nil
+}
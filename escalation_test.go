@@ -0,0 +1,76 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// EscalatedPayments, StepEscalation
+// -----------------------------------------------------------------------------
+func TestStepEscalation(t *testing.T) {
+	flows, err := EscalatedPayments(anchor, anchor.AddDate(3, 0, 0), 12, 1000, StepEscalation{0.03, 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(flows) != 36 {
+		t.Fatalf("got %d payments, want 36", len(flows))
+	}
+	// the payment due on the anniversary still covers the first year
+	for i, want := range map[int]float64{0: 1000, 11: 1000, 12: 1030, 23: 1030, 24: 1060.9, 35: 1060.9} {
+		if !almostEq(flows[i].Value, want, 1e-9) || !flows[i].Date.Equal(addMonths(anchor, i+1)) {
+			t.Errorf("payment %d got %v, want %v", i, flows[i], want)
+		}
+	}
+
+	level, _ := EscalatedPayments(anchor, anchor.AddDate(1, 0, 0), 4, 500, nil)
+	for _, cf := range level {
+		if cf.Value != 500 {
+			t.Errorf("level payment got %v, want 500", cf.Value)
+		}
+	}
+	if _, err := EscalatedPayments(anchor, anchor.AddDate(1, 0, 0), 12, 1, StepEscalation{0.03, 0}); err == nil {
+		t.Error("zero Months: expected error")
+	}
+	if _, err := EscalatedPayments(anchor, anchor, 12, 1, nil); err == nil {
+		t.Error("empty term: expected error")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// IndexEscalation
+// -----------------------------------------------------------------------------
+func TestIndexEscalation(t *testing.T) {
+	// the CPI is read three months before each annual review
+	cpi := TimeSeries{
+		{addMonths(anchor, -3), 100},
+		{addMonths(anchor, 9), 102},
+		{addMonths(anchor, 21), 101},
+	}
+	tests := []struct {
+		name       string
+		escalation IndexEscalation
+		want       []float64 // factors of the three years
+	}{
+		{"indexed", IndexEscalation{Index: cpi, Months: 12, Lag: 3}, []float64{1, 1.02, 1.01}},
+		{"upward only", IndexEscalation{Index: cpi, Months: 12, Lag: 3, UpwardOnly: true}, []float64{1, 1.02, 1.02}},
+		{"capped", IndexEscalation{Index: cpi, Months: 12, Lag: 3, Cap: 0.015}, []float64{1, 1.015, 1.015 * 101 / 102}},
+	}
+	for _, tc := range tests {
+		for year, want := range tc.want {
+			got, err := tc.escalation.Factor(anchor, anchor.AddDate(year, 0, 0))
+			if err != nil || !almostEq(got, want, epsilon) {
+				t.Errorf("%s: year %d got %v, %v, want %v", tc.name, year, got, err, want)
+			}
+		}
+	}
+
+	flows, err := EscalatedPayments(anchor, anchor.AddDate(3, 0, 0), 1, 1000, tests[0].escalation)
+	if err != nil || len(flows) != 3 || !almostEq(flows[2].Value, 1010, 1e-9) {
+		t.Errorf("indexed payments got %v, %v", flows, err)
+	}
+
+	if _, err := (IndexEscalation{Index: cpi, Months: 12, Lag: 6}).Factor(anchor, anchor); err == nil {
+		t.Error("index read before its first observation: expected error")
+	}
+	if _, err := (IndexEscalation{Months: 12}).Factor(anchor, anchor); err == nil {
+		t.Error("no index: expected error")
+	}
+}
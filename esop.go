@@ -0,0 +1,96 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// ExpenseAttribution selects how the compensation cost of an award vesting
+// in annual tranches is spread over the service period.
+type ExpenseAttribution int
+
+const (
+	// AttributionStraightLine expenses the whole award evenly
+	// over the full vesting period.
+	AttributionStraightLine ExpenseAttribution = iota
+	// AttributionGraded expenses each tranche evenly over its own
+	// vesting period (accelerated attribution), front-loading the cost.
+	AttributionGraded
+)
+
+// OptionGrant is an employee stock option grant under ASC 718: Options
+// options granted on Date with a grant-date FairValue per option, for
+// example from [BlackScholesCall], vesting in equal annual tranches over
+// VestingYears. ForfeitureRate is the expected annual rate at which
+// employees leave before vesting.
+type OptionGrant struct {
+	Date           time.Time
+	Options        float64
+	FairValue      float64
+	VestingYears   int
+	ForfeitureRate float64
+}
+
+// ExpensePeriod is one period of an expense schedule ending at Date,
+// with the Expense of the period and the Cumulative expense to date.
+type ExpensePeriod struct {
+	Date       time.Time
+	Expense    float64
+	Cumulative float64
+}
+
+// Tranches returns the number of options expected to vest on each
+// anniversary of the grant, net of expected forfeitures.
+// Math details:
+//
+// Tranche_k = Options / VestingYears * (1 - ForfeitureRate)^k
+func (g OptionGrant) Tranches() []float64 {
+	tranches := make([]float64, g.VestingYears)
+	for k := range tranches {
+		tranches[k] = g.Options / float64(g.VestingYears) * math.Pow(1-g.ForfeitureRate, float64(k+1))
+	}
+	return tranches
+}
+
+// ExpenseSchedule returns the compensation expense of the grant in each of
+// periodsPerYear periods a year from the grant Date until fully vested.
+// Math details:
+//
+// StraightLine_t = \sum_k Tranche_k * FairValue / (VestingYears * PeriodsPerYear)
+//
+// Graded_t = \sum_{k : t <= k * PeriodsPerYear} Tranche_k * FairValue / (k * PeriodsPerYear)
+func (g OptionGrant) ExpenseSchedule(attribution ExpenseAttribution, periodsPerYear int) ([]ExpensePeriod, error) {
+	if g.VestingYears <= 0 {
+		return nil, errors.New("OptionGrant requires positive vesting years")
+	}
+	if periodsPerYear <= 0 || 12%periodsPerYear != 0 {
+		return nil, errors.New("OptionGrant.ExpenseSchedule requires periodsPerYear to divide 12")
+	}
+	tranches := g.Tranches()
+	n := g.VestingYears * periodsPerYear
+	expenses := make([]float64, n)
+	for k, options := range tranches {
+		cost := options * g.FairValue
+		switch attribution {
+		case AttributionStraightLine:
+			for t := range expenses {
+				expenses[t] += cost / float64(n)
+			}
+		case AttributionGraded:
+			periods := (k + 1) * periodsPerYear
+			for t := range periods {
+				expenses[t] += cost / float64(periods)
+			}
+		default:
+			return nil, errors.New("OptionGrant.ExpenseSchedule: unknown attribution")
+		}
+	}
+	schedule := make([]ExpensePeriod, n)
+	cum := 0.0
+	for t, e := range expenses {
+		cum += e
+		schedule[t] = ExpensePeriod{addMonths(g.Date, (t+1)*12/periodsPerYear), e, cum}
+	}
+	return schedule, nil
+}
@@ -0,0 +1,55 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// OptionGrant
+// -----------------------------------------------------------------------------
+func TestOptionGrantExpense(t *testing.T) {
+	g := OptionGrant{Date: anchor, Options: 3000, FairValue: 10, VestingYears: 3}
+
+	sl, err := g.ExpenseSchedule(AttributionStraightLine, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, p := range sl {
+		if !almostEq(p.Expense, 10000, 1e-9) || !p.Date.Equal(anchor.AddDate(i+1, 0, 0)) {
+			t.Errorf("straight-line year %d got %+v", i+1, p)
+		}
+	}
+
+	// graded: tranches of 10000 over 1, 2, and 3 years
+	graded, _ := g.ExpenseSchedule(AttributionGraded, 1)
+	want := []float64{10000 + 5000 + 10000.0/3, 5000 + 10000.0/3, 10000.0 / 3}
+	for i, w := range want {
+		if !almostEq(graded[i].Expense, w, 1e-9) {
+			t.Errorf("graded year %d got %f, want %f", i+1, graded[i].Expense, w)
+		}
+	}
+	if !almostEq(graded[2].Cumulative, 30000, 1e-9) || !almostEq(sl[2].Cumulative, 30000, 1e-9) {
+		t.Errorf("total expense got %f graded, %f straight-line, want 30000", graded[2].Cumulative, sl[2].Cumulative)
+	}
+
+	quarterly, _ := g.ExpenseSchedule(AttributionGraded, 4)
+	if len(quarterly) != 12 || !almostEq(quarterly[11].Cumulative, 30000, 1e-9) || !quarterly[0].Date.Equal(anchor.AddDate(0, 3, 0)) {
+		t.Errorf("quarterly graded schedule got %d periods ending %+v", len(quarterly), quarterly[len(quarterly)-1])
+	}
+}
+
+func TestOptionGrantForfeitures(t *testing.T) {
+	g := OptionGrant{Date: anchor, Options: 2000, FairValue: 5, VestingYears: 2, ForfeitureRate: 0.1}
+	tranches := g.Tranches()
+	if !almostEq(tranches[0], 900, 1e-9) || !almostEq(tranches[1], 810, 1e-9) {
+		t.Errorf("Tranches got %v, want [900 810]", tranches)
+	}
+	s, _ := g.ExpenseSchedule(AttributionStraightLine, 1)
+	if !almostEq(s[1].Cumulative, 1710*5, 1e-9) {
+		t.Errorf("total expense got %f, want %f", s[1].Cumulative, 1710.0*5)
+	}
+	if _, err := g.ExpenseSchedule(AttributionGraded, 5); err == nil {
+		t.Error("ExpenseSchedule accepted 5 periods per year")
+	}
+	if _, err := (OptionGrant{}).ExpenseSchedule(AttributionGraded, 1); err == nil {
+		t.Error("ExpenseSchedule accepted zero vesting years")
+	}
+}
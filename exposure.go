@@ -0,0 +1,82 @@
+package gofinance
+
+import "math"
+
+// MarketPosition is a position for exposure reporting: Quantity units of
+// an instrument on an underlying trading at Price, negative for a short.
+// Delta is the change in the instrument's value per unit change of the
+// underlying, 1 for shares and futures; Beta is the underlying's beta to
+// the benchmark, for example from [CAPM].
+// [EquityPosition] and [OptionPosition] fill Delta in.
+type MarketPosition struct {
+	Name     string
+	Quantity float64
+	Price    float64
+	Delta    float64
+	Beta     float64
+}
+
+// EquityPosition returns a position in shares, with a delta of 1.
+func EquityPosition(name string, shares, price, beta float64) MarketPosition {
+	return MarketPosition{name, shares, price, 1, beta}
+}
+
+// OptionPosition returns a position in European options, each on
+// multiplier units of the underlying, with the delta of [BlackScholesDelta].
+func OptionPosition(name string, contracts, multiplier, spot, strike, volatility float64, r, yield Rate, years float64, call bool, beta float64) MarketPosition {
+	delta := BlackScholesDelta(spot, strike, volatility, r, yield, years, call)
+	return MarketPosition{name, contracts * multiplier, spot, delta, beta}
+}
+
+// PositionExposure is the exposure of one position: its Notional, the
+// DeltaAdjusted exposure to its underlying, and the BetaAdjusted
+// exposure to the benchmark.
+type PositionExposure struct {
+	Name          string
+	Notional      float64
+	DeltaAdjusted float64
+	BetaAdjusted  float64
+}
+
+// ExposureReport sums the delta-adjusted exposures of a portfolio into
+// Long and Short, Gross and Net exposure, and the BetaAdjusted net market
+// exposure to the benchmark, the single number to hedge with index futures.
+type ExposureReport struct {
+	Positions    []PositionExposure
+	Long         float64
+	Short        float64
+	Gross        float64
+	Net          float64
+	BetaAdjusted float64
+}
+
+// Exposures reports the exposures of the positions.
+// Short is reported as a negative amount.
+// Math details:
+//
+// Notional = Quantity * Price
+//
+// DeltaAdjusted = Notional * Delta
+//
+// BetaAdjusted = DeltaAdjusted * Beta
+//
+// Gross = \sum |DeltaAdjusted|,   Net = \sum DeltaAdjusted
+func Exposures(positions []MarketPosition) ExposureReport {
+	report := ExposureReport{Positions: make([]PositionExposure, len(positions))}
+	for i, p := range positions {
+		e := PositionExposure{Name: p.Name, Notional: p.Quantity * p.Price}
+		e.DeltaAdjusted = e.Notional * p.Delta
+		e.BetaAdjusted = e.DeltaAdjusted * p.Beta
+		report.Positions[i] = e
+
+		if e.DeltaAdjusted > 0 {
+			report.Long += e.DeltaAdjusted
+		} else {
+			report.Short += e.DeltaAdjusted
+		}
+		report.Gross += math.Abs(e.DeltaAdjusted)
+		report.Net += e.DeltaAdjusted
+		report.BetaAdjusted += e.BetaAdjusted
+	}
+	return report
+}
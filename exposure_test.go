@@ -0,0 +1,51 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// Exposures
+// -----------------------------------------------------------------------------
+func TestExposures(t *testing.T) {
+	r, q := RateAnnualContinuous{0.03}, RateAnnualContinuous{0}
+	put := OptionPosition("XYZ puts", 10, 100, 50, 50, 0.3, r, q, 0.25, false, 1.5)
+	delta := BlackScholesDelta(50, 50, 0.3, r, q, 0.25, false)
+	if put.Quantity != 1000 || put.Delta != delta || put.Price != 50 {
+		t.Fatalf("OptionPosition got %+v", put)
+	}
+
+	report := Exposures([]MarketPosition{
+		EquityPosition("XYZ", 2000, 50, 1.5),
+		EquityPosition("utility", -1000, 40, 0.5),
+		put,
+	})
+
+	wantPositions := []PositionExposure{
+		{"XYZ", 100000, 100000, 150000},
+		{"utility", -40000, -40000, -20000},
+		{"XYZ puts", 50000, 50000 * delta, 75000 * delta},
+	}
+	for i, want := range wantPositions {
+		got := report.Positions[i]
+		if got.Name != want.Name || !almostEq(got.Notional, want.Notional, epsilon) ||
+			!almostEq(got.DeltaAdjusted, want.DeltaAdjusted, epsilon) || !almostEq(got.BetaAdjusted, want.BetaAdjusted, epsilon) {
+			t.Errorf("position %d got %+v, want %+v", i, got, want)
+		}
+	}
+
+	putExposure := 50000 * delta // negative, a long put is short the underlying
+	checks := []struct {
+		name      string
+		got, want float64
+	}{
+		{"Long", report.Long, 100000},
+		{"Short", report.Short, -40000 + putExposure},
+		{"Gross", report.Gross, 140000 - putExposure},
+		{"Net", report.Net, 60000 + putExposure},
+		{"BetaAdjusted", report.BetaAdjusted, 130000 + 1.5*putExposure},
+	}
+	for _, c := range checks {
+		if !almostEq(c.got, c.want, 1e-9) {
+			t.Errorf("%s got %f, want %f", c.name, c.got, c.want)
+		}
+	}
+}
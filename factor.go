@@ -0,0 +1,103 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+)
+
+// FactorModel is an ordinary least squares fit of an asset's excess returns
+// on factor returns: the intercept Alpha, one Beta per factor,
+// their t-statistics, and the RSquared of the fit.
+// Alpha is per period, like the returns.
+type FactorModel struct {
+	Alpha    float64
+	Betas    []float64
+	AlphaT   float64
+	BetaT    []float64
+	RSquared float64
+}
+
+// FitFactorModel regresses the asset's excess returns on the factor series,
+// factors[j][t] being factor j in period t, for example the market excess
+// return for the CAPM or the Fama-French market, SMB, and HML factors.
+// Math details:
+//
+// Excess_t = Alpha + \sum_j Beta_j * Factor_{j,t} + e_t
+//
+// Coefficients = (X^T X)^{-1} X^T Excess,   X = [1, Factors]
+//
+// t_j = Coefficient_j / \sqrt{s^2 * (X^T X)^{-1}_{jj}},   s^2 = \sum_t e_t^2 / (n - k - 1)
+//
+// RSquared = 1 - \sum_t e_t^2 / \sum_t (Excess_t - Mean(Excess))^2
+func FitFactorModel(excess []float64, factors [][]float64) (FactorModel, error) {
+	n, k := len(excess), len(factors)
+	if n <= k+1 {
+		return FactorModel{}, errors.New("FitFactorModel requires more periods than coefficients")
+	}
+	for _, f := range factors {
+		if len(f) != n {
+			return FactorModel{}, errors.New("FitFactorModel requires factor series as long as the returns")
+		}
+	}
+	regressor := func(j, t int) float64 {
+		if j == 0 {
+			return 1
+		}
+		return factors[j-1][t]
+	}
+
+	// normal equations X^T X and X^T y
+	p := k + 1
+	xtx := make([][]float64, p)
+	xty := make([]float64, p)
+	for i := range p {
+		xtx[i] = make([]float64, p)
+		for t := range n {
+			xty[i] += regressor(i, t) * excess[t]
+			for j := range p {
+				xtx[i][j] += regressor(i, t) * regressor(j, t)
+			}
+		}
+	}
+	// invert X^T X column by column, for the coefficients and their variances
+	inverse := make([][]float64, p)
+	for c := range p {
+		m := make([][]float64, p)
+		for i := range m {
+			m[i] = append([]float64(nil), xtx[i]...)
+		}
+		unit := make([]float64, p)
+		unit[c] = 1
+		col, err := solveLinear(m, unit)
+		if err != nil {
+			return FactorModel{}, errors.New("FitFactorModel: factors are collinear")
+		}
+		inverse[c] = col // symmetric, so columns are rows
+	}
+	coef := make([]float64, p)
+	for i := range p {
+		coef[i] = dot(inverse[i], xty)
+	}
+
+	ssr, sst, my := 0.0, 0.0, mean(excess)
+	for t := range n {
+		fit := 0.0
+		for j := range p {
+			fit += coef[j] * regressor(j, t)
+		}
+		ssr += (excess[t] - fit) * (excess[t] - fit)
+		sst += (excess[t] - my) * (excess[t] - my)
+	}
+	s2 := ssr / float64(n-p)
+	tstat := make([]float64, p)
+	for j := range p {
+		tstat[j] = coef[j] / math.Sqrt(s2*inverse[j][j])
+	}
+	return FactorModel{coef[0], coef[1:], tstat[0], tstat[1:], 1 - ssr/sst}, nil
+}
+
+// CAPM fits the capital asset pricing model: the asset's excess returns
+// on the market's excess returns, a one-factor [FitFactorModel].
+func CAPM(excess, marketExcess []float64) (FactorModel, error) {
+	return FitFactorModel(excess, [][]float64{marketExcess})
+}
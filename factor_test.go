@@ -0,0 +1,66 @@
+package gofinance
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Factor regression
+// -----------------------------------------------------------------------------
+func TestFitFactorModel(t *testing.T) {
+	// exact linear relation: coefficients recovered, perfect fit
+	mkt := []float64{0.01, -0.02, 0.03, 0.015, -0.01, 0.02}
+	smb := []float64{0.002, 0.001, -0.003, 0.004, 0.0, -0.001}
+	excess := make([]float64, len(mkt))
+	for i := range mkt {
+		excess[i] = 0.001 + 1.2*mkt[i] - 0.5*smb[i]
+	}
+	m, err := FitFactorModel(excess, [][]float64{mkt, smb})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !almostEq(m.Alpha, 0.001, 1e-12) || !almostEq(m.Betas[0], 1.2, 1e-10) || !almostEq(m.Betas[1], -0.5, 1e-9) || !almostEq(m.RSquared, 1, 1e-12) {
+		t.Errorf("FitFactorModel got %+v", m)
+	}
+}
+
+func TestCAPM(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 3))
+	n := 500
+	mkt, excess := make([]float64, n), make([]float64, n)
+	for i := range n {
+		mkt[i] = 0.005 + 0.04*rng.NormFloat64()
+		excess[i] = 0.8*mkt[i] + 0.01*rng.NormFloat64()
+	}
+	m, err := CAPM(excess, mkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// one-factor OLS: beta = Cov / Var, R^2 = correlation^2
+	beta := covariance(excess, mkt) / covariance(mkt, mkt)
+	if !almostEq(m.Betas[0], beta, 1e-12) {
+		t.Errorf("CAPM beta got %f, want %f", m.Betas[0], beta)
+	}
+	corr := covariance(excess, mkt) / math.Sqrt(covariance(excess, excess)*covariance(mkt, mkt))
+	if !almostEq(m.RSquared, corr*corr, 1e-12) {
+		t.Errorf("CAPM R squared got %f, want %f", m.RSquared, corr*corr)
+	}
+	if m.BetaT[0] < 50 || math.Abs(m.AlphaT) > 4 {
+		t.Errorf("CAPM t-statistics got alpha %f, beta %f", m.AlphaT, m.BetaT[0])
+	}
+}
+
+func TestFitFactorModelErrors(t *testing.T) {
+	x := []float64{1, 2, 3, 4}
+	if _, err := FitFactorModel(x, [][]float64{x, x}); err == nil {
+		t.Error("FitFactorModel accepted collinear factors")
+	}
+	if _, err := FitFactorModel(x[:2], [][]float64{x[:2]}); err == nil {
+		t.Error("FitFactorModel accepted too few periods")
+	}
+	if _, err := FitFactorModel(x, [][]float64{x[:3]}); err == nil {
+		t.Error("FitFactorModel accepted a short factor series")
+	}
+}
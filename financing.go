@@ -0,0 +1,74 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"time"
+)
+
+// FinancingTerms are a broker's terms for financing leveraged positions.
+// Rates are simple annual rates, for example 0.06 for 6%, accrued per
+// calendar day over DaysPerYear, usually 360 or 365.
+// DebitRate is charged on borrowed cash of long positions, CreditRate paid
+// on cash balances including short sale proceeds, BorrowFee charged on the
+// market value of shorted securities, and IntradayRate charged on the cash
+// borrowed during the day and repaid before the close.
+type FinancingTerms struct {
+	DebitRate    float64
+	CreditRate   float64
+	BorrowFee    float64
+	IntradayRate float64
+	DaysPerYear  float64
+}
+
+// FinancedPosition is the state of an account at the close of Date:
+// the Cash balance, negative when borrowing, and ShortValue, the market
+// value of shorted securities as a positive number.
+type FinancedPosition struct {
+	Date       time.Time
+	Cash       float64
+	ShortValue float64
+}
+
+// Overnight returns the financing accrued on a position held for nights,
+// negative for a cost. Weekends and holidays count as nights,
+// so a position held from Friday to Monday accrues three.
+// Math details:
+//
+// Accrual = (min(Cash, 0) * DebitRate + max(Cash, 0) * CreditRate - ShortValue * BorrowFee) * Nights / DaysPerYear
+func (f FinancingTerms) Overnight(cash, shortValue float64, nights int) float64 {
+	rate := min(cash, 0)*f.DebitRate + max(cash, 0)*f.CreditRate - shortValue*f.BorrowFee
+	return rate * float64(nights) / f.DaysPerYear
+}
+
+// Intraday returns the interest, negative, on debit borrowed within a day
+// and repaid before the close, charged for the hours it was outstanding.
+// Math details:
+//
+// Accrual = -Debit * IntradayRate * Hours / 24 / DaysPerYear
+func (f FinancingTerms) Intraday(debit, hours float64) float64 {
+	return -debit * f.IntradayRate * hours / 24 / f.DaysPerYear
+}
+
+// Accrue returns the overnight financing of an account as [CashFlows]:
+// each position is financed until the next, the accrual being paid on the
+// date of the next position, so it can be booked like any other cash-flow.
+// Positions are taken in date order, the input is left untouched.
+func (f FinancingTerms) Accrue(positions []FinancedPosition) (CashFlows, error) {
+	if f.DaysPerYear <= 0 {
+		return nil, errors.New("FinancingTerms.Accrue requires positive DaysPerYear")
+	}
+	ordered := slices.Clone(positions)
+	slices.SortStableFunc(ordered, func(a, b FinancedPosition) int { return a.Date.Compare(b.Date) })
+	var cfs CashFlows
+	for i := 1; i < len(ordered); i++ {
+		prev, next := ordered[i-1], ordered[i]
+		nights := int(math.Round(next.Date.Sub(prev.Date).Hours() / 24))
+		if nights == 0 {
+			continue
+		}
+		cfs = append(cfs, CashFlow{f.Overnight(prev.Cash, prev.ShortValue, nights), next.Date})
+	}
+	return cfs, nil
+}
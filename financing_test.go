@@ -0,0 +1,58 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// FinancingTerms
+// -----------------------------------------------------------------------------
+func TestFinancingTerms(t *testing.T) {
+	f := FinancingTerms{DebitRate: 0.06, CreditRate: 0.02, BorrowFee: 0.01, IntradayRate: 0.03, DaysPerYear: 360}
+
+	tests := []struct {
+		name       string
+		cash       float64
+		shortValue float64
+		nights     int
+		want       float64
+	}{
+		{"long on margin", -36000, 0, 1, -6},
+		{"cash balance", 36000, 0, 1, 2},
+		{"short over weekend", 36000, 36000, 3, 36000 * (0.02 - 0.01) * 3 / 360},
+		{"flat", 0, 0, 5, 0},
+	}
+	for _, tt := range tests {
+		if got := f.Overnight(tt.cash, tt.shortValue, tt.nights); !almostEq(got, tt.want, epsilon) {
+			t.Errorf("%s: Overnight got %f, want %f", tt.name, got, tt.want)
+		}
+	}
+
+	if got := f.Intraday(72000, 6); !almostEq(got, -1.5, epsilon) {
+		t.Errorf("Intraday got %f, want -1.5", got)
+	}
+}
+
+func TestFinancingTermsAccrue(t *testing.T) {
+	f := FinancingTerms{DebitRate: 0.06, CreditRate: 0.02, BorrowFee: 0.01, DaysPerYear: 360}
+	friday := anchor.AddDate(0, 0, 2) // 2020-01-03
+	positions := []FinancedPosition{
+		{friday.AddDate(0, 0, 3), 0, 0},
+		{friday, -36000, 0},
+		{friday.AddDate(0, 0, 4), 10, 0},
+	}
+	cfs, err := f.Accrue(positions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := CashFlows{{-18, friday.AddDate(0, 0, 3)}, {0, friday.AddDate(0, 0, 4)}}
+	if len(cfs) != len(want) {
+		t.Fatalf("Accrue got %+v", cfs)
+	}
+	for i := range want {
+		if !almostEq(cfs[i].Value, want[i].Value, epsilon) || !cfs[i].Date.Equal(want[i].Date) {
+			t.Errorf("accrual %d got %+v, want %+v", i, cfs[i], want[i])
+		}
+	}
+	if _, err := (FinancingTerms{}).Accrue(positions); err == nil {
+		t.Error("Accrue accepted zero DaysPerYear")
+	}
+}
@@ -0,0 +1,89 @@
+package gofinance
+
+import "time"
+
+// Forward prices follow from the cost of carry: buying the underlying today
+// and holding it to delivery must cost the same as agreeing to buy it forward.
+//
+// Note on futures: with deterministic interest rates a futures price equals
+// the forward price, so the functions below price futures as well.
+// When rates are stochastic and positively correlated with the underlying,
+// daily margining makes futures prices slightly higher than forward prices.
+// For interest rate futures the gap is material and is approximated by
+// [FuturesRateConvexityAdjustment].
+
+// ForwardPrice returns the cost-of-carry forward price of an asset that pays
+// a continuous yield, for example a stock index with a dividend yield.
+// Math details:
+//
+// Forward = Spot * DiscountFactor_yield(Years) / DiscountFactor_rate(Years)
+//
+// which for continuous rates is the familiar
+//
+// Forward = Spot * e^{(ContinuousRate - ContinuousYield) * Years}
+func ForwardPrice(spot float64, r Rate, yield Rate, years float64) float64 {
+	return spot * yield.DiscountFactor(years) / r.DiscountFactor(years)
+}
+
+// ForwardPriceDiscreteDividends returns the forward price of an asset that
+// pays known discrete dividends between valuationDate and deliveryDate.
+// Dividends outside that window are ignored.
+// Math details:
+//
+// Forward = (Spot - PresentValue(Dividends)) / DiscountFactor(Years)
+func ForwardPriceDiscreteDividends(spot float64, dividends CashFlows, r Rate, valuationDate, deliveryDate time.Time) float64 {
+	pvDividends := 0.0
+	for _, d := range dividends {
+		if d.Date.After(valuationDate) && !d.Date.After(deliveryDate) {
+			pvDividends += d.PresentValue(r, valuationDate)
+		}
+	}
+	years := yearsBetween(valuationDate, deliveryDate)
+	return (spot - pvDividends) / r.DiscountFactor(years)
+}
+
+// ForwardPriceFX returns the outright FX forward rate by covered interest parity.
+// spot is quoted as units of domestic currency per one unit of foreign currency,
+// domestic and foreign are the interest rates of the two currencies.
+// Math details:
+//
+// Forward = Spot * DiscountFactor_foreign(Years) / DiscountFactor_domestic(Years)
+func ForwardPriceFX(spot float64, domestic, foreign Rate, years float64) float64 {
+	return ForwardPrice(spot, domestic, foreign, years)
+}
+
+// ForwardPriceCommodity returns the forward price of a commodity with a
+// storage cost and a convenience yield, both expressed as rates on the spot value.
+// Storage adds to the cost of carry, the convenience yield reduces it.
+// Math details:
+//
+// Forward = Spot * DiscountFactor_convenience(Years) / (DiscountFactor_rate(Years) * DiscountFactor_storage(Years))
+//
+// which for continuous rates is
+//
+// Forward = Spot * e^{(ContinuousRate + Storage - Convenience) * Years}
+func ForwardPriceCommodity(spot float64, r Rate, storage, convenience Rate, years float64) float64 {
+	return spot * convenience.DiscountFactor(years) / (r.DiscountFactor(years) * storage.DiscountFactor(years))
+}
+
+// ForwardValue returns the value today of a long forward contract struck at
+// deliveryPrice, given the current forward price for the same delivery date.
+// A short position is worth the negative of this.
+// Math details:
+//
+// Value = (Forward - DeliveryPrice) * DiscountFactor(Years)
+func ForwardValue(forward, deliveryPrice float64, r Rate, years float64) float64 {
+	return (forward - deliveryPrice) * r.DiscountFactor(years)
+}
+
+// FuturesRateConvexityAdjustment returns the amount by which an interest rate
+// futures rate exceeds the forward rate for the same period, under the Ho-Lee
+// model with the given annual short-rate volatility.
+// years is the time to futures expiry, maturityYears the time to the end
+// of the underlying rate period.
+// Math details:
+//
+// ForwardRate = FuturesRate - 1/2 * Volatility^2 * Years * MaturityYears
+func FuturesRateConvexityAdjustment(volatility, years, maturityYears float64) float64 {
+	return 0.5 * volatility * volatility * years * maturityYears
+}
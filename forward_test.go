@@ -0,0 +1,85 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Continuous-yield forwards
+// -----------------------------------------------------------------------------
+func TestForwardPrice(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.05}
+	q := RateAnnualContinuous{Value: 0.02}
+
+	want := 100 * math.Exp((0.05-0.02)*2)
+	if got := ForwardPrice(100, r, q, 2); !almostEq(got, want, epsilon) {
+		t.Errorf("ForwardPrice got %.10f, want %.10f", got, want)
+	}
+
+	// zero years: forward equals spot
+	if got := ForwardPrice(100, r, q, 0); got != 100 {
+		t.Errorf("ForwardPrice at zero years got %f, want 100", got)
+	}
+
+	// mixed conventions: 4% effective annual rate, no yield
+	ea := RateEffective{Value: 0.04, PeriodsPerYear: 1}
+	if got := ForwardPrice(50, ea, RateAnnualContinuous{}, 3); !almostEq(got, 50*math.Pow(1.04, 3), epsilon) {
+		t.Errorf("ForwardPrice effective got %.10f, want %.10f", got, 50*math.Pow(1.04, 3))
+	}
+}
+
+func TestForwardPriceDiscreteDividends(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.05}
+	delivery := anchor.AddDate(1, 0, 0)
+	dividends := CashFlows{
+		{Value: 2, Date: anchor.AddDate(0, 6, 0)},
+		{Value: 9, Date: anchor.AddDate(2, 0, 0)},  // after delivery
+		{Value: 9, Date: anchor.AddDate(-1, 0, 0)}, // already paid
+	}
+
+	pv := dividends[0].PresentValue(r, anchor)
+	want := (100 - pv) * math.Exp(0.05)
+	if got := ForwardPriceDiscreteDividends(100, dividends, r, anchor, delivery); !almostEq(got, want, epsilon) {
+		t.Errorf("ForwardPriceDiscreteDividends got %.10f, want %.10f", got, want)
+	}
+}
+
+func TestForwardPriceFX(t *testing.T) {
+	// EURUSD 1.10, USD 5% domestic, EUR 3% foreign, continuous
+	usd := RateAnnualContinuous{Value: 0.05}
+	eur := RateAnnualContinuous{Value: 0.03}
+	want := 1.10 * math.Exp((0.05-0.03)*0.5)
+	if got := ForwardPriceFX(1.10, usd, eur, 0.5); !almostEq(got, want, epsilon) {
+		t.Errorf("ForwardPriceFX got %.10f, want %.10f", got, want)
+	}
+}
+
+func TestForwardPriceCommodity(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.04}
+	storage := RateAnnualContinuous{Value: 0.01}
+	convenience := RateAnnualContinuous{Value: 0.03}
+	want := 80 * math.Exp((0.04+0.01-0.03)*1.5)
+	if got := ForwardPriceCommodity(80, r, storage, convenience, 1.5); !almostEq(got, want, epsilon) {
+		t.Errorf("ForwardPriceCommodity got %.10f, want %.10f", got, want)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Forward contract value & futures convexity
+// -----------------------------------------------------------------------------
+func TestForwardValue(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.05}
+	want := (105 - 100) * math.Exp(-0.05)
+	if got := ForwardValue(105, 100, r, 1); !almostEq(got, want, epsilon) {
+		t.Errorf("ForwardValue got %.10f, want %.10f", got, want)
+	}
+}
+
+func TestFuturesRateConvexityAdjustment(t *testing.T) {
+	// Hull's example: σ = 1.2%, 8 years to expiry, 8.25 years to maturity
+	want := 0.5 * 0.012 * 0.012 * 8 * 8.25
+	if got := FuturesRateConvexityAdjustment(0.012, 8, 8.25); !almostEq(got, want, epsilon) {
+		t.Errorf("FuturesRateConvexityAdjustment got %.10f, want %.10f", got, want)
+	}
+}
@@ -0,0 +1,140 @@
+package gofinance
+
+import (
+	"math"
+	"time"
+)
+
+// RateConvention selects how a quoted rate accrues over a period:
+// simple interest, compounding a number of times a year, or continuously.
+type RateConvention int
+
+const (
+	ConventionSimple RateConvention = iota
+	ConventionContinuous
+	ConventionAnnual
+	ConventionSemiAnnual
+	ConventionQuarterly
+	ConventionMonthly
+)
+
+// periodsPerYear returns the compounding frequency of periodic conventions
+// and 0 for simple and continuous ones.
+func (c RateConvention) periodsPerYear() float64 {
+	switch c {
+	case ConventionAnnual:
+		return 1
+	case ConventionSemiAnnual:
+		return 2
+	case ConventionQuarterly:
+		return 4
+	case ConventionMonthly:
+		return 12
+	}
+	return 0
+}
+
+// ForwardRate returns the rate for the period from years1 to years2 implied
+// by the curve, quoted in the given convention.
+// Math details:
+//
+// Growth = DiscountFactor(Years1) / DiscountFactor(Years2),   Tau = Years2 - Years1
+//
+// Simple = (Growth - 1) / Tau
+//
+// Continuous = ln(Growth) / Tau
+//
+// Periodic = Periods * (Growth^{1 / (Periods * Tau)} - 1)
+func ForwardRate(curve YieldCurve, years1, years2 float64, convention RateConvention) float64 {
+	growth := curve.DiscountFactor(years1) / curve.DiscountFactor(years2)
+	return convention.rate(growth, years2-years1)
+}
+
+// periodForward returns the simple forward rate implied by the curve, as
+// seen from valuationDate, for the accrual period from start to end on
+// dayCount, so that accruing it over the period is worth
+// DiscountFactor(start) - DiscountFactor(end) whatever the day count.
+// A period already in progress is projected from valuationDate.
+// Math details:
+//
+// Forward = (DiscountFactor(Start) / DiscountFactor(End) - 1) / YearFraction(Start, End)
+func periodForward(curve YieldCurve, valuationDate, start, end time.Time, dayCount DayCount) float64 {
+	if start.Before(valuationDate) {
+		start = valuationDate
+	}
+	growth := curve.DiscountFactor(yearsBetween(valuationDate, start)) / curve.DiscountFactor(yearsBetween(valuationDate, end))
+	return ConventionSimple.rate(growth, dayCount.YearFraction(start, end))
+}
+
+// rate returns the rate in convention c that grows 1 into growth over tau years.
+func (c RateConvention) rate(growth, tau float64) float64 {
+	switch c {
+	case ConventionSimple:
+		return (growth - 1) / tau
+	case ConventionContinuous:
+		return math.Log(growth) / tau
+	}
+	m := c.periodsPerYear()
+	return m * (math.Pow(growth, 1/(m*tau)) - 1)
+}
+
+// growth returns what 1 grows into over tau years at rate in convention c,
+// the inverse of [RateConvention.rate].
+func (c RateConvention) growth(rate, tau float64) float64 {
+	switch c {
+	case ConventionSimple:
+		return 1 + rate*tau
+	case ConventionContinuous:
+		return math.Exp(rate * tau)
+	}
+	m := c.periodsPerYear()
+	return math.Pow(1+rate/m, m*tau)
+}
+
+// ForwardRateAgreement represents an FRA: a contract to exchange, on Start,
+// the difference between a reference rate and ContractRate for the period
+// from Start to End on Notional.
+// ContractRate and the reference rate are simple annual rates,
+// the period length is the year fraction between Start and End.
+//
+// PayFixed selects the side: true for the buyer, who pays ContractRate and
+// gains when rates rise, false for the seller.
+type ForwardRateAgreement struct {
+	Notional     float64
+	ContractRate float64
+	Start        time.Time
+	End          time.Time
+	PayFixed     bool
+}
+
+// sign returns +1 for the buyer and -1 for the seller.
+func (f ForwardRateAgreement) sign() float64 {
+	if f.PayFixed {
+		return 1
+	}
+	return -1
+}
+
+// SettlementAmount returns the amount paid on Start once the reference rate
+// for the period is fixed. Since it is paid at the start of the period
+// rather than the end, the difference is discounted at the reference rate.
+// Math details:
+//
+// Settlement = Notional * (ReferenceRate - ContractRate) * Tau / (1 + ReferenceRate * Tau)
+func (f ForwardRateAgreement) SettlementAmount(referenceRate float64) float64 {
+	tau := yearsBetween(f.Start, f.End)
+	return f.sign() * f.Notional * (referenceRate - f.ContractRate) * tau / (1 + referenceRate*tau)
+}
+
+// Value returns the value of the FRA at valuationDate, with the reference rate
+// projected off the curve as a simple [ForwardRate].
+// Math details:
+//
+// Value = Notional * (ForwardRate - ContractRate) * Tau * DiscountFactor(End)
+func (f ForwardRateAgreement) Value(curve YieldCurve, valuationDate time.Time) float64 {
+	t1 := yearsBetween(valuationDate, f.Start)
+	t2 := yearsBetween(valuationDate, f.End)
+	forward := ForwardRate(curve, t1, t2, ConventionSimple)
+	tau := yearsBetween(f.Start, f.End)
+	return f.sign() * f.Notional * (forward - f.ContractRate) * tau * curve.DiscountFactor(t2)
+}
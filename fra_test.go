@@ -0,0 +1,82 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// ForwardRate
+// -----------------------------------------------------------------------------
+func TestForwardRate(t *testing.T) {
+	curve, _ := NewYieldCurveZero([]float64{1, 2}, []float64{0.03, 0.04})
+	// continuous forward between pillars: (r2*t2 - r1*t1) / (t2 - t1)
+	cont := (0.04*2 - 0.03*1) / 1.0
+	growth := math.Exp(cont)
+
+	tests := []struct {
+		name       string
+		convention RateConvention
+		want       float64
+	}{
+		{"simple", ConventionSimple, growth - 1},
+		{"continuous", ConventionContinuous, cont},
+		{"annual", ConventionAnnual, growth - 1},
+		{"semi-annual", ConventionSemiAnnual, 2 * (math.Sqrt(growth) - 1)},
+		{"quarterly", ConventionQuarterly, 4 * (math.Pow(growth, 0.25) - 1)},
+		{"monthly", ConventionMonthly, 12 * (math.Pow(growth, 1.0/12) - 1)},
+	}
+	for _, tc := range tests {
+		if got := ForwardRate(curve, 1, 2, tc.convention); !almostEq(got, tc.want, epsilon) {
+			t.Errorf("%s: ForwardRate got %.12f, want %.12f", tc.name, got, tc.want)
+		}
+	}
+
+	// a flat continuous curve has the same forward everywhere
+	flat := RateAnnualContinuous{Value: 0.05}
+	if got := ForwardRate(flat, 3, 3.5, ConventionContinuous); !almostEq(got, 0.05, epsilon) {
+		t.Errorf("flat curve ForwardRate got %v, want 0.05", got)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// ForwardRateAgreement
+// -----------------------------------------------------------------------------
+func TestForwardRateAgreementSettlement(t *testing.T) {
+	f := ForwardRateAgreement{
+		Notional:     1_000_000,
+		ContractRate: 0.05,
+		Start:        anchor.AddDate(1, 0, 0),
+		End:          anchor.AddDate(2, 0, 0),
+		PayFixed:     true,
+	}
+	// one-year period: Tau = 1
+	want := 1_000_000 * (0.06 - 0.05) / 1.06
+	if got := f.SettlementAmount(0.06); !almostEq(got, want, epsilon) {
+		t.Errorf("buyer SettlementAmount got %.6f, want %.6f", got, want)
+	}
+	f.PayFixed = false
+	if got := f.SettlementAmount(0.06); !almostEq(got, -want, epsilon) {
+		t.Errorf("seller SettlementAmount got %.6f, want %.6f", got, -want)
+	}
+}
+
+func TestForwardRateAgreementValue(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.04}
+	f := ForwardRateAgreement{
+		Notional: 100,
+		Start:    anchor.AddDate(1, 0, 0),
+		End:      anchor.AddDate(2, 0, 0),
+		PayFixed: true,
+	}
+	f.ContractRate = ForwardRate(r, 1, 2, ConventionSimple)
+	if got := f.Value(r, anchor); math.Abs(got) > 1e-12 {
+		t.Errorf("FRA at the forward rate worth %g, want 0", got)
+	}
+
+	f.ContractRate = 0.03
+	want := 100 * (math.Exp(0.04) - 1 - 0.03) * math.Exp(-0.04*2)
+	if got := f.Value(r, anchor); !almostEq(got, want, epsilon) {
+		t.Errorf("FRA Value got %.10f, want %.10f", got, want)
+	}
+}
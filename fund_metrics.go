@@ -0,0 +1,123 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Fund is the record of a private-fund investment from the investor's
+// side: capital called as positive Contributions, cash received as
+// positive Distributions, and the reported net asset values.
+type Fund struct {
+	Contributions CashFlows
+	Distributions CashFlows
+	NAV           TimeSeries
+}
+
+// FundMetrics is the LP performance report of a [Fund] at a date: paid-in
+// capital, distributions and the latest NAV, their multiples and the
+// since-inception IRR treating the NAV as a final distribution.
+type FundMetrics struct {
+	Date        time.Time
+	PaidIn      float64
+	Distributed float64
+	NAV         float64
+	DPI         float64
+	RVPI        float64
+	TVPI        float64
+	IRR         Rate
+}
+
+// Metrics reports the fund at date, counting the cash-flows up to and
+// including date and the latest NAV on or before it, zero if none.
+// Math details:
+//
+// DPI = Distributed / PaidIn,   RVPI = NAV / PaidIn,   TVPI = DPI + RVPI
+func (f Fund) Metrics(date time.Time) (FundMetrics, error) {
+	flows, nav, err := f.until(date)
+	if err != nil {
+		return FundMetrics{}, err
+	}
+	m := FundMetrics{Date: date, NAV: nav}
+	for _, cf := range flows {
+		if cf.Value < 0 {
+			m.PaidIn -= cf.Value
+		} else {
+			m.Distributed += cf.Value
+		}
+	}
+	m.DPI = m.Distributed / m.PaidIn
+	m.RVPI = m.NAV / m.PaidIn
+	m.TVPI = m.DPI + m.RVPI
+	if nav != 0 {
+		flows = append(flows, CashFlow{nav, date})
+	}
+	if m.IRR, err = flows.IRR(); err != nil {
+		return FundMetrics{}, err
+	}
+	return m, nil
+}
+
+// KaplanSchoarPME compares the fund with a public benchmark index at date,
+// every contribution and distribution compounded by the index to date.
+// A PME above one means the fund beat the benchmark.
+// Math details:
+//
+// PME = (\sum_t D_t * I_T / I_t + NAV_T) / \sum_t C_t * I_T / I_t
+func (f Fund) KaplanSchoarPME(benchmark PriceIndex, date time.Time) (float64, error) {
+	flows, nav, err := f.until(date)
+	if err != nil {
+		return 0, err
+	}
+	end, err := benchmark.Level(date)
+	if err != nil {
+		return 0, err
+	}
+	in, out := 0.0, nav
+	for _, cf := range flows {
+		level, err := benchmark.Level(cf.Date)
+		if err != nil {
+			return 0, err
+		}
+		if cf.Value < 0 {
+			in -= cf.Value * end / level
+		} else {
+			out += cf.Value * end / level
+		}
+	}
+	return out / in, nil
+}
+
+// until returns the investor cash-flows up to date, contributions as
+// negative values, and the latest NAV on or before date.
+func (f Fund) until(date time.Time) (CashFlows, float64, error) {
+	var flows CashFlows
+	for _, cf := range f.Contributions {
+		if cf.Value <= 0 {
+			return nil, 0, errors.New("Fund requires positive contributions")
+		}
+		if !cf.Date.After(date) {
+			flows = append(flows, CashFlow{-cf.Value, cf.Date})
+		}
+	}
+	if len(flows) == 0 {
+		return nil, 0, fmt.Errorf("Fund: %w", ErrEmptyCashFlows)
+	}
+	for _, cf := range f.Distributions {
+		if cf.Value < 0 {
+			return nil, 0, errors.New("Fund requires non-negative distributions")
+		}
+		if !cf.Date.After(date) {
+			flows = append(flows, cf)
+		}
+	}
+	nav := 0.0
+	navs := slices.Clone(f.NAV)
+	navs.Sort()
+	if len(navs) > 0 && !navs[0].Date.After(date) {
+		nav, _ = navs.Level(date)
+	}
+	return flows, nav, nil
+}
@@ -0,0 +1,84 @@
+package gofinance
+
+import (
+	"errors"
+	"testing"
+)
+
+// testFund calls 150 in two drawdowns and distributes 110 over three years,
+// against a benchmark growing 10 % a year.
+func testFund() (Fund, TimeSeries) {
+	y1, y2, y3 := anchor.AddDate(1, 0, 0), anchor.AddDate(2, 0, 0), anchor.AddDate(3, 0, 0)
+	fund := Fund{
+		Contributions: CashFlows{{100, anchor}, {50, y1}},
+		Distributions: CashFlows{{80, y3}, {30, y2}},
+		NAV:           TimeSeries{{y2, 120}, {y1, 140}, {y3, 70}},
+	}
+	benchmark := TimeSeries{{anchor, 100}, {y1, 110}, {y2, 121}, {y3, 133.1}}
+	return fund, benchmark
+}
+
+// -----------------------------------------------------------------------------
+// Fund.Metrics
+// -----------------------------------------------------------------------------
+func TestFundMetrics(t *testing.T) {
+	fund, _ := testFund()
+	y3 := anchor.AddDate(3, 0, 0)
+	m, err := fund.Metrics(y3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.PaidIn != 150 || m.Distributed != 110 || m.NAV != 70 ||
+		!almostEq(m.DPI, 110.0/150, epsilon) || !almostEq(m.RVPI, 70.0/150, epsilon) || !almostEq(m.TVPI, 1.2, epsilon) {
+		t.Errorf("Metrics got %+v", m)
+	}
+	flows := CashFlows{{-100, anchor}, {-50, anchor.AddDate(1, 0, 0)}, {30, anchor.AddDate(2, 0, 0)}, {150, y3}}
+	if npv := flows.NPV(m.IRR, anchor); !almostEq(npv, 0, 1e-9) {
+		t.Errorf("NPV at IRR %v got %v, want 0", m.IRR.RateAnnualEffective(), npv)
+	}
+
+	// mid-way, only the first drawdown and the first NAV count
+	mid, err := fund.Metrics(anchor.AddDate(0, 18, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mid.PaidIn != 150 || mid.Distributed != 0 || mid.NAV != 140 || mid.DPI != 0 || !almostEq(mid.TVPI, 140.0/150, epsilon) {
+		t.Errorf("mid-way Metrics got %+v", mid)
+	}
+
+	if _, err := fund.Metrics(anchor.AddDate(0, 0, -1)); !errors.Is(err, ErrEmptyCashFlows) {
+		t.Errorf("before the first call got %v, want ErrEmptyCashFlows", err)
+	}
+	if _, err := (Fund{Contributions: CashFlows{{-1, anchor}}}).Metrics(y3); err == nil {
+		t.Error("negative contribution: expected error")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Fund.KaplanSchoarPME
+// -----------------------------------------------------------------------------
+func TestKaplanSchoarPME(t *testing.T) {
+	fund, benchmark := testFund()
+	y3 := anchor.AddDate(3, 0, 0)
+	pme, err := fund.KaplanSchoarPME(benchmark, y3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := (30*133.1/121 + 80 + 70) / (100*1.331 + 50*1.21)
+	if !almostEq(pme, want, 1e-9) || pme >= 1 {
+		t.Errorf("PME got %v, want %v", pme, want)
+	}
+
+	// a fund tracking the index exactly has a PME of one
+	tracker := Fund{Contributions: CashFlows{{100, anchor}}, NAV: TimeSeries{{y3, 133.1}}}
+	if pme, _ := tracker.KaplanSchoarPME(benchmark, y3); !almostEq(pme, 1, 1e-12) {
+		t.Errorf("tracker PME got %v, want 1", pme)
+	}
+
+	if _, err := fund.KaplanSchoarPME(benchmark[1:], y3); err == nil {
+		t.Error("benchmark starting after the first call: expected error")
+	}
+	if pme, _ := fund.KaplanSchoarPME(benchmark, anchor); pme != 0 {
+		t.Errorf("at the first call, before any NAV, got %v, want 0", pme)
+	}
+}
@@ -0,0 +1,119 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// FundingProjection projects the funding ratio of a [PensionPlan], its assets
+// over its obligation, with simulated asset returns against the expected
+// development of the liability. Assets earn lognormal annual returns with the
+// given expected log return and volatility, receive Contributions and pay the
+// expected benefits at the end of each year. The liability follows the
+// members as they age, leave, and die, as the [PensionPlan] expects.
+// Seed makes the simulation reproducible.
+type FundingProjection struct {
+	Plan          PensionPlan
+	Members       []PlanMember
+	Assets        float64
+	Contributions float64
+	ExpectedLog   float64
+	Volatility    float64
+	Years         int
+	Paths         int
+	Seed          uint64
+}
+
+// FundingYear summarizes the simulated funding ratios at the end of Year:
+// the expected Liability and Benefits paid, the Median, 5th and 95th
+// percentile funding ratios, and the Shortfall probability of a funding
+// ratio below 1.
+type FundingYear struct {
+	Year      int
+	Liability float64
+	Benefits  float64
+	Median    float64
+	Low       float64
+	High      float64
+	Shortfall float64
+}
+
+// agedMember returns member m after years more years in the plan,
+// with the probability of still being in it.
+func (p PensionPlan) agedMember(m PlanMember, years int) (PlanMember, float64) {
+	active := min(years, max(0, p.RetirementAge-m.Age))
+	weight := p.survival(m.Age, years) * math.Pow(1-p.Turnover, float64(active))
+	m.Age += years
+	m.Service += float64(active)
+	m.Salary *= math.Pow(1+p.SalaryGrowth, float64(active))
+	return m, weight
+}
+
+// expected returns the expected liability at the end of year t
+// and the expected benefits paid at its end.
+func (f FundingProjection) expected(t int) (liability, benefits float64) {
+	for _, m := range f.Members {
+		aged, weight := f.Plan.agedMember(m, t)
+		liability += weight * f.Plan.DBO(aged)
+		if aged.Age >= f.Plan.RetirementAge {
+			benefits += weight * f.Plan.AccrualRate * aged.Service * aged.Salary
+		}
+	}
+	return liability, benefits
+}
+
+// Project runs the simulation and returns one summary per year.
+// Math details:
+//
+// Assets_t = Assets_{t-1} * e^{ExpectedLog + Volatility * Z_t} + Contributions - Benefits_t
+//
+// FundingRatio_t = Assets_t / Liability_t
+func (f FundingProjection) Project() ([]FundingYear, error) {
+	if f.Years <= 0 || f.Paths <= 0 {
+		return nil, errors.New("FundingProjection requires positive years and paths")
+	}
+	liabilities, benefits := make([]float64, f.Years), make([]float64, f.Years)
+	for t := range f.Years {
+		liabilities[t], benefits[t] = f.expected(t + 1)
+	}
+	rng := rand.New(rand.NewPCG(f.Seed, f.Seed))
+	ratios := make([][]float64, f.Years)
+	for t := range ratios {
+		ratios[t] = make([]float64, f.Paths)
+	}
+	for p := range f.Paths {
+		assets := f.Assets
+		for t := range f.Years {
+			assets = assets*math.Exp(f.ExpectedLog+f.Volatility*rng.NormFloat64()) + f.Contributions - benefits[t]
+			ratios[t][p] = assets / liabilities[t]
+		}
+	}
+	years := make([]FundingYear, f.Years)
+	for t, r := range ratios {
+		slices.Sort(r)
+		short, _ := slices.BinarySearch(r, 1)
+		years[t] = FundingYear{
+			Year:      t + 1,
+			Liability: liabilities[t],
+			Benefits:  benefits[t],
+			Median:    quantileSorted(r, 0.5),
+			Low:       quantileSorted(r, 0.05),
+			High:      quantileSorted(r, 0.95),
+			Shortfall: float64(short) / float64(len(r)),
+		}
+	}
+	return years, nil
+}
+
+// quantileSorted returns the q-quantile of sorted values,
+// interpolating linearly between order statistics.
+func quantileSorted(sorted []float64, q float64) float64 {
+	h := q * float64(len(sorted)-1)
+	i := int(h)
+	if i >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[i] + (h-float64(i))*(sorted[i+1]-sorted[i])
+}
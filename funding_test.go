@@ -0,0 +1,69 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// FundingProjection
+// -----------------------------------------------------------------------------
+func TestFundingProjection(t *testing.T) {
+	mortality := make([]float64, 110)
+	for x := range mortality {
+		mortality[x] = 0.005 + 0.0001*float64(x)
+	}
+	plan := PensionPlan{AccrualRate: 0.015, RetirementAge: 65, SalaryGrowth: 0.02, Turnover: 0.02, Mortality: mortality, DiscountRate: RateEffective{0.03, 1}}
+	members := []PlanMember{{Age: 45, Service: 15, Salary: 60000}, {Age: 63, Service: 30, Salary: 80000}, {Age: 70, Service: 35, Salary: 50000}}
+	dbo := plan.Valuation(members).DBO
+
+	f := FundingProjection{Plan: plan, Members: members, Assets: dbo, ExpectedLog: 0.04, Volatility: 0.10, Years: 5, Paths: 20000, Seed: 1}
+	years, err := f.Project()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(years) != 5 || years[0].Year != 1 {
+		t.Fatalf("Project got %+v", years)
+	}
+	for _, y := range years {
+		if !(y.Low < y.Median && y.Median < y.High) || y.Shortfall <= 0 || y.Shortfall >= 1 {
+			t.Errorf("year %d summary inconsistent: %+v", y.Year, y)
+		}
+	}
+	if years[0].Benefits <= 0 {
+		t.Error("pensioner benefits not paid")
+	}
+	// more volatile assets widen the spread of funding ratios
+	f.Volatility = 0.2
+	risky, _ := f.Project()
+	if risky[4].High-risky[4].Low <= years[4].High-years[4].Low {
+		t.Error("higher volatility did not widen the funding ratio band")
+	}
+
+	// without volatility the projection is deterministic
+	f.Volatility, f.Paths = 0, 10
+	sure, _ := f.Project()
+	liability, benefits := f.expected(1)
+	want := (dbo*math.Exp(0.04) - benefits) / liability
+	if !almostEq(sure[0].Median, want, 1e-12) || sure[0].Low != sure[0].High {
+		t.Errorf("deterministic funding ratio got %+v, want %f", sure[0], want)
+	}
+	if _, err := (FundingProjection{}).Project(); err == nil {
+		t.Error("Project accepted zero years")
+	}
+}
+
+func TestAgedMember(t *testing.T) {
+	plan := PensionPlan{RetirementAge: 65, SalaryGrowth: 0.1, Turnover: 0.5, Mortality: make([]float64, 100)}
+	m, w := plan.agedMember(PlanMember{Age: 63, Service: 10, Salary: 100}, 4)
+	if m.Age != 67 || m.Service != 12 || !almostEq(m.Salary, 121, 1e-12) || !almostEq(w, 0.25, 1e-15) {
+		t.Errorf("agedMember got %+v with weight %f", m, w)
+	}
+}
+
+func TestQuantileSorted(t *testing.T) {
+	s := []float64{1, 2, 3, 4, 5}
+	if quantileSorted(s, 0.5) != 3 || quantileSorted(s, 0.1) != 1.4 || quantileSorted(s, 1) != 5 {
+		t.Error("quantileSorted wrong")
+	}
+}
@@ -0,0 +1,221 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// CurrencyPair is a pair of ISO currency codes quoted as Base/Quote, for
+// example EUR/USD: the price of one EUR in USD.
+type CurrencyPair struct {
+	Base  string
+	Quote string
+}
+
+// ParseCurrencyPair parses a pair written EUR/USD, EUR-USD, or EURUSD.
+func ParseCurrencyPair(s string) (CurrencyPair, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	var p CurrencyPair
+	switch {
+	case len(s) == 7 && (s[3] == '/' || s[3] == '-'):
+		p = CurrencyPair{s[:3], s[4:]}
+	case len(s) == 6:
+		p = CurrencyPair{s[:3], s[3:]}
+	default:
+		return CurrencyPair{}, fmt.Errorf("unsupported currency pair: %s", s)
+	}
+	if p.Base == p.Quote || strings.ContainsAny(p.Base+p.Quote, "/- ") {
+		return CurrencyPair{}, fmt.Errorf("unsupported currency pair: %s", s)
+	}
+	return p, nil
+}
+
+// String returns the pair as Base/Quote.
+func (p CurrencyPair) String() string {
+	return p.Base + "/" + p.Quote
+}
+
+// Inverse returns the pair with Base and Quote swapped.
+func (p CurrencyPair) Inverse() CurrencyPair {
+	return CurrencyPair{p.Quote, p.Base}
+}
+
+// PipSize returns the size of one pip of the pair, 0.01 when quoted in
+// JPY and 0.0001 otherwise; forward points are quoted in pips.
+func (p CurrencyPair) PipSize() float64 {
+	if p.Quote == "JPY" {
+		return 0.01
+	}
+	return 0.0001
+}
+
+// FXRate is a mid exchange rate: Rate units of Pair.Quote per one unit of
+// Pair.Base.
+type FXRate struct {
+	Pair CurrencyPair
+	Rate float64
+}
+
+// Inverse returns the same rate quoted the other way round.
+func (r FXRate) Inverse() FXRate {
+	return FXRate{r.Pair.Inverse(), 1 / r.Rate}
+}
+
+// Direct returns the rate as a direct quote for home, the price of one
+// unit of the foreign currency in home currency, e.g. 1.10 USD per EUR
+// in the US.
+func (r FXRate) Direct(home string) (FXRate, error) {
+	switch home {
+	case r.Pair.Quote:
+		return r, nil
+	case r.Pair.Base:
+		return r.Inverse(), nil
+	}
+	return FXRate{}, fmt.Errorf("FXRate.Direct: %s is not in %s", home, r.Pair)
+}
+
+// Indirect returns the rate as an indirect quote for home, the amount of
+// foreign currency one unit of home currency buys, e.g. 0.91 EUR per USD
+// in the US.
+func (r FXRate) Indirect(home string) (FXRate, error) {
+	direct, err := r.Direct(home)
+	if err != nil {
+		return FXRate{}, fmt.Errorf("FXRate.Indirect: %s is not in %s", home, r.Pair)
+	}
+	return direct.Inverse(), nil
+}
+
+// Convert converts amount of currency from into the other currency of
+// the pair.
+func (r FXRate) Convert(amount float64, from string) (float64, error) {
+	switch from {
+	case r.Pair.Base:
+		return amount * r.Rate, nil
+	case r.Pair.Quote:
+		return amount / r.Rate, nil
+	}
+	return 0, fmt.Errorf("FXRate.Convert: %s is not in %s", from, r.Pair)
+}
+
+// CrossRate returns the rate of pair from two rates sharing a currency,
+// for example EUR/JPY from EUR/USD and USD/JPY, whichever way round they
+// are quoted.
+// Math details:
+//
+// Rate_{A/C} = Rate_{A/B} * Rate_{B/C}
+func CrossRate(a, b FXRate, pair CurrencyPair) (FXRate, error) {
+	base, err := toVia(a, b, pair.Base)
+	if err != nil {
+		return FXRate{}, err
+	}
+	quote, err := toVia(a, b, pair.Quote)
+	if err != nil {
+		return FXRate{}, err
+	}
+	if base.Pair.Quote != quote.Pair.Quote {
+		return FXRate{}, errors.New("CrossRate requires rates sharing a currency")
+	}
+	return FXRate{pair, base.Rate / quote.Rate}, nil
+}
+
+// toVia returns the one of a and b that contains currency, quoted with
+// currency as Base and the shared currency as Quote.
+func toVia(a, b FXRate, currency string) (FXRate, error) {
+	shared := ""
+	for _, c := range []string{a.Pair.Base, a.Pair.Quote} {
+		if c == b.Pair.Base || c == b.Pair.Quote {
+			shared = c
+		}
+	}
+	if shared == "" || shared == currency {
+		return FXRate{}, fmt.Errorf("CrossRate requires rates sharing one currency other than %s", currency)
+	}
+	for _, r := range []FXRate{a, b} {
+		if r.Pair == (CurrencyPair{currency, shared}) {
+			return r, nil
+		}
+		if r.Pair == (CurrencyPair{shared, currency}) {
+			return r.Inverse(), nil
+		}
+	}
+	return FXRate{}, fmt.Errorf("CrossRate: no rate for %s", currency)
+}
+
+// Pair returns the currency pair of the quote.
+func (q FXQuote) Pair() CurrencyPair {
+	return CurrencyPair{q.Base, q.Quote}
+}
+
+// Mid returns the mid rate of the quote.
+func (q FXQuote) Mid() FXRate {
+	return FXRate{q.Pair(), (q.Bid + q.Ask) / 2}
+}
+
+// Inverse returns the quote the other way round, where buying the new
+// Base at Ask is selling the old one at Bid.
+func (q FXQuote) Inverse() FXQuote {
+	return FXQuote{q.Quote, q.Base, 1 / q.Ask, 1 / q.Bid}
+}
+
+// CrossQuote returns the two-way quote of pair from two quotes sharing
+// a currency, every conversion dealt on the side that costs the client,
+// so the cross spread is wider than either leg.
+// Math details:
+//
+// Bid_{A/C} = Rate_{A->B} * Rate_{B->C},   Ask_{A/C} = 1 / (Rate_{C->B} * Rate_{B->A})
+//
+// where a conversion sells a quote's Base at Bid or buys it at Ask.
+func CrossQuote(a, b FXQuote, pair CurrencyPair) (FXQuote, error) {
+	rates, err := conversionRates([]FXQuote{a, b})
+	if err != nil {
+		return FXQuote{}, err
+	}
+	for _, via := range []string{a.Base, a.Quote} {
+		if via == pair.Base || via == pair.Quote {
+			continue
+		}
+		rate := func(from, to string) float64 { return rates[[2]string{from, to}] }
+		bid := rate(pair.Base, via) * rate(via, pair.Quote)
+		ask := 1 / (rate(pair.Quote, via) * rate(via, pair.Base))
+		if bid > 0 && !math.IsInf(ask, 0) {
+			return FXQuote{pair.Base, pair.Quote, bid, ask}, nil
+		}
+	}
+	return FXQuote{}, errors.New("CrossQuote requires quotes linking the pair through a shared currency")
+}
+
+// ForwardPoints returns the forward points of a forward rate over spot,
+// in pips of the pair.
+// Math details:
+//
+// Points = (Forward - Spot) / PipSize
+func ForwardPoints(spot, forward FXRate) float64 {
+	return (forward.Rate - spot.Rate) / spot.Pair.PipSize()
+}
+
+// ForwardFromPoints returns the outright forward rate of spot plus points.
+func ForwardFromPoints(spot FXRate, points float64) FXRate {
+	return FXRate{spot.Pair, spot.Rate + points*spot.Pair.PipSize()}
+}
+
+// FXForward returns the outright forward rate by covered interest parity,
+// from the interest rates of the pair's Base and Quote currencies,
+// see [ForwardPriceFX].
+// Math details:
+//
+// Forward = Spot * DiscountFactor_base(Years) / DiscountFactor_quote(Years)
+func FXForward(spot FXRate, baseRate, quoteRate Rate, years float64) FXRate {
+	return FXRate{spot.Pair, ForwardPriceFX(spot.Rate, quoteRate, baseRate, years)}
+}
+
+// ImpliedBaseRate returns the continuously compounded interest rate of
+// the Base currency implied by spot, forward, and the Quote currency's
+// rate, as used to read deposit rates off FX swaps.
+// Math details:
+//
+// ImpliedBaseRate = QuoteRate_continuous - ln(Forward / Spot) / Years
+func ImpliedBaseRate(spot, forward FXRate, quoteRate Rate, years float64) RateAnnualContinuous {
+	return RateAnnualContinuous{quoteRate.RateAnnualContinuous() - math.Log(forward.Rate/spot.Rate)/years}
+}
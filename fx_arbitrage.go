@@ -0,0 +1,103 @@
+package gofinance
+
+import (
+	"errors"
+	"slices"
+)
+
+// FXQuote is a two-way quote of the currency pair Base/Quote, in units of
+// Quote per one unit of Base: the dealer buys Base at Bid and sells it at Ask.
+// For example EUR/USD 1.0850/1.0852.
+type FXQuote struct {
+	Base  string
+	Quote string
+	Bid   float64
+	Ask   float64
+}
+
+// FXTrade is one leg of an arbitrage cycle: convert From into To at Rate,
+// units of To received per unit of From.
+type FXTrade struct {
+	From string
+	To   string
+	Rate float64
+}
+
+// ArbitrageCycle is a sequence of three trades that starts and ends in the
+// same currency, with the Profit per unit of it after fees.
+type ArbitrageCycle struct {
+	Trades []FXTrade
+	Profit float64
+}
+
+// conversionRates returns the best rate for every direction the quotes
+// allow, selling Base at Bid and buying it at 1 / Ask.
+func conversionRates(quotes []FXQuote) (map[[2]string]float64, error) {
+	rates := make(map[[2]string]float64)
+	better := func(from, to string, rate float64) {
+		if rate > rates[[2]string{from, to}] {
+			rates[[2]string{from, to}] = rate
+		}
+	}
+	for _, q := range quotes {
+		if q.Base == q.Quote || q.Bid <= 0 || q.Ask < q.Bid {
+			return nil, errors.New("FXQuote requires two currencies and 0 < Bid <= Ask")
+		}
+		better(q.Base, q.Quote, q.Bid)
+		better(q.Quote, q.Base, 1/q.Ask)
+	}
+	return rates, nil
+}
+
+// TriangularArbitrage scans quotes for cycles of three conversions that
+// return more than they started with after paying fee, a proportional cost
+// per trade, and returns them most profitable first. Each cycle is reported
+// once, starting from its alphabetically first currency; where a pair is
+// quoted more than once the best price is used.
+// A healthy quote set has none, so any cycle found flags stale or
+// mis-keyed market data.
+// Math details:
+//
+// Profit = Rate_{A->B} * Rate_{B->C} * Rate_{C->A} * (1 - Fee)^3 - 1
+func TriangularArbitrage(quotes []FXQuote, fee float64) ([]ArbitrageCycle, error) {
+	rates, err := conversionRates(quotes)
+	if err != nil {
+		return nil, err
+	}
+	var currencies []string
+	for pair := range rates {
+		if !slices.Contains(currencies, pair[0]) {
+			currencies = append(currencies, pair[0])
+		}
+	}
+	slices.Sort(currencies)
+
+	cost := (1 - fee) * (1 - fee) * (1 - fee)
+	var cycles []ArbitrageCycle
+	for i, a := range currencies {
+		for _, b := range currencies[i+1:] {
+			for _, c := range currencies[i+1:] {
+				ab, bc, ca := rates[[2]string{a, b}], rates[[2]string{b, c}], rates[[2]string{c, a}]
+				if b == c || ab == 0 || bc == 0 || ca == 0 {
+					continue
+				}
+				if profit := ab*bc*ca*cost - 1; profit > 0 {
+					cycles = append(cycles, ArbitrageCycle{
+						Trades: []FXTrade{{a, b, ab}, {b, c, bc}, {c, a, ca}},
+						Profit: profit,
+					})
+				}
+			}
+		}
+	}
+	slices.SortStableFunc(cycles, func(x, y ArbitrageCycle) int {
+		switch {
+		case x.Profit > y.Profit:
+			return -1
+		case x.Profit < y.Profit:
+			return 1
+		}
+		return 0
+	})
+	return cycles, nil
+}
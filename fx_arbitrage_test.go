@@ -0,0 +1,63 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// Triangular arbitrage
+// -----------------------------------------------------------------------------
+func TestTriangularArbitrage(t *testing.T) {
+	quotes := []FXQuote{
+		{"EUR", "USD", 1.1000, 1.1001},
+		{"USD", "JPY", 150.00, 150.01},
+		{"EUR", "JPY", 164.99, 165.03},
+	}
+	cycles, err := TriangularArbitrage(quotes, 0)
+	if err != nil || len(cycles) != 0 {
+		t.Errorf("consistent quotes got %v, %v, want no cycles", cycles, err)
+	}
+
+	// a stale EUR/JPY bid: sell EUR for JPY, JPY for USD, USD for EUR
+	quotes[2] = FXQuote{"EUR", "JPY", 166.00, 166.05}
+	cycles, err = TriangularArbitrage(quotes, 0)
+	if err != nil || len(cycles) != 1 {
+		t.Fatalf("stale quote got %v, %v, want one cycle", cycles, err)
+	}
+	want := []FXTrade{{"EUR", "JPY", 166}, {"JPY", "USD", 1 / 150.01}, {"USD", "EUR", 1 / 1.1001}}
+	for i, w := range want {
+		got := cycles[0].Trades[i]
+		if got.From != w.From || got.To != w.To || !almostEq(got.Rate, w.Rate, epsilon) {
+			t.Errorf("trade %d got %+v, want %+v", i, got, w)
+		}
+	}
+	profit := 166/150.01/1.1001 - 1
+	if !almostEq(cycles[0].Profit, profit, epsilon) {
+		t.Errorf("Profit got %f, want %f", cycles[0].Profit, profit)
+	}
+
+	// fees above the edge remove it
+	if cycles, _ := TriangularArbitrage(quotes, 0.002); len(cycles) != 0 {
+		t.Errorf("fee of 0.2%% per trade got %v, want no cycles", cycles)
+	}
+	withFee, _ := TriangularArbitrage(quotes, 0.001)
+	if len(withFee) != 1 || !almostEq(withFee[0].Profit, (1+profit)*0.999*0.999*0.999-1, epsilon) {
+		t.Errorf("fee of 0.1%% per trade got %v", withFee)
+	}
+
+	// a better duplicate quote is used, more profitable cycles come first
+	quotes = append(quotes, FXQuote{"EUR", "JPY", 167.00, 167.05}, FXQuote{"GBP", "USD", 1.2700, 1.2701}, FXQuote{"GBP", "EUR", 1.1600, 1.1601})
+	cycles, _ = TriangularArbitrage(quotes, 0)
+	if len(cycles) < 2 || cycles[0].Trades[0] != (FXTrade{"EUR", "JPY", 167}) {
+		t.Fatalf("got %v", cycles)
+	}
+	for i := 1; i < len(cycles); i++ {
+		if cycles[i].Profit > cycles[i-1].Profit {
+			t.Errorf("cycles not sorted by profit: %v", cycles)
+		}
+	}
+
+	for _, bad := range []FXQuote{{"EUR", "EUR", 1, 1}, {"EUR", "USD", 0, 1}, {"EUR", "USD", 1.2, 1.1}} {
+		if _, err := TriangularArbitrage([]FXQuote{bad}, 0); err == nil {
+			t.Errorf("TriangularArbitrage accepted %+v", bad)
+		}
+	}
+}
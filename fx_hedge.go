@@ -0,0 +1,76 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OptimalHedgeRatio returns the fraction of a foreign asset's value to sell
+// forward in its currency that minimises the variance of its return in the
+// base currency, from the volatility of the asset's local return, that of
+// the currency, and their correlation. The product of the two returns is
+// neglected. Ratios above 1 over-hedge a currency that rises with the asset.
+// Math details:
+//
+// R_base = R_local + R_fx
+//
+// HedgeRatio = Cov(R_base, R_fx) / Var(R_fx) = 1 + Correlation * AssetVolatility / FXVolatility
+func OptimalHedgeRatio(assetVolatility, fxVolatility, correlation float64) float64 {
+	return 1 + correlation*assetVolatility/fxVolatility
+}
+
+// MinimumVarianceHedgeRatios returns, for each currency, the forward sale
+// as a fraction of the portfolio's value that minimises the variance of the
+// hedged return, from the portfolio's unhedged returns in the base currency
+// and the currencies' returns over the same periods, fx[j][t] being the
+// return of currency j in period t.
+// Math details:
+//
+// HedgeRatios = Cov(R_fx)^{-1} * Cov(R_fx, R_unhedged)
+func MinimumVarianceHedgeRatios(unhedged []float64, fx [][]float64) ([]float64, error) {
+	if len(fx) == 0 || len(unhedged) < 2 {
+		return nil, fmt.Errorf("MinimumVarianceHedgeRatios requires currencies and two or more periods: %w", ErrInsufficientData)
+	}
+	cov := make([][]float64, len(fx))
+	rhs := make([]float64, len(fx))
+	for i, xi := range fx {
+		if len(xi) != len(unhedged) {
+			return nil, errors.New("MinimumVarianceHedgeRatios requires a return per period for every currency")
+		}
+		cov[i] = make([]float64, len(fx))
+		for j, xj := range fx {
+			cov[i][j] = covariance(xi, xj)
+		}
+		rhs[i] = covariance(xi, unhedged)
+	}
+	return solveLinear(cov, rhs)
+}
+
+// HedgedReturn decomposes the base-currency return of a foreign asset over
+// one period: the Local return of the asset, the Currency return added by
+// translating it, the Hedge return of the forward sale, and the resulting
+// Unhedged and Hedged returns.
+type HedgedReturn struct {
+	Local    float64
+	Currency float64
+	Hedge    float64
+	Unhedged float64
+	Hedged   float64
+}
+
+// DecomposeHedge splits the return of a foreign asset hedged with a forward
+// sale of ratio times its value. forwardPremium is the forward over the
+// spot rate less one for the period, the interest differential the hedge
+// earns, negative when the foreign rate is the higher.
+// Math details:
+//
+// Unhedged = (1 + Local) * (1 + FX) - 1,   Currency = Unhedged - Local
+//
+// Hedge = -Ratio * (FX - ForwardPremium)
+//
+// Hedged = Unhedged + Hedge
+func DecomposeHedge(local, fx, ratio, forwardPremium float64) HedgedReturn {
+	unhedged := (1+local)*(1+fx) - 1
+	hedge := -ratio * (fx - forwardPremium)
+	return HedgedReturn{local, unhedged - local, hedge, unhedged, unhedged + hedge}
+}
@@ -0,0 +1,65 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// Hedge ratios
+// -----------------------------------------------------------------------------
+func TestOptimalHedgeRatio(t *testing.T) {
+	tests := []struct {
+		name                  string
+		assetVol, fxVol, corr float64
+		want                  float64
+	}{
+		{"uncorrelated", 0.15, 0.10, 0, 1},
+		{"currency falls as asset rises", 0.15, 0.10, -0.5, 0.25},
+		{"currency rises with asset", 0.15, 0.10, 0.4, 1.6},
+	}
+	for _, tt := range tests {
+		if got := OptimalHedgeRatio(tt.assetVol, tt.fxVol, tt.corr); !almostEq(got, tt.want, epsilon) {
+			t.Errorf("%s: got %f, want %f", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMinimumVarianceHedgeRatios(t *testing.T) {
+	eur := []float64{0.01, -0.02, 0.015, 0.005, -0.01, 0.02}
+	jpy := []float64{-0.005, 0.01, 0.02, -0.015, 0.0, 0.01}
+	unhedged := make([]float64, len(eur))
+	for i := range unhedged {
+		unhedged[i] = 0.002 + 0.6*eur[i] + 0.3*jpy[i]
+	}
+	got, err := MinimumVarianceHedgeRatios(unhedged, [][]float64{eur, jpy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !almostEq(got[0], 0.6, 1e-10) || !almostEq(got[1], 0.3, 1e-10) {
+		t.Errorf("MinimumVarianceHedgeRatios got %v, want [0.6 0.3]", got)
+	}
+
+	if _, err := MinimumVarianceHedgeRatios(unhedged, [][]float64{eur[:3]}); err == nil {
+		t.Error("accepted a short currency series")
+	}
+	if _, err := MinimumVarianceHedgeRatios(unhedged, nil); err == nil {
+		t.Error("accepted no currencies")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// DecomposeHedge
+// -----------------------------------------------------------------------------
+func TestDecomposeHedge(t *testing.T) {
+	got := DecomposeHedge(0.05, -0.02, 0.5, 0.001)
+	want := HedgedReturn{
+		Local:    0.05,
+		Currency: 1.05*0.98 - 1 - 0.05,
+		Hedge:    -0.5 * (-0.021),
+		Unhedged: 1.05*0.98 - 1,
+		Hedged:   1.05*0.98 - 1 + 0.0105,
+	}
+	if !almostEq(got.Local, want.Local, epsilon) || !almostEq(got.Currency, want.Currency, epsilon) ||
+		!almostEq(got.Hedge, want.Hedge, epsilon) || !almostEq(got.Unhedged, want.Unhedged, epsilon) ||
+		!almostEq(got.Hedged, want.Hedged, epsilon) {
+		t.Errorf("DecomposeHedge got %+v, want %+v", got, want)
+	}
+}
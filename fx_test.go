@@ -0,0 +1,131 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Currency pairs and quotes
+// -----------------------------------------------------------------------------
+func TestCurrencyPair(t *testing.T) {
+	for _, s := range []string{"EUR/USD", "eur-usd", " EURUSD "} {
+		if p, err := ParseCurrencyPair(s); err != nil || p != (CurrencyPair{"EUR", "USD"}) || p.String() != "EUR/USD" {
+			t.Errorf("ParseCurrencyPair(%q) got %v, %v", s, p, err)
+		}
+	}
+	for _, s := range []string{"EUR", "EUR/EUR", "EUR USD", "EU/RUSD"} {
+		if _, err := ParseCurrencyPair(s); err == nil {
+			t.Errorf("ParseCurrencyPair(%q) accepted", s)
+		}
+	}
+	if p := (CurrencyPair{"USD", "JPY"}); p.PipSize() != 0.01 || p.Inverse().PipSize() != 0.0001 {
+		t.Error("PipSize got the wrong size")
+	}
+}
+
+func TestFXRateQuotes(t *testing.T) {
+	eurusd := FXRate{CurrencyPair{"EUR", "USD"}, 1.25}
+	direct, err := eurusd.Direct("USD")
+	if err != nil || direct != eurusd {
+		t.Errorf("Direct for USD got %v, %v", direct, err)
+	}
+	direct, _ = eurusd.Direct("EUR")
+	if direct.Pair != (CurrencyPair{"USD", "EUR"}) || !almostEq(direct.Rate, 0.8, epsilon) {
+		t.Errorf("Direct for EUR got %v", direct)
+	}
+	indirect, _ := eurusd.Indirect("USD")
+	if indirect.Pair != (CurrencyPair{"USD", "EUR"}) || !almostEq(indirect.Rate, 0.8, epsilon) {
+		t.Errorf("Indirect for USD got %v", indirect)
+	}
+	if _, err := eurusd.Direct("GBP"); err == nil {
+		t.Error("Direct accepted a currency outside the pair")
+	}
+	if v, _ := eurusd.Convert(100, "EUR"); !almostEq(v, 125, epsilon) {
+		t.Errorf("Convert EUR got %f, want 125", v)
+	}
+	if v, _ := eurusd.Convert(100, "USD"); !almostEq(v, 80, epsilon) {
+		t.Errorf("Convert USD got %f, want 80", v)
+	}
+	if _, err := eurusd.Convert(100, "JPY"); err == nil {
+		t.Error("Convert accepted a currency outside the pair")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Cross rates
+// -----------------------------------------------------------------------------
+func TestCrossRate(t *testing.T) {
+	eurusd := FXRate{CurrencyPair{"EUR", "USD"}, 1.10}
+	usdjpy := FXRate{CurrencyPair{"USD", "JPY"}, 150}
+	gbpusd := FXRate{CurrencyPair{"GBP", "USD"}, 1.27}
+	tests := []struct {
+		a, b FXRate
+		pair CurrencyPair
+		want float64
+	}{
+		{eurusd, usdjpy, CurrencyPair{"EUR", "JPY"}, 165},
+		{usdjpy, eurusd, CurrencyPair{"JPY", "EUR"}, 1.0 / 165},
+		{eurusd, gbpusd, CurrencyPair{"EUR", "GBP"}, 1.10 / 1.27},
+		{eurusd.Inverse(), gbpusd.Inverse(), CurrencyPair{"GBP", "EUR"}, 1.27 / 1.10},
+	}
+	for _, tt := range tests {
+		got, err := CrossRate(tt.a, tt.b, tt.pair)
+		if err != nil || got.Pair != tt.pair || !almostEq(got.Rate, tt.want, epsilon) {
+			t.Errorf("CrossRate %s got %v, %v, want %f", tt.pair, got, err, tt.want)
+		}
+	}
+	if _, err := CrossRate(eurusd, usdjpy, CurrencyPair{"EUR", "USD"}); err == nil {
+		t.Error("CrossRate accepted a pair through its own currency")
+	}
+	if _, err := CrossRate(eurusd, FXRate{CurrencyPair{"GBP", "JPY"}, 190}, CurrencyPair{"EUR", "JPY"}); err == nil {
+		t.Error("CrossRate accepted rates without a shared currency")
+	}
+}
+
+func TestCrossQuote(t *testing.T) {
+	eurusd := FXQuote{"EUR", "USD", 1.0998, 1.1002}
+	usdjpy := FXQuote{"USD", "JPY", 149.98, 150.02}
+	got, err := CrossQuote(eurusd, usdjpy, CurrencyPair{"EUR", "JPY"})
+	if err != nil || !almostEq(got.Bid, 1.0998*149.98, epsilon) || !almostEq(got.Ask, 1.1002*150.02, epsilon) {
+		t.Errorf("EUR/JPY got %+v, %v", got, err)
+	}
+	// both legs quoted against USD: EUR/GBP sells EUR for USD and buys GBP with it
+	gbpusd := FXQuote{"GBP", "USD", 1.2698, 1.2702}
+	got, _ = CrossQuote(eurusd, gbpusd, CurrencyPair{"EUR", "GBP"})
+	if !almostEq(got.Bid, 1.0998/1.2702, epsilon) || !almostEq(got.Ask, 1.1002/1.2698, epsilon) {
+		t.Errorf("EUR/GBP got %+v", got)
+	}
+	if inv := got.Inverse(); !almostEq(inv.Bid, 1/got.Ask, epsilon) || inv.Base != "GBP" {
+		t.Errorf("Inverse got %+v", inv)
+	}
+	if mid := got.Mid(); !almostEq(mid.Rate, (got.Bid+got.Ask)/2, epsilon) || mid.Pair != got.Pair() {
+		t.Errorf("Mid got %+v", mid)
+	}
+	if _, err := CrossQuote(eurusd, FXQuote{"GBP", "JPY", 190, 190.1}, CurrencyPair{"EUR", "JPY"}); err == nil {
+		t.Error("CrossQuote accepted quotes without a shared currency")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Forwards
+// -----------------------------------------------------------------------------
+func TestFXForward(t *testing.T) {
+	spot := FXRate{CurrencyPair{"USD", "JPY"}, 150}
+	usd, jpy := RateAnnualContinuous{0.05}, RateAnnualContinuous{0.001}
+	fwd := FXForward(spot, usd, jpy, 1)
+	want := 150 * math.Exp(0.001-0.05)
+	if !almostEq(fwd.Rate, want, epsilon) || fwd.Pair != spot.Pair {
+		t.Errorf("FXForward got %v, want %f", fwd, want)
+	}
+	points := ForwardPoints(spot, fwd)
+	if !almostEq(points, (want-150)*100, 1e-9) {
+		t.Errorf("ForwardPoints got %f, want %f", points, (want-150)*100)
+	}
+	if back := ForwardFromPoints(spot, points); !almostEq(back.Rate, fwd.Rate, epsilon) {
+		t.Errorf("ForwardFromPoints got %f, want %f", back.Rate, fwd.Rate)
+	}
+	if implied := ImpliedBaseRate(spot, fwd, jpy, 1); !almostEq(implied.Value, 0.05, epsilon) {
+		t.Errorf("ImpliedBaseRate got %f, want 0.05", implied.Value)
+	}
+}
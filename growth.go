@@ -0,0 +1,116 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// CAGR returns the compound annual growth rate that takes begin to end
+// over years, as an effective annual rate.
+// Math details:
+//
+// CAGR = (End / Begin)^{1 / Years} - 1
+func CAGR(begin, end, years float64) (RateEffective, error) {
+	if begin <= 0 || end <= 0 {
+		return RateEffective{}, errors.New("CAGR requires positive begin and end values")
+	}
+	if years <= 0 {
+		return RateEffective{}, errors.New("CAGR requires positive years")
+	}
+	return NewRateEffective(math.Expm1(math.Log(end/begin)/years), 1)
+}
+
+// GrowthRates returns the growth rates between consecutive levels,
+// such as yearly revenues, like [TimeSeries.SimpleReturns] for plain values.
+// Math details:
+//
+// g_t = L_t / L_{t-1} - 1
+func GrowthRates(levels []float64) []float64 {
+	if len(levels) < 2 {
+		return nil
+	}
+	rates := make([]float64, len(levels)-1)
+	for i := 1; i < len(levels); i++ {
+		rates[i-1] = levels[i]/levels[i-1] - 1
+	}
+	return rates
+}
+
+// LogGrowthRates returns the log growth rates between consecutive levels,
+// like [TimeSeries.LogReturns] for plain values.
+// Math details:
+//
+// r_t = ln(L_t / L_{t-1})
+func LogGrowthRates(levels []float64) []float64 {
+	rates := GrowthRates(levels)
+	for i := range rates {
+		rates[i] = math.Log1p(rates[i])
+	}
+	return rates
+}
+
+// ArithmeticMeanGrowth returns the average of periodic growth rates,
+// the expected growth of a single period.
+// It overstates compound growth whenever the rates vary, see
+// [GeometricMeanGrowth].
+// Math details:
+//
+// Arithmetic = \sum g_t / n
+func ArithmeticMeanGrowth(rates []float64) (float64, error) {
+	if len(rates) == 0 {
+		return math.NaN(), fmt.Errorf("ArithmeticMeanGrowth requires at least one rate: %w", ErrInsufficientData)
+	}
+	return mean(rates), nil
+}
+
+// GeometricMeanGrowth returns the constant periodic growth rate
+// compounding to the same total growth as rates; over whole years
+// it equals the [CAGR] of the underlying levels.
+// Math details:
+//
+// Geometric = (\prod (1 + g_t))^{1 / n} - 1 = e^{\sum ln(1 + g_t) / n} - 1
+func GeometricMeanGrowth(rates []float64) (float64, error) {
+	if len(rates) == 0 {
+		return math.NaN(), fmt.Errorf("GeometricMeanGrowth requires at least one rate: %w", ErrInsufficientData)
+	}
+	sum := 0.0
+	for _, g := range rates {
+		if g <= -1 {
+			return math.NaN(), errors.New("GeometricMeanGrowth requires rates above -100%")
+		}
+		sum += math.Log1p(g)
+	}
+	return math.Expm1(sum / float64(len(rates))), nil
+}
+
+// AnnualizeGrowth converts the growth rate of one period, of which there are
+// periodsPerYear in a year, into an annual rate quoted in convention:
+// simple annualization multiplies by periodsPerYear, continuous annualizes
+// the log growth, and the periodic conventions compound, so that
+// ConventionAnnual gives the effective annual rate.
+// The result agrees with [ForwardRate] on RateEffective{periodic, periodsPerYear}.
+// Math details:
+//
+// Simple = PeriodsPerYear * g
+//
+// Continuous = PeriodsPerYear * ln(1 + g)
+//
+// Periodic = m * ((1 + g)^{PeriodsPerYear / m} - 1)
+func AnnualizeGrowth(periodic, periodsPerYear float64, convention RateConvention) float64 {
+	return convention.rate(1+periodic, 1/periodsPerYear)
+}
+
+// PeriodicGrowth is the inverse of [AnnualizeGrowth]: it converts an annual
+// rate quoted in convention into the growth rate of one of periodsPerYear
+// periods in a year.
+// Math details:
+//
+// Simple: g = Annual / PeriodsPerYear
+//
+// Continuous: g = e^{Annual / PeriodsPerYear} - 1
+//
+// Periodic: g = (1 + Annual / m)^{m / PeriodsPerYear} - 1
+func PeriodicGrowth(annual, periodsPerYear float64, convention RateConvention) float64 {
+	return convention.growth(annual, 1/periodsPerYear) - 1
+}
@@ -0,0 +1,114 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// CAGR
+// -----------------------------------------------------------------------------
+func TestCAGR(t *testing.T) {
+	r, err := CAGR(100, 121, 2)
+	if err != nil || !almostEq(r.Value, 0.10, epsilon) || r.PeriodsPerYear != 1 {
+		t.Errorf("CAGR got %+v, %v, want 10%% effective annual", r, err)
+	}
+	// the rate discounts end back to begin
+	r, _ = CAGR(80, 150, 3.5)
+	if got := 150 * r.DiscountFactor(3.5); !almostEq(got, 80, 1e-10) {
+		t.Errorf("CAGR discounted end got %v, want 80", got)
+	}
+	// a fall in value is negative growth
+	if r, _ := CAGR(100, 81, 2); !almostEq(r.Value, -0.10, epsilon) {
+		t.Errorf("CAGR decline got %v, want -0.10", r.Value)
+	}
+	for _, tc := range [][3]float64{{0, 100, 1}, {100, 0, 1}, {100, 110, 0}} {
+		if _, err := CAGR(tc[0], tc[1], tc[2]); err == nil {
+			t.Errorf("CAGR%v: expected error", tc)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Growth rates and means
+// -----------------------------------------------------------------------------
+func TestGrowthRates(t *testing.T) {
+	levels := []float64{100, 150, 75, 100}
+	simple := GrowthRates(levels)
+	logs := LogGrowthRates(levels)
+	want := []float64{0.5, -0.5, 1.0 / 3}
+	for i := range want {
+		if !almostEq(simple[i], want[i], epsilon) || !almostEq(logs[i], math.Log1p(want[i]), epsilon) {
+			t.Errorf("rate %d: got %v, %v, want %v", i, simple[i], logs[i], want[i])
+		}
+	}
+	if GrowthRates([]float64{100}) != nil {
+		t.Error("GrowthRates of one level should be nil")
+	}
+
+	// +50%, -50% averages zero arithmetically but loses money
+	arith, _ := ArithmeticMeanGrowth([]float64{0.5, -0.5})
+	geo, _ := GeometricMeanGrowth([]float64{0.5, -0.5})
+	if !almostEq(arith, 0, epsilon) || !almostEq(geo, math.Sqrt(0.75)-1, epsilon) {
+		t.Errorf("means got %v, %v", arith, geo)
+	}
+
+	// the geometric mean of yearly growth equals the CAGR of the levels
+	geo, _ = GeometricMeanGrowth(simple)
+	cagr, _ := CAGR(levels[0], levels[len(levels)-1], float64(len(simple)))
+	if !almostEq(geo, cagr.Value, epsilon) {
+		t.Errorf("GeometricMeanGrowth %v != CAGR %v", geo, cagr.Value)
+	}
+
+	if _, err := ArithmeticMeanGrowth(nil); err == nil {
+		t.Error("ArithmeticMeanGrowth(nil): expected error")
+	}
+	if _, err := GeometricMeanGrowth(nil); err == nil {
+		t.Error("GeometricMeanGrowth(nil): expected error")
+	}
+	if _, err := GeometricMeanGrowth([]float64{0.1, -1}); err == nil {
+		t.Error("GeometricMeanGrowth with -100%: expected error")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// AnnualizeGrowth, PeriodicGrowth
+// -----------------------------------------------------------------------------
+func TestAnnualizeGrowth(t *testing.T) {
+	const g = 0.01
+	tests := []struct {
+		name       string
+		convention RateConvention
+		want       float64
+	}{
+		{"simple", ConventionSimple, 0.12},
+		{"continuous", ConventionContinuous, 12 * math.Log1p(g)},
+		{"annual", ConventionAnnual, math.Pow(1+g, 12) - 1},
+		{"quarterly", ConventionQuarterly, 4 * (math.Pow(1+g, 3) - 1)},
+		{"monthly", ConventionMonthly, 0.12},
+	}
+	monthly := RateEffective{Value: g, PeriodsPerYear: 12}
+	for _, tc := range tests {
+		got := AnnualizeGrowth(g, 12, tc.convention)
+		if !almostEq(got, tc.want, epsilon) {
+			t.Errorf("%s: AnnualizeGrowth got %.12f, want %.12f", tc.name, got, tc.want)
+		}
+		if fwd := ForwardRate(monthly, 0, 1.0/12, tc.convention); !almostEq(got, fwd, epsilon) {
+			t.Errorf("%s: AnnualizeGrowth %v disagrees with ForwardRate %v", tc.name, got, fwd)
+		}
+		if back := PeriodicGrowth(got, 12, tc.convention); !almostEq(back, g, epsilon) {
+			t.Errorf("%s: PeriodicGrowth round trip got %v, want %v", tc.name, back, g)
+		}
+	}
+
+	// consistency with the Rate types
+	if got := AnnualizeGrowth(g, 12, ConventionAnnual); !almostEq(got, monthly.RateAnnualEffective(), epsilon) {
+		t.Errorf("annual got %v, want RateAnnualEffective %v", got, monthly.RateAnnualEffective())
+	}
+	if got := AnnualizeGrowth(g, 12, ConventionContinuous); !almostEq(got, monthly.RateAnnualContinuous(), epsilon) {
+		t.Errorf("continuous got %v, want RateAnnualContinuous %v", got, monthly.RateAnnualContinuous())
+	}
+	if got := PeriodicGrowth(0.05, 2, ConventionSemiAnnual); !almostEq(got, 0.025, epsilon) {
+		t.Errorf("PeriodicGrowth semi-annual got %v, want 0.025", got)
+	}
+}
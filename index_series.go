@@ -0,0 +1,113 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// IndexInterpolation selects how an [IndexSeries] values dates between
+// observations.
+type IndexInterpolation int
+
+const (
+	// IndexStep holds each level until the next observation.
+	IndexStep IndexInterpolation = iota
+	// IndexLinear interpolates linearly in time between observations,
+	// as for the daily reference CPI of inflation-linked bonds.
+	IndexLinear
+)
+
+// IndexSeries is a price index, such as the CPI, observed at dates.
+// It implements [PriceIndex]; dates after the last observation take the
+// last level, dates before the first are an error.
+// Use [NewIndexSeries] to get the observations validated.
+type IndexSeries struct {
+	Observations  TimeSeries
+	Interpolation IndexInterpolation
+}
+
+// NewIndexSeries builds an [IndexSeries] from positive index levels at
+// distinct dates, in any order. The observations are copied and sorted.
+func NewIndexSeries(observations TimeSeries, interpolation IndexInterpolation) (IndexSeries, error) {
+	if len(observations) == 0 {
+		return IndexSeries{}, fmt.Errorf("NewIndexSeries requires observations: %w", ErrInsufficientData)
+	}
+	obs := slices.Clone(observations)
+	obs.Sort()
+	for i, o := range obs {
+		if o.Value <= 0 {
+			return IndexSeries{}, errors.New("NewIndexSeries requires positive levels")
+		}
+		if i > 0 && o.Date.Equal(obs[i-1].Date) {
+			return IndexSeries{}, errors.New("NewIndexSeries requires distinct dates")
+		}
+	}
+	return IndexSeries{obs, interpolation}, nil
+}
+
+// Level implements [PriceIndex].
+// Math details:
+//
+// Level(t) = Level_i + (Level_{i+1} - Level_i) * (t - t_i) / (t_{i+1} - t_i)   (IndexLinear)
+func (s IndexSeries) Level(date time.Time) (float64, error) {
+	obs := s.Observations
+	i, found := slices.BinarySearchFunc(obs, date, func(o Observation, d time.Time) int { return o.Date.Compare(d) })
+	switch {
+	case found:
+		return obs[i].Value, nil
+	case i == 0:
+		return 0, errors.New("IndexSeries.Level: date before the first observation")
+	case i == len(obs) || s.Interpolation == IndexStep:
+		return obs[i-1].Value, nil
+	}
+	prev, next := obs[i-1], obs[i]
+	w := float64(date.Sub(prev.Date)) / float64(next.Date.Sub(prev.Date))
+	return prev.Value + w*(next.Value-prev.Value), nil
+}
+
+// Rebase rescales the series so that its level at baseDate is baseLevel,
+// for example 100.
+func (s IndexSeries) Rebase(baseDate time.Time, baseLevel float64) (IndexSeries, error) {
+	base, err := s.Level(baseDate)
+	if err != nil {
+		return IndexSeries{}, err
+	}
+	obs := slices.Clone(s.Observations)
+	for i := range obs {
+		obs[i].Value *= baseLevel / base
+	}
+	return IndexSeries{obs, s.Interpolation}, nil
+}
+
+// Change returns the growth of the index from one date to another,
+// for example the inflation over the period.
+// Math details:
+//
+// Change = Level(To) / Level(From) - 1
+func (s IndexSeries) Change(from, to time.Time) (float64, error) {
+	a, err := s.Level(from)
+	if err != nil {
+		return 0, err
+	}
+	b, err := s.Level(to)
+	if err != nil {
+		return 0, err
+	}
+	return b/a - 1, nil
+}
+
+// YearOnYear returns the [IndexSeries.Change] over the year before each
+// observation, from the first observation at least a year after the start.
+func (s IndexSeries) YearOnYear() TimeSeries {
+	var yoy TimeSeries
+	for _, o := range s.Observations {
+		c, err := s.Change(o.Date.AddDate(-1, 0, 0), o.Date)
+		if err != nil {
+			continue
+		}
+		yoy = append(yoy, Observation{o.Date, c})
+	}
+	return yoy
+}
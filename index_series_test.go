@@ -0,0 +1,65 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// IndexSeries
+// -----------------------------------------------------------------------------
+func TestIndexSeries(t *testing.T) {
+	var obs TimeSeries
+	for m := 24; m >= 0; m-- {
+		obs = append(obs, Observation{anchor.AddDate(0, m, 0), 100 + float64(m)})
+	}
+	step, err := NewIndexSeries(obs, IndexStep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	linear, _ := NewIndexSeries(obs, IndexLinear)
+
+	mid := anchor.AddDate(0, 0, 15) // January has 31 days
+	if got, _ := step.Level(mid); got != 100 {
+		t.Errorf("step Level got %f, want 100", got)
+	}
+	if got, _ := linear.Level(mid); !almostEq(got, 100+15.0/31, 1e-12) {
+		t.Errorf("linear Level got %f, want %f", got, 100+15.0/31)
+	}
+	if got, _ := linear.Level(anchor.AddDate(5, 0, 0)); got != 124 {
+		t.Errorf("Level after the last observation got %f, want 124", got)
+	}
+	if _, err := linear.Level(anchor.AddDate(0, 0, -1)); err == nil {
+		t.Error("Level accepted a date before the first observation")
+	}
+
+	rebased, _ := step.Rebase(anchor.AddDate(1, 0, 0), 100)
+	if got, _ := rebased.Level(anchor); !almostEq(got, 100*100.0/112, 1e-12) {
+		t.Errorf("Rebase got %f at the start", got)
+	}
+
+	yoy := step.YearOnYear()
+	if len(yoy) != 13 || !yoy[0].Date.Equal(anchor.AddDate(1, 0, 0)) || !almostEq(yoy[0].Value, 0.12, 1e-12) {
+		t.Errorf("YearOnYear got %d observations, first %+v", len(yoy), yoy[0])
+	}
+
+	// an IndexSeries deflates cash-flows as a PriceIndex
+	cfs := CashFlows{{112, anchor.AddDate(1, 0, 0)}}
+	deflated, err := cfs.DeflateTo(anchor, step)
+	if err != nil || !almostEq(deflated[0].Value, 100, 1e-12) {
+		t.Errorf("DeflateTo with IndexSeries got %+v, %v", deflated, err)
+	}
+}
+
+func TestNewIndexSeriesErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		obs  TimeSeries
+	}{
+		{"empty", nil},
+		{"non-positive", TimeSeries{{anchor, 0}}},
+		{"duplicate date", TimeSeries{{anchor, 1}, {anchor, 2}}},
+	}
+	for _, tt := range tests {
+		if _, err := NewIndexSeries(tt.obs, IndexStep); err == nil {
+			t.Errorf("%s: NewIndexSeries accepted invalid observations", tt.name)
+		}
+	}
+}
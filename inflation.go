@@ -0,0 +1,99 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"time"
+)
+
+// RateReal implements [Rate] for a real, inflation-adjusted, effective annual
+// rate, discounting cash-flows stated in constant currency.
+// Use [RealFromNominal] and [NominalFromReal] to convert with the Fisher equation.
+type RateReal struct {
+	Value float64
+}
+
+// DiscountFactor implements [Rate].
+// Math details:
+//
+// DiscountFactor = (1 + RealRate)^{-Years}
+func (r RateReal) DiscountFactor(years float64) float64 {
+	return math.Pow(1+r.Value, -years)
+}
+
+// RateAnnualEffective implements [Rate].
+// The real rate is already an effective annual rate.
+func (r RateReal) RateAnnualEffective() float64 {
+	return r.Value
+}
+
+// RateAnnualContinuous implements [Rate].
+// Math details:
+//
+// ContinuousRate = ln(1 + RealRate)
+func (r RateReal) RateAnnualContinuous() float64 {
+	return math.Log1p(r.Value)
+}
+
+// RealFromNominal returns the real rate earned at the nominal rate
+// when prices grow at the inflation rate.
+// Math details:
+//
+// 1 + Nominal = (1 + Real) * (1 + Inflation)   (Fisher equation)
+//
+// Real = (1 + Nominal) / (1 + Inflation) - 1
+func RealFromNominal(nominal, inflation Rate) RateReal {
+	return RateReal{(1+nominal.RateAnnualEffective())/(1+inflation.RateAnnualEffective()) - 1}
+}
+
+// NominalFromReal returns the effective annual nominal rate matching the real
+// rate at the inflation rate, the inverse of [RealFromNominal].
+// Math details:
+//
+// Nominal = (1 + Real) * (1 + Inflation) - 1
+func NominalFromReal(realRate, inflation Rate) RateEffective {
+	return RateEffective{(1+realRate.RateAnnualEffective())*(1+inflation.RateAnnualEffective()) - 1, 1}
+}
+
+// PriceIndex gives the level of a price index, such as the CPI, at a date.
+type PriceIndex interface {
+	Level(date time.Time) (float64, error)
+}
+
+// Level implements [PriceIndex] for a series of index levels in date order:
+// the level of the latest observation on or before date.
+func (s TimeSeries) Level(date time.Time) (float64, error) {
+	i, found := slices.BinarySearchFunc(s, date, func(o Observation, d time.Time) int { return o.Date.Compare(d) })
+	if found {
+		for i+1 < len(s) && s[i+1].Date.Equal(date) {
+			i++
+		}
+		return s[i].Value, nil
+	}
+	if i == 0 {
+		return 0, errors.New("TimeSeries.Level: date before the first observation")
+	}
+	return s[i-1].Value, nil
+}
+
+// DeflateTo restates nominal cash-flows in the constant currency of baseDate,
+// scaling each flow by the change of the price index since its date.
+// Math details:
+//
+// Real_i = Nominal_i * Index(BaseDate) / Index(Date_i)
+func (cfs CashFlows) DeflateTo(baseDate time.Time, index PriceIndex) (CashFlows, error) {
+	base, err := index.Level(baseDate)
+	if err != nil {
+		return nil, err
+	}
+	out := make(CashFlows, len(cfs))
+	for i, cf := range cfs {
+		level, err := index.Level(cf.Date)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = CashFlow{cf.Value * base / level, cf.Date}
+	}
+	return out, nil
+}
@@ -0,0 +1,121 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// InflationLinkedBond is an index-linked bond such as a TIPS: Real holds
+// its terms in constant currency, Face and CouponRate, and every payment
+// is the real one scaled by the [InflationLinkedBond.IndexRatio] on its date.
+//
+// The reference index of a date is Index read Lag months earlier, 3 for
+// TIPS; an [IndexSeries] of monthly CPI with IndexLinear interpolates a
+// daily reference. BaseIndex is the reference index at Issue, read from
+// Index when zero. With Floor the principal repaid at maturity is at
+// least Face, the deflation floor of TIPS.
+type InflationLinkedBond struct {
+	Real      Bond
+	Index     PriceIndex
+	Lag       int
+	BaseIndex float64
+	Floor     bool
+}
+
+// ReferenceIndex returns the reference index level at date.
+func (b InflationLinkedBond) ReferenceIndex(date time.Time) (float64, error) {
+	if b.Index == nil || b.Lag < 0 {
+		return 0, errors.New("InflationLinkedBond requires an Index and a non-negative Lag")
+	}
+	return b.Index.Level(addMonths(date, -b.Lag))
+}
+
+// IndexRatio returns the inflation accrued on the principal from Issue
+// to date.
+// Math details:
+//
+// IndexRatio = ReferenceIndex(Date) / ReferenceIndex(Issue)
+func (b InflationLinkedBond) IndexRatio(date time.Time) (float64, error) {
+	base := b.BaseIndex
+	if base == 0 {
+		var err error
+		if base, err = b.ReferenceIndex(b.Real.Issue); err != nil {
+			return 0, err
+		}
+	}
+	level, err := b.ReferenceIndex(date)
+	if err != nil {
+		return 0, err
+	}
+	if base <= 0 {
+		return 0, errors.New("InflationLinkedBond requires a positive BaseIndex")
+	}
+	return level / base, nil
+}
+
+// InvoicePrice returns the nominal amount paid at settlement for the bond
+// quoted at a real clean price, in the currency of Face: the real price
+// and accrued interest scaled by the index ratio.
+// Math details:
+//
+// InvoicePrice = (RealCleanPrice + RealAccruedInterest) * IndexRatio(Settlement)
+func (b InflationLinkedBond) InvoicePrice(realCleanPrice float64, settlement time.Time) (float64, error) {
+	accrued, err := b.Real.AccruedInterest(settlement)
+	if err != nil {
+		return 0, err
+	}
+	ratio, err := b.IndexRatio(settlement)
+	if err != nil {
+		return 0, err
+	}
+	return (realCleanPrice + accrued) * ratio, nil
+}
+
+// RealYield returns the street-convention real yield at the real clean
+// price, see [Bond.YieldToMaturity], compounded PeriodsPerYear times a year.
+func (b InflationLinkedBond) RealYield(realCleanPrice float64, settlement time.Time, opts ...SolverOptions) (float64, error) {
+	return b.Real.YieldToMaturity(realCleanPrice, settlement, YieldQuote{Clean: true}, opts...)
+}
+
+// BreakevenInflation returns the inflation rate at which the bond at the
+// real clean price earns the nominal yield of a conventional bond of the
+// same maturity, by the Fisher equation on effective annual rates.
+// Math details:
+//
+// 1 + Breakeven = (1 + NominalYield) / (1 + RealYield)
+func (b InflationLinkedBond) BreakevenInflation(realCleanPrice float64, nominal Rate, settlement time.Time, opts ...SolverOptions) (RateEffective, error) {
+	y, err := b.RealYield(realCleanPrice, settlement, opts...)
+	if err != nil {
+		return RateEffective{math.NaN(), 1}, err
+	}
+	realRate := RateAnnualPercentage{Value: y, PeriodsPerYear: float64(b.Real.PeriodsPerYear)}
+	return RateEffective{(1+nominal.RateAnnualEffective())/(1+realRate.RateAnnualEffective()) - 1, 1}, nil
+}
+
+// ProjectedCashFlows returns the nominal payments after settlement, the
+// index ratio at settlement projected forward at the inflation rate,
+// with a final top-up of the principal to Face when Floor is set and
+// the projected index ratio at maturity is below one.
+// Math details:
+//
+// Nominal_i = Real_i * IndexRatio(Settlement) * (1 + Inflation)^{t_i}
+func (b InflationLinkedBond) ProjectedCashFlows(settlement time.Time, inflation Rate) (CashFlows, error) {
+	flows, err := b.Real.CashFlows(settlement)
+	if err != nil {
+		return nil, err
+	}
+	ratio, err := b.IndexRatio(settlement)
+	if err != nil {
+		return nil, err
+	}
+	for i, cf := range flows {
+		flows[i].Value *= ratio / inflation.DiscountFactor(cf.YearsFrom(settlement))
+	}
+	final := ratio / inflation.DiscountFactor(yearsBetween(settlement, b.Real.Maturity))
+	if b.Floor && final < 1 && b.Real.Maturity.After(settlement) {
+		// the floor tops up the principal, not the final coupon
+		flows = append(flows, CashFlow{b.Real.outstanding(b.Real.Maturity) * (1 - final), b.Real.Maturity})
+	}
+	return flows, nil
+}
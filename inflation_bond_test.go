@@ -0,0 +1,122 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// testTIPS is a five-year 1.25 % TIPS, on annual coupons to keep the real
+// yield at par round, on a CPI rising 0.2 % a month read with the
+// three-month lag from October 2019.
+func testTIPS(t *testing.T) InflationLinkedBond {
+	var cpi TimeSeries
+	for k := -3; k <= 60; k++ {
+		cpi = append(cpi, Observation{addMonths(anchor, k), 100 * math.Pow(1.002, float64(k+3))})
+	}
+	index, err := NewIndexSeries(cpi, IndexLinear)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return InflationLinkedBond{
+		Real:  Bond{Face: 100, CouponRate: 0.0125, PeriodsPerYear: 1, Issue: anchor, Maturity: anchor.AddDate(5, 0, 0)},
+		Index: index,
+		Lag:   3,
+		Floor: true,
+	}
+}
+
+// -----------------------------------------------------------------------------
+// InflationLinkedBond: IndexRatio, InvoicePrice
+// -----------------------------------------------------------------------------
+func TestInflationLinkedBondIndexRatio(t *testing.T) {
+	b := testTIPS(t)
+	year := anchor.AddDate(1, 0, 0)
+	if got, err := b.IndexRatio(year); err != nil || !almostEq(got, math.Pow(1.002, 12), epsilon) {
+		t.Errorf("IndexRatio got %v, %v, want %v", got, err, math.Pow(1.002, 12))
+	}
+	// an explicit base index overrides the level at issue
+	b.BaseIndex = 50
+	if got, _ := b.IndexRatio(anchor); !almostEq(got, 2, epsilon) {
+		t.Errorf("IndexRatio on BaseIndex 50 got %v, want 2", got)
+	}
+
+	b = testTIPS(t)
+	settlement := anchor.AddDate(0, 8, 0)
+	accrued, _ := b.Real.AccruedInterest(settlement)
+	ratio, _ := b.IndexRatio(settlement)
+	if got, err := b.InvoicePrice(99, settlement); err != nil || !almostEq(got, (99+accrued)*ratio, epsilon) || accrued == 0 {
+		t.Errorf("InvoicePrice got %v, %v, want %v", got, err, (99+accrued)*ratio)
+	}
+
+	if _, err := b.IndexRatio(anchor.AddDate(-1, 0, 0)); err == nil {
+		t.Error("reference before the first CPI: expected error")
+	}
+	if _, err := (InflationLinkedBond{Real: b.Real}).IndexRatio(anchor); err == nil {
+		t.Error("no index: expected error")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// InflationLinkedBond: RealYield, BreakevenInflation
+// -----------------------------------------------------------------------------
+func TestInflationLinkedBondYields(t *testing.T) {
+	b := testTIPS(t)
+	if y, err := b.RealYield(100, anchor); err != nil || !almostEq(y, 0.0125, 1e-10) {
+		t.Errorf("RealYield at par got %v, %v, want 0.0125", y, err)
+	}
+	below, _ := b.RealYield(97, anchor)
+	if below <= 0.0125 {
+		t.Errorf("RealYield below par got %v, want above the coupon", below)
+	}
+
+	nominal := RateAnnualPercentage{Value: 0.035, PeriodsPerYear: 2}
+	breakeven, err := b.BreakevenInflation(100, nominal, anchor)
+	want := math.Pow(1.0175, 2)/1.0125 - 1
+	if err != nil || !almostEq(breakeven.Value, want, 1e-10) {
+		t.Errorf("BreakevenInflation got %v, %v, want %v", breakeven, err, want)
+	}
+	// the Fisher equation recovers the nominal yield
+	if got := NominalFromReal(RateEffective{0.0125, 1}, breakeven); !almostEq(got.Value, nominal.RateAnnualEffective(), 1e-12) {
+		t.Errorf("NominalFromReal got %v, want %v", got.Value, nominal.RateAnnualEffective())
+	}
+}
+
+// -----------------------------------------------------------------------------
+// InflationLinkedBond.ProjectedCashFlows
+// -----------------------------------------------------------------------------
+func TestInflationLinkedBondProjection(t *testing.T) {
+	b := testTIPS(t)
+	settlement := anchor.AddDate(1, 0, 0)
+	ratio, _ := b.IndexRatio(settlement)
+	realFlows, _ := b.Real.CashFlows(settlement)
+
+	inflation := RateEffective{0.02, 1}
+	flows, err := b.ProjectedCashFlows(settlement, inflation)
+	if err != nil || len(flows) != len(realFlows) {
+		t.Fatalf("ProjectedCashFlows got %v, %v", flows, err)
+	}
+	for i, cf := range flows {
+		want := realFlows[i].Value * ratio * math.Pow(1.02, realFlows[i].YearsFrom(settlement))
+		if !almostEq(cf.Value, want, 1e-9) || !cf.Date.Equal(realFlows[i].Date) {
+			t.Errorf("flow %d got %v, want %v", i, cf, want)
+		}
+	}
+
+	// deep deflation leaves the principal at Face and shrinks the coupons
+	deflated, _ := b.ProjectedCashFlows(settlement, RateEffective{-0.1, 1})
+	final := ratio * math.Pow(0.9, yearsBetween(settlement, b.Real.Maturity))
+	atMaturity, wantAtMaturity := 0.0, 100.0
+	for _, cf := range realFlows {
+		if cf.Date.Equal(b.Real.Maturity) && cf.Value != 100 {
+			wantAtMaturity += cf.Value * ratio * math.Pow(0.9, cf.YearsFrom(settlement))
+		}
+	}
+	for _, cf := range deflated {
+		if cf.Date.Equal(b.Real.Maturity) {
+			atMaturity += cf.Value
+		}
+	}
+	if len(deflated) != len(realFlows)+1 || final >= 1 || !almostEq(atMaturity, wantAtMaturity, 1e-9) || deflated[0].Value >= realFlows[0].Value {
+		t.Errorf("floored flows got %v, %v at maturity, want %v", deflated, atMaturity, wantAtMaturity)
+	}
+}
@@ -0,0 +1,50 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// Real rates
+// -----------------------------------------------------------------------------
+func TestRateReal(t *testing.T) {
+	nominal, inflation := RateEffective{0.05, 1}, RateEffective{0.02, 1}
+	realRate := RealFromNominal(nominal, inflation)
+	if !almostEq(realRate.Value, 1.05/1.02-1, epsilon) {
+		t.Errorf("RealFromNominal got %f, want %f", realRate.Value, 1.05/1.02-1)
+	}
+	if back := NominalFromReal(realRate, inflation); !almostEq(back.RateAnnualEffective(), 0.05, epsilon) {
+		t.Errorf("NominalFromReal got %f, want 0.05", back.RateAnnualEffective())
+	}
+	// discounting real flows at the real rate equals discounting the inflated
+	// nominal flows at the nominal rate
+	if got, want := realRate.DiscountFactor(10), nominal.DiscountFactor(10)*inflation.DiscountFactor(-10); !almostEq(got, want, epsilon) {
+		t.Errorf("real discount factor got %f, want %f", got, want)
+	}
+	if !almostEq(RateAnnualContinuous{realRate.RateAnnualContinuous()}.RateAnnualEffective(), realRate.Value, epsilon) {
+		t.Error("RateReal continuous conversion does not round-trip")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Deflating cash-flows
+// -----------------------------------------------------------------------------
+func TestDeflateTo(t *testing.T) {
+	cpi := TimeSeries{
+		{anchor, 100},
+		{anchor.AddDate(1, 0, 0), 110},
+		{anchor.AddDate(2, 0, 0), 121},
+	}
+	cfs := CashFlows{
+		{110, anchor.AddDate(1, 0, 0)},
+		{121, anchor.AddDate(2, 3, 0)}, // between observations: last known level
+	}
+	deflated, err := cfs.DeflateTo(anchor, cpi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !almostEq(deflated[0].Value, 100, epsilon) || !almostEq(deflated[1].Value, 100, epsilon) {
+		t.Errorf("DeflateTo got %+v, want 100 each", deflated)
+	}
+	if _, err := (CashFlows{{1, anchor.AddDate(-1, 0, 0)}}).DeflateTo(anchor, cpi); err == nil {
+		t.Error("DeflateTo accepted a flow before the index starts")
+	}
+}
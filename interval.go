@@ -0,0 +1,93 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Range is an uncertain value: a Base case between Low and High bounds,
+// for quick what-if bounding without a full Monte Carlo simulation.
+type Range struct {
+	Low  float64
+	Base float64
+	High float64
+}
+
+// NewRange returns a [Range], rejecting NaN values and bounds out of order.
+func NewRange(low, base, high float64) (Range, error) {
+	if !(low <= base && base <= high) {
+		return Range{}, errors.New("NewRange requires low <= base <= high")
+	}
+	return Range{low, base, high}, nil
+}
+
+// Exact returns the Range of a known value.
+func Exact(value float64) Range {
+	return Range{value, value, value}
+}
+
+// Add returns the range of sums, the bases adding up.
+// Math details:
+//
+// [a, b] + [c, d] = [a + c, b + d]
+func (r Range) Add(other Range) Range {
+	return Range{r.Low + other.Low, r.Base + other.Base, r.High + other.High}
+}
+
+// Mul returns the range of products, for example of a price range and
+// a volume range, the bases multiplying.
+// Math details:
+//
+// [a, b] * [c, d] = [min(ac, ad, bc, bd), max(ac, ad, bc, bd)]
+func (r Range) Mul(other Range) Range {
+	lo, hi := mulBounds(r.Low, r.High, other.Low, other.High)
+	return Range{lo, r.Base * other.Base, hi}
+}
+
+// Width returns the spread between the bounds.
+func (r Range) Width() float64 {
+	return r.High - r.Low
+}
+
+// mulBounds returns the bounds of the products of [a, b] and [c, d].
+func mulBounds(a, b, c, d float64) (lo, hi float64) {
+	ac, ad, bc, bd := a*c, a*d, b*c, b*d
+	return min(ac, ad, bc, bd), max(ac, ad, bc, bd)
+}
+
+// CashFlowRange is a dated cash-flow of uncertain amount.
+type CashFlowRange struct {
+	Value Range
+	Date  time.Time
+}
+
+// CashFlowRanges is a collection of [CashFlowRange].
+type CashFlowRanges []CashFlowRange
+
+// NPV returns the range of net present values at valuationDate when every
+// amount lies in its range and the discount rate lies between the rates
+// low and high, the base NPV using base amounts and the base rate.
+//
+// Bounds follow interval arithmetic, each flow taking its worst and best
+// case independently, so the result always contains the true range of NPVs
+// but can be wider when a stream of both signs, such as an investment and
+// its payoff, is discounted at an uncertain rate.
+// Math details:
+//
+// PV_i = [Low_i, High_i] * [min(DF_low(t_i), DF_high(t_i)), max(DF_low(t_i), DF_high(t_i))]
+//
+// NPV = \sum_i PV_i,   Base = \sum_i Base_i * DF_base(t_i)
+func (cfs CashFlowRanges) NPV(low, base, high Rate, valuationDate time.Time) (Range, error) {
+	var npv Range
+	for _, cf := range cfs {
+		if !(cf.Value.Low <= cf.Value.Base && cf.Value.Base <= cf.Value.High) {
+			return Range{math.NaN(), math.NaN(), math.NaN()}, errors.New("CashFlowRanges.NPV requires Low <= Base <= High for every flow")
+		}
+		years := yearsBetween(valuationDate, cf.Date)
+		d1, d2 := low.DiscountFactor(years), high.DiscountFactor(years)
+		lo, hi := mulBounds(cf.Value.Low, cf.Value.High, min(d1, d2), max(d1, d2))
+		npv = npv.Add(Range{lo, cf.Value.Base * base.DiscountFactor(years), hi})
+	}
+	return npv, nil
+}
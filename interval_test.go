@@ -0,0 +1,81 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// Range arithmetic
+// -----------------------------------------------------------------------------
+func TestRange(t *testing.T) {
+	price, _ := NewRange(9, 10, 12)
+	volume, _ := NewRange(-2, 100, 150)
+
+	if got := price.Add(Exact(1)); got != (Range{10, 11, 13}) {
+		t.Errorf("Add got %+v", got)
+	}
+	// the negative low volume gives the low product with the high price
+	if got := price.Mul(volume); got != (Range{-24, 1000, 1800}) {
+		t.Errorf("Mul got %+v", got)
+	}
+	if got := price.Width(); got != 3 {
+		t.Errorf("Width got %v, want 3", got)
+	}
+	if _, err := NewRange(1, 0, 2); err == nil {
+		t.Error("NewRange out of order: expected error")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// CashFlowRanges.NPV
+// -----------------------------------------------------------------------------
+func TestCashFlowRangesNPV(t *testing.T) {
+	r := RateEffective{0.08, 1}
+	exact := CashFlows{{-100, anchor}, {60, anchor.AddDate(1, 0, 0)}, {60, anchor.AddDate(2, 0, 0)}}
+	ranges := make(CashFlowRanges, len(exact))
+	for i, cf := range exact {
+		ranges[i] = CashFlowRange{Exact(cf.Value), cf.Date}
+	}
+
+	// without uncertainty the range collapses to the NPV
+	npv, err := ranges.NPV(r, r, r, anchor)
+	want := exact.NPV(r, anchor)
+	if err != nil || !almostEq(npv.Low, want, epsilon) || !almostEq(npv.Base, want, epsilon) || !almostEq(npv.High, want, epsilon) {
+		t.Errorf("exact NPV got %+v, %v, want %v", npv, err, want)
+	}
+
+	// inflows only: low amounts at the high rate, high amounts at the low rate
+	low, high := RateEffective{0.06, 1}, RateEffective{0.10, 1}
+	inflows := CashFlowRanges{
+		{Range{50, 60, 70}, anchor.AddDate(1, 0, 0)},
+		{Range{40, 60, 80}, anchor.AddDate(2, 0, 0)},
+	}
+	npv, _ = inflows.NPV(low, r, high, anchor)
+	wantLow := CashFlows{{50, anchor.AddDate(1, 0, 0)}, {40, anchor.AddDate(2, 0, 0)}}.NPV(high, anchor)
+	wantHigh := CashFlows{{70, anchor.AddDate(1, 0, 0)}, {80, anchor.AddDate(2, 0, 0)}}.NPV(low, anchor)
+	if !almostEq(npv.Low, wantLow, epsilon) || !almostEq(npv.High, wantHigh, epsilon) {
+		t.Errorf("inflow range got %+v, want [%v, %v]", npv, wantLow, wantHigh)
+	}
+
+	// the bounds contain every scenario on a grid of inputs
+	project := append(CashFlowRanges{{Range{-110, -100, -95}, anchor}}, inflows...)
+	npv, _ = project.NPV(low, r, high, anchor)
+	if !almostEq(npv.Base, exact.NPV(r, anchor), epsilon) {
+		t.Errorf("base NPV got %v, want %v", npv.Base, exact.NPV(r, anchor))
+	}
+	for _, rate := range []Rate{low, r, high} {
+		for _, c0 := range []float64{-110, -95} {
+			for _, c1 := range []float64{50, 70} {
+				for _, c2 := range []float64{40, 80} {
+					v := CashFlows{{c0, anchor}, {c1, anchor.AddDate(1, 0, 0)}, {c2, anchor.AddDate(2, 0, 0)}}.NPV(rate, anchor)
+					if v < npv.Low-epsilon || v > npv.High+epsilon {
+						t.Errorf("scenario NPV %v outside [%v, %v]", v, npv.Low, npv.High)
+					}
+				}
+			}
+		}
+	}
+
+	bad := CashFlowRanges{{Range{1, 0, 2}, anchor}}
+	if _, err := bad.NPV(r, r, r, anchor); err == nil {
+		t.Error("unordered range: expected error")
+	}
+}
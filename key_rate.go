@@ -0,0 +1,123 @@
+package gofinance
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// BumpPillar returns a copy of the curve with the zero rate of pillar i
+// moved by shift, a continuously compounded rate. As zero rates are
+// interpolated linearly, the bump fades to zero at the neighbouring
+// pillars, and is held flat beyond the first and last pillar.
+func (c YieldCurveZero) BumpPillar(i int, shift float64) YieldCurveZero {
+	rates := slices.Clone(c.Rates)
+	rates[i] += shift
+	return YieldCurveZero{c.Years, rates}
+}
+
+// KeyRateDuration is the sensitivity of a value to the zero rate of one
+// curve pillar at Years: Duration, the relative change in value per unit
+// of rate, and DV01, the fall in value for a one basis point rise.
+type KeyRateDuration struct {
+	Years    float64
+	Duration float64
+	DV01     float64
+}
+
+// KeyRateDurations bumps each pillar of the curve up and down by bump,
+// for example 0.0001, and reports the sensitivity of the NPV per tenor.
+// The key-rate DV01s add up to the DV01 of a parallel shift.
+// Math details:
+//
+// Duration_i = -(NPV(Pillar_i + Bump) - NPV(Pillar_i - Bump)) / (2 * Bump * NPV)
+//
+// DV01_i = -(NPV(Pillar_i + Bump) - NPV(Pillar_i - Bump)) / (2 * Bump) * 0.0001
+func (cfs CashFlows) KeyRateDurations(curve YieldCurveZero, bump float64, valuationDate time.Time) []KeyRateDuration {
+	npv := cfs.NPVCurve(curve, valuationDate)
+	krds := make([]KeyRateDuration, len(curve.Years))
+	for i, years := range curve.Years {
+		up := cfs.NPVCurve(curve.BumpPillar(i, bump), valuationDate)
+		down := cfs.NPVCurve(curve.BumpPillar(i, -bump), valuationDate)
+		slope := (up - down) / (2 * bump)
+		krds[i] = KeyRateDuration{years, -slope / npv, -slope * 0.0001}
+	}
+	return krds
+}
+
+// KeyRateDurations returns the key-rate durations of the bond's cash-flows
+// after settlement, see [CashFlows.KeyRateDurations].
+func (b Bond) KeyRateDurations(curve YieldCurveZero, bump float64, settlement time.Time) ([]KeyRateDuration, error) {
+	flows, err := b.CashFlows(settlement)
+	if err != nil {
+		return nil, err
+	}
+	return flows.KeyRateDurations(curve, bump, settlement), nil
+}
+
+// CurveShock is a change of the continuously compounded zero rate
+// by maturity in years, as used for interest rate risk scenarios.
+type CurveShock func(years float64) float64
+
+// ShockParallel moves every zero rate by shift.
+func ShockParallel(shift float64) CurveShock {
+	return func(float64) float64 { return shift }
+}
+
+// ShockShortRate moves short rates by shift, fading with maturity
+// as in the Basel interest rate risk in the banking book (IRRBB) standard.
+// Math details:
+//
+// Shock(t) = Shift * e^{-t / 4}
+func ShockShortRate(shift float64) CurveShock {
+	return func(t float64) float64 { return shift * math.Exp(-t/4) }
+}
+
+// ShockSteepener lowers short rates and raises long rates, combining the
+// short and long rate shock sizes as in the Basel IRRBB standard,
+// for example 0.03 and 0.015 for the US dollar.
+// Math details:
+//
+// Shock(t) = -0.65 * Short * e^{-t / 4} + 0.9 * Long * (1 - e^{-t / 4})
+func ShockSteepener(short, long float64) CurveShock {
+	return func(t float64) float64 {
+		w := math.Exp(-t / 4)
+		return -0.65*short*w + 0.9*long*(1-w)
+	}
+}
+
+// ShockFlattener raises short rates and lowers long rates,
+// like [ShockSteepener].
+// Math details:
+//
+// Shock(t) = 0.8 * Short * e^{-t / 4} - 0.6 * Long * (1 - e^{-t / 4})
+func ShockFlattener(short, long float64) CurveShock {
+	return func(t float64) float64 {
+		w := math.Exp(-t / 4)
+		return 0.8*short*w - 0.6*long*(1-w)
+	}
+}
+
+// YieldCurveShocked implements [YieldCurve] by adding Shock to every zero
+// rate of Curve, like [YieldCurveShifted] with a spread varying by maturity.
+type YieldCurveShocked struct {
+	Curve YieldCurve
+	Shock CurveShock
+}
+
+// DiscountFactor implements [YieldCurve].
+// Math details:
+//
+// DiscountFactor = DiscountFactor_curve(Years) * e^{Shock(Years) * -Years}
+func (c YieldCurveShocked) DiscountFactor(years float64) float64 {
+	return c.Curve.DiscountFactor(years) * math.Exp(c.Shock(years)*-years)
+}
+
+// Reprice returns the change in NPV of the cash-flows when the curve
+// is shocked, bump-and-reprice style.
+// Math details:
+//
+// Change = NPV(Curve + Shock) - NPV(Curve)
+func (cfs CashFlows) Reprice(curve YieldCurve, shock CurveShock, valuationDate time.Time) float64 {
+	return cfs.NPVCurve(YieldCurveShocked{curve, shock}, valuationDate) - cfs.NPVCurve(curve, valuationDate)
+}
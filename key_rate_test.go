@@ -0,0 +1,95 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Key-rate durations
+// -----------------------------------------------------------------------------
+func TestKeyRateDurations(t *testing.T) {
+	curve, _ := NewYieldCurveZero([]float64{1, 2, 5, 10}, []float64{0.02, 0.025, 0.03, 0.035})
+	cfs := CashFlows{
+		{4, anchor.AddDate(1, 0, 0)},
+		{4, anchor.AddDate(2, 0, 0)},
+		{4, anchor.AddDate(3, 0, 0)},
+		{104, anchor.AddDate(4, 0, 0)},
+	}
+	krds := cfs.KeyRateDurations(curve, 0.0001, anchor)
+	if len(krds) != 4 || krds[3].Years != 10 {
+		t.Fatalf("KeyRateDurations got %+v", krds)
+	}
+
+	// nothing beyond 5 years, so the 10-year pillar carries no risk
+	if math.Abs(krds[3].DV01) > 1e-12 {
+		t.Errorf("10-year DV01 got %g, want 0", krds[3].DV01)
+	}
+	// key-rate DV01s add up to the parallel DV01
+	sum := 0.0
+	for _, k := range krds {
+		sum += k.DV01
+	}
+	parallel := (cfs.Reprice(curve, ShockParallel(-0.0001), anchor) - cfs.Reprice(curve, ShockParallel(0.0001), anchor)) / 2
+	if !almostEq(sum, parallel, 1e-8) {
+		t.Errorf("sum of key-rate DV01s %f, parallel DV01 %f", sum, parallel)
+	}
+	// flows fall on the 1- and 2-year pillars, so the 1-year pillar sees only its own
+	df1 := curve.DiscountFactor(1)
+	npv := cfs.NPVCurve(curve, anchor)
+	if want := 4 * df1 / npv; !almostEq(krds[0].Duration, want, 1e-8) {
+		t.Errorf("1-year key-rate duration got %f, want %f", krds[0].Duration, want)
+	}
+
+	flows, _ := bullet.CashFlows(anchor)
+	got, err := bullet.KeyRateDurations(curve, 0.0001, anchor)
+	if err != nil || len(got) != 4 || got[1] != flows.KeyRateDurations(curve, 0.0001, anchor)[1] {
+		t.Errorf("Bond.KeyRateDurations got %+v, %v", got, err)
+	}
+}
+
+func TestBumpPillar(t *testing.T) {
+	curve, _ := NewYieldCurveZero([]float64{1, 2}, []float64{0.02, 0.03})
+	bumped := curve.BumpPillar(1, 0.01)
+	if bumped.Rates[1] != 0.04 || curve.Rates[1] != 0.03 {
+		t.Errorf("BumpPillar got %v, original %v", bumped.Rates, curve.Rates)
+	}
+	if got := bumped.ZeroRate(1.5); !almostEq(got, 0.03, epsilon) {
+		t.Errorf("bumped ZeroRate(1.5) got %f, want 0.03", got)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Shock presets
+// -----------------------------------------------------------------------------
+func TestCurveShocks(t *testing.T) {
+	tests := []struct {
+		name  string
+		shock CurveShock
+		years float64
+		want  float64
+	}{
+		{"parallel", ShockParallel(0.02), 7, 0.02},
+		{"short at 0", ShockShortRate(0.03), 0, 0.03},
+		{"short at 4", ShockShortRate(0.03), 4, 0.03 / math.E},
+		{"steepener at 0", ShockSteepener(0.03, 0.015), 0, -0.0195},
+		{"steepener long end", ShockSteepener(0.03, 0.015), 1000, 0.0135},
+		{"flattener at 0", ShockFlattener(0.03, 0.015), 0, 0.024},
+		{"flattener long end", ShockFlattener(0.03, 0.015), 1000, -0.009},
+	}
+	for _, tt := range tests {
+		if got := tt.shock(tt.years); !almostEq(got, tt.want, 1e-12) {
+			t.Errorf("%s: got %f, want %f", tt.name, got, tt.want)
+		}
+	}
+
+	flat := RateAnnualContinuous{0.03}
+	shocked := YieldCurveShocked{flat, ShockParallel(0.01)}
+	if got, want := shocked.DiscountFactor(2), math.Exp(-0.08); !almostEq(got, want, epsilon) {
+		t.Errorf("shocked DiscountFactor got %f, want %f", got, want)
+	}
+	cfs := CashFlows{{100, anchor.AddDate(2, 0, 0)}}
+	if got, want := cfs.Reprice(flat, ShockParallel(0.01), anchor), 100*(math.Exp(-0.08)-math.Exp(-0.06)); !almostEq(got, want, 1e-12) {
+		t.Errorf("Reprice got %f, want %f", got, want)
+	}
+}
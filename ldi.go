@@ -0,0 +1,99 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// PV01 returns the fall in the present value of the cash-flows when every
+// zero rate of the curve moves up by one basis point, see [YieldCurveShifted].
+// It is positive for positive flows.
+// Math details:
+//
+// PV01 = PV(Curve) - PV(Curve + 0.0001)
+func (cfs CashFlows) PV01(curve YieldCurve, valuationDate time.Time) float64 {
+	return cfs.NPVCurve(curve, valuationDate) - cfs.NPVCurve(YieldCurveShifted{curve, 0.0001}, valuationDate)
+}
+
+// IE01 returns the rise in the present value of inflation-linked cash-flows,
+// projected at the annual breakeven inflation rate, when breakeven inflation
+// moves up by one basis point.
+// Math details:
+//
+// IE01 = \sum_i Value_i * DiscountFactor(t_i) * (((1 + Breakeven + 0.0001) / (1 + Breakeven))^{t_i} - 1)
+func (cfs CashFlows) IE01(curve YieldCurve, breakeven float64, valuationDate time.Time) float64 {
+	ie01 := 0.0
+	for _, cf := range cfs {
+		t := cf.YearsFrom(valuationDate)
+		ie01 += cf.Value * curve.DiscountFactor(t) * (math.Pow((1+breakeven+0.0001)/(1+breakeven), t) - 1)
+	}
+	return ie01
+}
+
+// LDIHedge describes a pension scheme's liability-driven investment hedge:
+// the liability cash-flows, those of them that are inflation-linked
+// (projected at Breakeven inflation), the PV01 and IE01 already hedged by
+// the assets, and the target hedge ratios of interest rate and inflation
+// exposure. The overlay uses receive-fixed swaps like Swap, whose Notional
+// and PayFixed are ignored, and zero-coupon inflation swaps receiving
+// inflation for InflationSwapYears.
+type LDIHedge struct {
+	Liabilities         CashFlows
+	InflationLinked     CashFlows
+	Breakeven           float64
+	AssetPV01           float64
+	AssetIE01           float64
+	RateHedgeRatio      float64
+	InflationHedgeRatio float64
+	Swap                Swap
+	InflationSwapYears  float64
+}
+
+// LDIOverlay is the suggested overlay: the liability exposures, and the
+// notionals of the receive-fixed swap and of the zero-coupon inflation swap
+// that bring the hedge ratios to target. A negative notional means paying
+// fixed or paying inflation, i.e. reducing an existing hedge.
+type LDIOverlay struct {
+	LiabilityPV01         float64
+	LiabilityIE01         float64
+	SwapNotional          float64
+	InflationSwapNotional float64
+}
+
+// Suggest computes the overlay that closes the gap between the assets'
+// hedges and the target fractions of the liability exposures.
+// Math details:
+//
+// SwapNotional = (RateHedgeRatio * LiabilityPV01 - AssetPV01) / SwapPV01 per unit notional
+//
+// InflationSwapNotional = (InflationHedgeRatio * LiabilityIE01 - AssetIE01) / ZCIS IE01 per unit notional
+//
+// ZCIS IE01 = DiscountFactor(T) * ((1 + Breakeven + 0.0001)^T - (1 + Breakeven)^T)
+func (h LDIHedge) Suggest(curve YieldCurve, valuationDate time.Time) (LDIOverlay, error) {
+	unit := h.Swap
+	unit.Notional, unit.PayFixed = 1, false
+	dv01, err := unit.DV01(curve, valuationDate)
+	if err != nil {
+		return LDIOverlay{}, err
+	}
+	if dv01 == 0 {
+		return LDIOverlay{}, errors.New("LDIHedge: hedging swap has no rate sensitivity")
+	}
+	o := LDIOverlay{
+		LiabilityPV01: h.Liabilities.PV01(curve, valuationDate),
+		LiabilityIE01: h.InflationLinked.IE01(curve, h.Breakeven, valuationDate),
+	}
+	o.SwapNotional = (h.RateHedgeRatio*o.LiabilityPV01 - h.AssetPV01) / -dv01
+
+	gap := h.InflationHedgeRatio*o.LiabilityIE01 - h.AssetIE01
+	if gap != 0 {
+		t := h.InflationSwapYears
+		ie01 := curve.DiscountFactor(t) * (math.Pow(1+h.Breakeven+0.0001, t) - math.Pow(1+h.Breakeven, t))
+		if ie01 <= 0 {
+			return LDIOverlay{}, errors.New("LDIHedge requires a positive inflation swap maturity")
+		}
+		o.InflationSwapNotional = gap / ie01
+	}
+	return o, nil
+}
@@ -0,0 +1,69 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// PV01 and IE01
+// -----------------------------------------------------------------------------
+func TestPV01IE01(t *testing.T) {
+	curve := RateAnnualContinuous{0.03}
+	cfs := CashFlows{{1000, anchor.AddDate(10, 0, 0)}}
+	years := cfs[0].YearsFrom(anchor)
+	want := 1000 * (math.Exp(-0.03*years) - math.Exp(-0.0301*years))
+	if got := cfs.PV01(curve, anchor); !almostEq(got, want, 1e-9) {
+		t.Errorf("PV01 got %f, want %f", got, want)
+	}
+	want = 1000 * math.Exp(-0.03*years) * (math.Pow(1.0201/1.02, years) - 1)
+	if got := cfs.IE01(curve, 0.02, anchor); !almostEq(got, want, 1e-9) {
+		t.Errorf("IE01 got %f, want %f", got, want)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// LDI overlay
+// -----------------------------------------------------------------------------
+func TestLDIHedgeSuggest(t *testing.T) {
+	curve := RateAnnualContinuous{0.03}
+	var liabilities CashFlows
+	for y := 1; y <= 30; y++ {
+		liabilities = append(liabilities, CashFlow{1e6, anchor.AddDate(y, 0, 0)})
+	}
+	h := LDIHedge{
+		Liabilities:         liabilities,
+		InflationLinked:     liabilities[:15],
+		Breakeven:           0.025,
+		AssetPV01:           5000,
+		AssetIE01:           1000,
+		RateHedgeRatio:      0.8,
+		InflationHedgeRatio: 0.5,
+		Swap:                Swap{FixedRate: 0.03, Start: anchor, End: anchor.AddDate(20, 0, 0), FixedPeriodsPerYear: 1, FloatPeriodsPerYear: 2},
+		InflationSwapYears:  10,
+	}
+	o, err := h.Suggest(curve, anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the suggested swap brings the hedge ratio to target
+	swap := h.Swap
+	swap.Notional = o.SwapNotional
+	dv01, _ := swap.DV01(curve, anchor)
+	if ratio := (h.AssetPV01 - dv01) / o.LiabilityPV01; !almostEq(ratio, 0.8, 1e-9) {
+		t.Errorf("rate hedge ratio after overlay got %f, want 0.8", ratio)
+	}
+	zcis := o.InflationSwapNotional * curve.DiscountFactor(10) * (math.Pow(1.0251, 10) - math.Pow(1.025, 10))
+	if ratio := (h.AssetIE01 + zcis) / o.LiabilityIE01; !almostEq(ratio, 0.5, 1e-9) {
+		t.Errorf("inflation hedge ratio after overlay got %f, want 0.5", ratio)
+	}
+	if o.SwapNotional <= 0 || o.InflationSwapNotional <= 0 {
+		t.Errorf("under-hedged scheme got overlay %+v", o)
+	}
+
+	h.InflationSwapYears = 0
+	if _, err := h.Suggest(curve, anchor); err == nil {
+		t.Error("Suggest accepted an inflation swap without maturity")
+	}
+}
@@ -0,0 +1,119 @@
+package gofinance
+
+import (
+	"errors"
+	"time"
+)
+
+// Lease is a lessee's lease accounted for under IFRS 16.
+// Payments are the fixed lease payments, BorrowingRate the incremental
+// borrowing rate (or the rate implicit in the lease) discounting them,
+// InitialDirectCosts the costs capitalized into the right-of-use asset,
+// and End the end of the lease term, over which the asset is depreciated
+// straight-line; if End is zero the term ends with the last payment.
+type Lease struct {
+	Payments           CashFlows
+	Commencement       time.Time
+	End                time.Time
+	BorrowingRate      Rate
+	InitialDirectCosts float64
+}
+
+// LeasePeriod is one row of a [Lease.Schedule], ending at Date: the Payment
+// made, split into Interest on the liability and Principal repaid, the
+// closing Liability, the Depreciation of the right-of-use asset, and its
+// closing carrying amount Asset.
+type LeasePeriod struct {
+	Date         time.Time
+	Payment      float64
+	Interest     float64
+	Principal    float64
+	Liability    float64
+	Depreciation float64
+	Asset        float64
+}
+
+// end returns the end of the lease term.
+func (l Lease) end() time.Time {
+	if !l.End.IsZero() {
+		return l.End
+	}
+	end := l.Commencement
+	for _, p := range l.Payments {
+		if p.Date.After(end) {
+			end = p.Date
+		}
+	}
+	return end
+}
+
+// Liability returns the lease liability at commencement: the present value
+// of the payments not yet made, those after the commencement date.
+// Math details:
+//
+// Liability = \sum_{t_i > 0} Payment_i * DiscountFactor(t_i)
+func (l Lease) Liability() float64 {
+	pv := 0.0
+	for _, p := range l.Payments {
+		if p.Date.After(l.Commencement) {
+			pv += p.Value * l.BorrowingRate.DiscountFactor(p.YearsFrom(l.Commencement))
+		}
+	}
+	return pv
+}
+
+// RightOfUseAsset returns the right-of-use asset at commencement:
+// the lease liability plus payments made at or before commencement
+// plus initial direct costs.
+func (l Lease) RightOfUseAsset() float64 {
+	asset := l.Liability() + l.InitialDirectCosts
+	for _, p := range l.Payments {
+		if !p.Date.After(l.Commencement) {
+			asset += p.Value
+		}
+	}
+	return asset
+}
+
+// Schedule returns the liability amortization and asset depreciation,
+// one row per payment after commencement plus a final row at the end of
+// the term if it falls after the last payment. Interest accrues on the
+// opening liability at BorrowingRate between rows.
+// Math details:
+//
+// Interest_i = Liability_{i-1} * (DiscountFactor(t_{i-1}) / DiscountFactor(t_i) - 1)
+//
+// Depreciation_i = RightOfUseAsset * (t_i - t_{i-1}) / Term
+func (l Lease) Schedule() ([]LeasePeriod, error) {
+	end := l.end()
+	if !end.After(l.Commencement) {
+		return nil, errors.New("Lease: term must end after commencement")
+	}
+	ordered := l.Payments.SortedCopy()
+	var rows []LeasePeriod
+	liability, asset := l.Liability(), l.RightOfUseAsset()
+	term := yearsBetween(l.Commencement, end)
+	depreciationRate := asset / term
+	prev := 0.0
+	addRow := func(date time.Time, payment float64) {
+		t := yearsBetween(l.Commencement, date)
+		interest := liability * (l.BorrowingRate.DiscountFactor(prev)/l.BorrowingRate.DiscountFactor(t) - 1)
+		depreciation := 0.0
+		if tEnd := min(t, term); tEnd > prev {
+			depreciation = depreciationRate * (tEnd - prev)
+		}
+		liability += interest - payment
+		asset -= depreciation
+		rows = append(rows, LeasePeriod{date, payment, interest, payment - interest, liability, depreciation, asset})
+		prev = t
+	}
+	for _, p := range ordered {
+		if p.Date.After(l.Commencement) {
+			addRow(p.Date, p.Value)
+		}
+	}
+	if len(rows) == 0 || end.After(rows[len(rows)-1].Date) {
+		addRow(end, 0)
+	}
+	return rows, nil
+}
@@ -0,0 +1,77 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// Lease
+// -----------------------------------------------------------------------------
+func TestLease(t *testing.T) {
+	r := RateEffective{0.05, 1}
+	var payments CashFlows
+	for y := 1; y <= 3; y++ {
+		payments = append(payments, CashFlow{1000, anchor.AddDate(y, 0, 0)})
+	}
+	l := Lease{Payments: payments, Commencement: anchor, BorrowingRate: r, InitialDirectCosts: 50}
+
+	liability := 0.0
+	for _, p := range payments {
+		liability += p.Value * r.DiscountFactor(p.YearsFrom(anchor))
+	}
+	if got := l.Liability(); !almostEq(got, liability, 1e-9) {
+		t.Errorf("Liability got %f, want %f", got, liability)
+	}
+	if got := l.RightOfUseAsset(); !almostEq(got, liability+50, 1e-9) {
+		t.Errorf("RightOfUseAsset got %f, want %f", got, liability+50)
+	}
+
+	rows, err := l.Schedule()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("Schedule got %d rows, want 3", len(rows))
+	}
+	if !almostEq(rows[0].Interest, liability*0.05, 1e-6) {
+		t.Errorf("first interest got %f, want %f", rows[0].Interest, liability*0.05)
+	}
+	last := rows[2]
+	if !almostEq(last.Liability, 0, 1e-6) || !almostEq(last.Asset, 0, 1e-6) {
+		t.Errorf("final liability %f and asset %f, want 0", last.Liability, last.Asset)
+	}
+	interest, depreciation := 0.0, 0.0
+	for _, row := range rows {
+		interest += row.Interest
+		depreciation += row.Depreciation
+	}
+	if !almostEq(interest, 3000-liability, 1e-6) || !almostEq(depreciation, liability+50, 1e-6) {
+		t.Errorf("total interest %f, depreciation %f", interest, depreciation)
+	}
+}
+
+func TestLeaseInAdvance(t *testing.T) {
+	// payments in advance: the first is not part of the liability,
+	// and the term runs one year past the last payment
+	r := RateEffective{0.05, 1}
+	l := Lease{
+		Payments:      CashFlows{{1000, anchor}, {1000, anchor.AddDate(1, 0, 0)}},
+		Commencement:  anchor,
+		End:           anchor.AddDate(2, 0, 0),
+		BorrowingRate: r,
+	}
+	if got := l.Liability(); !almostEq(got, 1000/1.05, 1e-9) {
+		t.Errorf("Liability got %f", got)
+	}
+	if got := l.RightOfUseAsset(); !almostEq(got, 1000+1000/1.05, 1e-9) {
+		t.Errorf("RightOfUseAsset got %f", got)
+	}
+	rows, err := l.Schedule()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || rows[1].Payment != 0 || !almostEq(rows[1].Asset, 0, 1e-6) || !almostEq(rows[0].Liability, 0, 1e-6) {
+		t.Errorf("Schedule got %+v", rows)
+	}
+	if _, err := (Lease{Commencement: anchor, BorrowingRate: r}).Schedule(); err == nil {
+		t.Error("Schedule accepted a lease without term")
+	}
+}
@@ -0,0 +1,223 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// PrepaymentModel returns the single monthly mortality (SMM), the fraction
+// of the balance left after scheduled principal that prepays, in the month
+// the loans reach age months since origination, starting at 1.
+type PrepaymentModel func(age int) float64
+
+// SMMFromCPR converts a conditional prepayment rate, an annual rate,
+// into the equivalent single monthly mortality.
+// Math details:
+//
+// SMM = 1 - (1 - CPR)^{1/12}
+func SMMFromCPR(cpr float64) float64 {
+	return -math.Expm1(math.Log1p(-cpr) / 12)
+}
+
+// CPRFromSMM converts a single monthly mortality into the equivalent
+// annual conditional prepayment rate.
+// Math details:
+//
+// CPR = 1 - (1 - SMM)^{12}
+func CPRFromSMM(smm float64) float64 {
+	return -math.Expm1(12 * math.Log1p(-smm))
+}
+
+// ConstantSMM prepays the same fraction smm every month.
+func ConstantSMM(smm float64) PrepaymentModel {
+	return func(int) float64 { return smm }
+}
+
+// ConstantCPR prepays at the same annual conditional prepayment rate cpr
+// every month.
+func ConstantCPR(cpr float64) PrepaymentModel {
+	return ConstantSMM(SMMFromCPR(cpr))
+}
+
+// PSA is the Public Securities Association benchmark at speed, 1 for
+// 100% PSA: CPR rises by 0.2% a month for the first 30 months of age and
+// stays at 6% after, all multiplied by speed.
+// Math details:
+//
+// CPR(age) = Speed * 6% * min(age, 30) / 30
+func PSA(speed float64) PrepaymentModel {
+	return func(age int) float64 {
+		return SMMFromCPR(speed * 0.06 * float64(min(age, 30)) / 30)
+	}
+}
+
+// DefaultModel returns the monthly default rate (MDR), the fraction of the
+// performing balance that defaults in the month the loans reach age months
+// since origination, starting at 1.
+type DefaultModel func(age int) float64
+
+// ConstantCDR defaults at the same annual constant default rate cdr every
+// month, converted to a monthly rate like [SMMFromCPR].
+// Math details:
+//
+// MDR = 1 - (1 - CDR)^{1/12}
+func ConstantCDR(cdr float64) DefaultModel {
+	mdr := SMMFromCPR(cdr)
+	return func(int) float64 { return mdr }
+}
+
+// LoanPool is a pool of level-payment amortizing loans, such as
+// mortgages, modeled as one loan: Balance outstanding at Start with
+// Months of remaining term, paying monthly at the annual note Rate
+// compounded monthly, and Age months seasoned at Start.
+//
+// Prepayment sets the voluntary prepayments and Default the defaults,
+// none if nil. A defaulted balance stops paying and is recovered
+// RecoveryLag months later, less the Severity fraction lost.
+type LoanPool struct {
+	Balance     float64
+	Rate        float64
+	Months      int
+	Age         int
+	Start       time.Time
+	Prepayment  PrepaymentModel
+	Default     DefaultModel
+	Severity    float64
+	RecoveryLag int
+}
+
+// PoolPeriod is one month of a [LoanPool] projection ending at Date,
+// Month counting from 1 at Start. Balances are of performing loans;
+// Recovery and Loss settle defaults of RecoveryLag months earlier.
+type PoolPeriod struct {
+	Date               time.Time
+	Month              int
+	BeginningBalance   float64
+	Default            float64
+	Interest           float64
+	ScheduledPrincipal float64
+	Prepayment         float64
+	Recovery           float64
+	Loss               float64
+	EndingBalance      float64
+}
+
+// PoolProjection is the month by month projection of a [LoanPool].
+type PoolProjection []PoolPeriod
+
+// Project runs the pool to maturity or until it pays off, and on until
+// the last recovery. Each month defaults come first, at the start of the
+// month, then the performing loans pay the level payment that amortizes
+// their balance over the remaining term, and a fraction SMM of what is
+// left prepays.
+// Math details:
+//
+// Default = MDR(Age + m) * B,   P = B - Default
+//
+// Payment = P * r / (1 - (1 + r)^{-n}),   r = Rate / 12,   n = Months remaining
+//
+// Interest = P * r,   Scheduled = Payment - Interest,   Prepayment = SMM(Age + m) * (P - Scheduled)
+//
+// Recovery_{m + Lag} = (1 - Severity) * Default_m,   Loss_{m + Lag} = Severity * Default_m
+func (p LoanPool) Project() (PoolProjection, error) {
+	if p.Balance <= 0 || p.Months <= 0 {
+		return nil, errors.New("LoanPool requires a positive Balance and Months")
+	}
+	if p.Rate <= -12 || p.Age < 0 {
+		return nil, errors.New("LoanPool requires a Rate above -1200% and a non-negative Age")
+	}
+	if p.Severity < 0 || p.Severity > 1 || p.RecoveryLag < 0 {
+		return nil, errors.New("LoanPool requires Severity between 0 and 1 and a non-negative RecoveryLag")
+	}
+	r := p.Rate / 12
+	balance := p.Balance
+	defaults := make([]float64, p.Months+1)
+	lastDefault := 0
+	var periods PoolProjection
+	for m := 1; m <= p.Months+p.RecoveryLag; m++ {
+		if balance == 0 && m > lastDefault+p.RecoveryLag {
+			break
+		}
+		period := PoolPeriod{Date: addMonths(p.Start, m), Month: m, BeginningBalance: balance}
+		if m <= p.Months && balance > 0 {
+			if p.Default != nil {
+				period.Default = p.Default(p.Age+m) * balance
+				defaults[m] = period.Default
+				if period.Default > 0 {
+					lastDefault = m
+				}
+			}
+			performing := balance - period.Default
+			n := float64(p.Months - m + 1)
+			payment := performing / n
+			if r != 0 {
+				payment = performing * r / -math.Expm1(-n*math.Log1p(r))
+			}
+			period.Interest = performing * r
+			period.ScheduledPrincipal = payment - period.Interest
+			remaining := performing - period.ScheduledPrincipal
+			if p.Prepayment != nil && m < p.Months {
+				period.Prepayment = p.Prepayment(p.Age+m) * remaining
+			}
+			balance = remaining - period.Prepayment
+			if m == p.Months {
+				// the last payment clears the rounding left by the annuity formula
+				period.ScheduledPrincipal += balance
+				balance = 0
+			}
+		}
+		if d := m - p.RecoveryLag; d >= 1 && d <= p.Months {
+			period.Recovery = (1 - p.Severity) * defaults[d]
+			period.Loss = p.Severity * defaults[d]
+		}
+		period.EndingBalance = balance
+		periods = append(periods, period)
+	}
+	return periods, nil
+}
+
+// CashFlows returns the monthly cash-flows collected by the holder of the
+// pool, net of credit losses: interest, all principal, and recoveries,
+// ready for [CashFlows.NPV] or [CashFlows.IRR].
+func (proj PoolProjection) CashFlows() CashFlows {
+	flows := make(CashFlows, len(proj))
+	for i, period := range proj {
+		flows[i] = CashFlow{period.Interest + period.ScheduledPrincipal + period.Prepayment + period.Recovery, period.Date}
+	}
+	return flows
+}
+
+// GrossCashFlows returns the monthly cash-flows gross of credit losses,
+// as if defaulted balances were recovered in full; the difference to
+// [PoolProjection.CashFlows] is the Loss of each month.
+func (proj PoolProjection) GrossCashFlows() CashFlows {
+	flows := proj.CashFlows()
+	for i, period := range proj {
+		flows[i].Value += period.Loss
+	}
+	return flows
+}
+
+// PrincipalCashFlows returns the monthly principal returned, scheduled,
+// prepaid, and recovered, for [CashFlows.WeightedAverageLife].
+func (proj PoolProjection) PrincipalCashFlows() CashFlows {
+	flows := make(CashFlows, len(proj))
+	for i, period := range proj {
+		flows[i] = CashFlow{period.ScheduledPrincipal + period.Prepayment + period.Recovery, period.Date}
+	}
+	return flows
+}
+
+// CumulativeLoss returns the total credit losses of the projection
+// as a fraction of the original balance.
+func (proj PoolProjection) CumulativeLoss() float64 {
+	if len(proj) == 0 {
+		return 0
+	}
+	loss := 0.0
+	for _, period := range proj {
+		loss += period.Loss
+	}
+	return loss / proj[0].BeginningBalance
+}
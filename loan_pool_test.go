@@ -0,0 +1,177 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Prepayment models
+// -----------------------------------------------------------------------------
+func TestPrepaymentModels(t *testing.T) {
+	if got := SMMFromCPR(0.06); !almostEq(got, 1-math.Pow(0.94, 1.0/12), epsilon) {
+		t.Errorf("SMMFromCPR got %v", got)
+	}
+	if got := CPRFromSMM(SMMFromCPR(0.2)); !almostEq(got, 0.2, epsilon) {
+		t.Errorf("CPR round trip got %v, want 0.2", got)
+	}
+	if got := ConstantCPR(0.06)(1); !almostEq(got, SMMFromCPR(0.06), epsilon) {
+		t.Errorf("ConstantCPR got %v", got)
+	}
+
+	tests := []struct {
+		speed float64
+		age   int
+		cpr   float64
+	}{
+		{1, 1, 0.002},
+		{1, 15, 0.03},
+		{1, 30, 0.06},
+		{1, 200, 0.06},
+		{2, 30, 0.12},
+		{0.5, 10, 0.01},
+	}
+	for _, tc := range tests {
+		if got := CPRFromSMM(PSA(tc.speed)(tc.age)); !almostEq(got, tc.cpr, epsilon) {
+			t.Errorf("PSA %v%% at age %d: CPR got %v, want %v", 100*tc.speed, tc.age, got, tc.cpr)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// LoanPool.Project
+// -----------------------------------------------------------------------------
+func TestLoanPoolProject(t *testing.T) {
+	pool := LoanPool{Balance: 1e6, Rate: 0.06, Months: 360, Start: anchor}
+	proj, err := pool.Project()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proj) != 360 || proj[359].EndingBalance != 0 || !proj[0].Date.Equal(anchor.AddDate(0, 1, 0)) {
+		t.Fatalf("projection has %d months ending at %v", len(proj), proj[len(proj)-1].EndingBalance)
+	}
+	// the textbook payment of a 30-year 6% mortgage, level every month
+	payment := 1e6 * 0.005 / (1 - math.Pow(1.005, -360))
+	for _, m := range []int{0, 100, 359} {
+		if got := proj[m].Interest + proj[m].ScheduledPrincipal; !almostEq(got, payment, 1e-6) {
+			t.Errorf("month %d payment got %v, want %v", m+1, got, payment)
+		}
+	}
+	if got := proj.PrincipalCashFlows().Net(); !almostEq(got, 1e6, 1e-6) {
+		t.Errorf("principal repaid got %v, want 1e6", got)
+	}
+
+	// with a constant SMM the balance is the scheduled balance times (1 - SMM)^m
+	smm := SMMFromCPR(0.1)
+	pool.Prepayment = ConstantSMM(smm)
+	fast, _ := pool.Project()
+	for _, m := range []int{12, 120} {
+		want := proj[m-1].EndingBalance * math.Pow(1-smm, float64(m))
+		if got := fast[m-1].EndingBalance; !almostEq(got, want, 1e-6) {
+			t.Errorf("month %d balance got %v, want %v", m, got, want)
+		}
+	}
+	if got := fast.PrincipalCashFlows().Net(); !almostEq(got, 1e6, 1e-6) {
+		t.Errorf("principal repaid with prepayments got %v, want 1e6", got)
+	}
+	cf := fast.CashFlows()[0]
+	if want := fast[0].Interest + fast[0].ScheduledPrincipal + fast[0].Prepayment; !almostEq(cf.Value, want, epsilon) {
+		t.Errorf("first cash-flow got %v, want %v", cf.Value, want)
+	}
+
+	// faster prepayments shorten the weighted average life
+	walNone, _ := proj.PrincipalCashFlows().WeightedAverageLife(anchor)
+	pool.Prepayment = PSA(1)
+	psa100, _ := pool.Project()
+	wal100, _ := psa100.PrincipalCashFlows().WeightedAverageLife(anchor)
+	pool.Prepayment = PSA(3)
+	psa300, _ := pool.Project()
+	wal300, _ := psa300.PrincipalCashFlows().WeightedAverageLife(anchor)
+	if !(walNone > wal100 && wal100 > wal300) {
+		t.Errorf("WAL got %v, %v, %v, want decreasing with speed", walNone, wal100, wal300)
+	}
+
+	// a zero rate pool repays in equal instalments
+	flat, _ := LoanPool{Balance: 1200, Months: 12, Start: anchor}.Project()
+	if !almostEq(flat[5].ScheduledPrincipal, 100, 1e-9) || flat[5].Interest != 0 {
+		t.Errorf("zero-rate month got %+v", flat[5])
+	}
+
+	for _, bad := range []LoanPool{{Months: 12}, {Balance: 100}, {Balance: 100, Months: 12, Age: -1}} {
+		if _, err := bad.Project(); err == nil {
+			t.Errorf("Project(%+v): expected error", bad)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// LoanPool defaults and recoveries
+// -----------------------------------------------------------------------------
+func TestLoanPoolDefaults(t *testing.T) {
+	pool := LoanPool{
+		Balance: 1e6, Rate: 0.06, Months: 360, Start: anchor,
+		Prepayment: ConstantCPR(0.08), Default: ConstantCDR(0.02),
+		Severity: 0.4, RecoveryLag: 6,
+	}
+	proj, err := pool.Project()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the pool runs on for the recoveries of the last defaults
+	if len(proj) != 366 || proj[365].EndingBalance != 0 || proj[365].Interest != 0 {
+		t.Fatalf("projection has %d months", len(proj))
+	}
+
+	mdr := SMMFromCPR(0.02)
+	if got := proj[0].Default; !almostEq(got, mdr*1e6, 1e-9) {
+		t.Errorf("first default got %v, want %v", got, mdr*1e6)
+	}
+	if got := proj[0].Interest; !almostEq(got, (1e6-proj[0].Default)*0.005, 1e-9) {
+		t.Errorf("interest on performing balance got %v", got)
+	}
+	if proj[5].Recovery != 0 || !almostEq(proj[6].Recovery, 0.6*proj[0].Default, 1e-9) ||
+		!almostEq(proj[6].Loss, 0.4*proj[0].Default, 1e-9) {
+		t.Errorf("recovery after lag got %v, %v", proj[6].Recovery, proj[6].Loss)
+	}
+
+	// every unit of balance is repaid, prepaid, or defaults; every default
+	// is recovered or lost
+	var paid, defaulted, settled float64
+	for _, period := range proj {
+		paid += period.ScheduledPrincipal + period.Prepayment
+		defaulted += period.Default
+		settled += period.Recovery + period.Loss
+	}
+	if !almostEq(paid+defaulted, 1e6, 1e-6) || !almostEq(settled, defaulted, 1e-6) {
+		t.Errorf("paid %v + defaulted %v != 1e6, or settled %v != defaulted", paid, defaulted, settled)
+	}
+	if got := proj.CumulativeLoss(); !almostEq(got, 0.4*defaulted/1e6, 1e-12) {
+		t.Errorf("CumulativeLoss got %v, want %v", got, 0.4*defaulted/1e6)
+	}
+	if got := proj.PrincipalCashFlows().Net(); !almostEq(got, 1e6*(1-proj.CumulativeLoss()), 1e-6) {
+		t.Errorf("principal returned got %v", got)
+	}
+
+	net, gross := proj.CashFlows(), proj.GrossCashFlows()
+	for _, i := range []int{0, 6, 365} {
+		if !almostEq(gross[i].Value-net[i].Value, proj[i].Loss, 1e-9) {
+			t.Errorf("month %d: gross - net got %v, want loss %v", i+1, gross[i].Value-net[i].Value, proj[i].Loss)
+		}
+	}
+
+	// defaults without losses return the same principal, only later
+	pool.Severity = 0
+	safe, _ := pool.Project()
+	if got := safe.PrincipalCashFlows().Net(); !almostEq(got, 1e6, 1e-6) {
+		t.Errorf("zero-severity principal got %v, want 1e6", got)
+	}
+
+	for _, bad := range []LoanPool{
+		{Balance: 100, Months: 12, Severity: 1.5},
+		{Balance: 100, Months: 12, RecoveryLag: -1},
+	} {
+		if _, err := bad.Project(); err == nil {
+			t.Errorf("Project(%+v): expected error", bad)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"slices"
+)
+
+// qpMaxIterations caps the active-set iterations of the optimizers.
+const qpMaxIterations = 1000
+
+// validateMeanVariance checks that expected returns, if given,
+// and the covariance matrix describe the same assets.
+func validateMeanVariance(expected []float64, cov [][]float64) error {
+	n := len(cov)
+	if n == 0 || expected != nil && len(expected) != n {
+		return errors.New("mean-variance optimization requires one expected return per covariance row")
+	}
+	for _, row := range cov {
+		if len(row) != n {
+			return errors.New("mean-variance optimization requires a square covariance matrix")
+		}
+	}
+	return nil
+}
+
+// MinimumVariance returns the long-only, fully invested portfolio weights
+// with the lowest variance for the covariance matrix cov.
+// Math details:
+//
+// min_w w^T Cov w   subject to   \sum_i w_i = 1,   w_i >= 0
+func MinimumVariance(cov [][]float64) ([]float64, error) {
+	if err := validateMeanVariance(nil, cov); err != nil {
+		return nil, err
+	}
+	n := len(cov)
+	x0, fixed := make([]float64, n), make([]bool, n)
+	for i := range fixed {
+		fixed[i] = i != 0
+	}
+	x0[0] = 1
+	return solveQP(cov, make([]float64, n), [][]float64{ones(n)}, x0, fixed, qpMaxIterations)
+}
+
+// EfficientPortfolio returns the long-only, fully invested portfolio weights
+// with the lowest variance among those with expected return target,
+// which must lie between the lowest and the highest expected asset return.
+// Math details:
+//
+// min_w w^T Cov w   subject to   \sum_i w_i = 1,   \sum_i w_i * Expected_i = Target,   w_i >= 0
+func EfficientPortfolio(expected []float64, cov [][]float64, target float64) ([]float64, error) {
+	if err := validateMeanVariance(expected, cov); err != nil {
+		return nil, err
+	}
+	n := len(cov)
+	lo, hi := argMin(expected), argMax(expected)
+	if target < expected[lo] || target > expected[hi] {
+		return nil, errors.New("EfficientPortfolio: target return out of reach")
+	}
+	if expected[lo] == expected[hi] {
+		return MinimumVariance(cov)
+	}
+	x0, fixed := make([]float64, n), make([]bool, n)
+	for i := range fixed {
+		fixed[i] = i != lo && i != hi
+	}
+	x0[hi] = (target - expected[lo]) / (expected[hi] - expected[lo])
+	x0[lo] = 1 - x0[hi]
+	return solveQP(cov, make([]float64, n), [][]float64{ones(n), slices.Clone(expected)}, x0, fixed, qpMaxIterations)
+}
+
+// MaximumSharpe returns the long-only, fully invested portfolio weights with
+// the highest Sharpe ratio over riskFree, the tangency portfolio.
+// At least one expected return must exceed riskFree.
+// Math details:
+//
+// min_y y^T Cov y   subject to   \sum_i y_i * (Expected_i - RiskFree) = 1,   y_i >= 0
+//
+// w = y / \sum_i y_i
+func MaximumSharpe(expected []float64, cov [][]float64, riskFree float64) ([]float64, error) {
+	if err := validateMeanVariance(expected, cov); err != nil {
+		return nil, err
+	}
+	n := len(cov)
+	best := argMax(expected)
+	if expected[best] <= riskFree {
+		return nil, errors.New("MaximumSharpe requires an expected return above the risk-free rate")
+	}
+	excess := make([]float64, n)
+	for i, e := range expected {
+		excess[i] = e - riskFree
+	}
+	y0, fixed := make([]float64, n), make([]bool, n)
+	for i := range fixed {
+		fixed[i] = i != best
+	}
+	y0[best] = 1 / excess[best]
+	y, err := solveQP(cov, make([]float64, n), [][]float64{excess}, y0, fixed, qpMaxIterations)
+	if err != nil {
+		return nil, err
+	}
+	sum := 0.0
+	for _, v := range y {
+		sum += v
+	}
+	for i := range y {
+		y[i] /= sum
+	}
+	return y, nil
+}
+
+// FrontierPoint is a portfolio on the efficient frontier.
+type FrontierPoint struct {
+	Weights    []float64
+	Return     float64
+	Volatility float64
+}
+
+// EfficientFrontier samples points long-only efficient portfolios, evenly
+// spaced in expected return from the minimum-variance portfolio to the asset
+// with the highest expected return.
+func EfficientFrontier(expected []float64, cov [][]float64, points int) ([]FrontierPoint, error) {
+	if points < 2 {
+		return nil, errors.New("EfficientFrontier requires at least two points")
+	}
+	minVar, err := MinimumVariance(cov)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMeanVariance(expected, cov); err != nil {
+		return nil, err
+	}
+	from, to := dot(minVar, expected), expected[argMax(expected)]
+	frontier := make([]FrontierPoint, points)
+	for k := range frontier {
+		target := from + (to-from)*float64(k)/float64(points-1)
+		w, err := EfficientPortfolio(expected, cov, target)
+		if err != nil {
+			return nil, err
+		}
+		frontier[k] = FrontierPoint{w, dot(w, expected), math.Sqrt(quadraticForm(cov, w))}
+	}
+	return frontier, nil
+}
+
+// ExpectedReturns returns the annualized mean return of each asset,
+// for use with the mean-variance optimizers together with
+// [Portfolio.Covariance].
+func (p Portfolio) ExpectedReturns() []float64 {
+	expected := make([]float64, len(p.Returns))
+	for i, r := range p.Returns {
+		expected[i] = mean(r) * float64(p.PeriodsPerYear)
+	}
+	return expected
+}
+
+// ones returns a slice of n ones.
+func ones(n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = 1
+	}
+	return s
+}
+
+// dot returns the inner product of two slices of equal length.
+func dot(x, y []float64) float64 {
+	sum := 0.0
+	for i := range x {
+		sum += x[i] * y[i]
+	}
+	return sum
+}
+
+// quadraticForm returns x^T m x.
+func quadraticForm(m [][]float64, x []float64) float64 {
+	sum := 0.0
+	for i := range x {
+		sum += x[i] * dot(m[i], x)
+	}
+	return sum
+}
+
+// argMin returns the index of the smallest element, the first if tied.
+func argMin(xs []float64) int {
+	best := 0
+	for i, x := range xs {
+		if x < xs[best] {
+			best = i
+		}
+	}
+	return best
+}
+
+// argMax returns the index of the largest element, the first if tied.
+func argMax(xs []float64) int {
+	best := 0
+	for i, x := range xs {
+		if x > xs[best] {
+			best = i
+		}
+	}
+	return best
+}
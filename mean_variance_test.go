@@ -0,0 +1,123 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Mean-variance optimization
+// -----------------------------------------------------------------------------
+func TestMinimumVariance(t *testing.T) {
+	// two assets: w1 = (s2^2 - s12) / (s1^2 + s2^2 - 2 s12)
+	cov := [][]float64{{0.04, 0.006}, {0.006, 0.09}}
+	w, err := MinimumVariance(cov)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := (0.09 - 0.006) / (0.04 + 0.09 - 0.012)
+	if !almostEq(w[0], want, 1e-12) || !almostEq(w[1], 1-want, 1e-12) {
+		t.Errorf("MinimumVariance got %v, want [%f %f]", w, want, 1-want)
+	}
+
+	// the third asset is riskier and highly correlated: the long-only
+	// optimum leaves it out, the unconstrained one would short it
+	cov = [][]float64{
+		{0.04, 0.01, 0.05},
+		{0.01, 0.09, 0.02},
+		{0.05, 0.02, 0.16},
+	}
+	w, err = MinimumVariance(cov)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w[2] != 0 || !almostEq(w[0]+w[1], 1, 1e-12) {
+		t.Errorf("MinimumVariance got %v, want the third asset excluded", w)
+	}
+	// no point on a grid over the simplex does better
+	best := quadraticForm(cov, w)
+	for i := 0; i <= 100; i++ {
+		for j := 0; i+j <= 100; j++ {
+			v := []float64{float64(i) / 100, float64(j) / 100, float64(100-i-j) / 100}
+			if quadraticForm(cov, v) < best-1e-15 {
+				t.Fatalf("grid point %v beats MinimumVariance %v", v, w)
+			}
+		}
+	}
+}
+
+func TestMaximumSharpe(t *testing.T) {
+	// uncorrelated assets: weights proportional to (mu_i - rf) / sigma_i^2
+	expected := []float64{0.08, 0.12, 0.10}
+	cov := [][]float64{{0.04, 0, 0}, {0, 0.09, 0}, {0, 0, 0.0625}}
+	w, err := MaximumSharpe(expected, cov, 0.02)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw := []float64{0.06 / 0.04, 0.10 / 0.09, 0.08 / 0.0625}
+	sum := raw[0] + raw[1] + raw[2]
+	for i := range raw {
+		if !almostEq(w[i], raw[i]/sum, 1e-12) {
+			t.Errorf("MaximumSharpe weight %d got %f, want %f", i, w[i], raw[i]/sum)
+		}
+	}
+	// an asset earning less than the risk-free rate is left out
+	w, _ = MaximumSharpe([]float64{0.08, 0.01}, [][]float64{{0.04, 0}, {0, 0.01}}, 0.02)
+	if w[1] != 0 || !almostEq(w[0], 1, 1e-12) {
+		t.Errorf("MaximumSharpe got %v, want [1 0]", w)
+	}
+	if _, err := MaximumSharpe([]float64{0.01}, [][]float64{{0.04}}, 0.02); err == nil {
+		t.Error("MaximumSharpe accepted returns below the risk-free rate")
+	}
+}
+
+func TestEfficientFrontier(t *testing.T) {
+	expected := []float64{0.05, 0.09, 0.13}
+	cov := [][]float64{
+		{0.02, 0.004, 0.002},
+		{0.004, 0.05, 0.01},
+		{0.002, 0.01, 0.12},
+	}
+	frontier, err := EfficientFrontier(expected, cov, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minVar, _ := MinimumVariance(cov)
+	if !almostEq(frontier[0].Volatility, math.Sqrt(quadraticForm(cov, minVar)), 1e-9) {
+		t.Errorf("frontier starts at volatility %f, want the minimum variance", frontier[0].Volatility)
+	}
+	last := frontier[len(frontier)-1]
+	if !almostEq(last.Return, 0.13, 1e-12) || !almostEq(last.Weights[2], 1, 1e-12) {
+		t.Errorf("frontier ends at %+v, want the highest-return asset", last)
+	}
+	for k := 1; k < len(frontier); k++ {
+		if frontier[k].Return <= frontier[k-1].Return || frontier[k].Volatility <= frontier[k-1].Volatility {
+			t.Errorf("frontier not increasing at point %d: %+v", k, frontier)
+		}
+		w := frontier[k].Weights
+		if !almostEq(w[0]+w[1]+w[2], 1, 1e-12) || w[0] < 0 || w[1] < 0 || w[2] < 0 {
+			t.Errorf("frontier point %d weights %v not long-only and fully invested", k, w)
+		}
+	}
+	if _, err := EfficientPortfolio(expected, cov, 0.2); err == nil {
+		t.Error("EfficientPortfolio accepted an unreachable target")
+	}
+}
+
+func TestPortfolioExpectedReturns(t *testing.T) {
+	p, _ := NewPortfolio([]float64{0.5, 0.5}, [][]float64{{0.01, 0.03}, {0.02, 0.00}}, 12)
+	got := p.ExpectedReturns()
+	if !almostEq(got[0], 0.24, 1e-12) || !almostEq(got[1], 0.12, 1e-12) {
+		t.Errorf("ExpectedReturns got %v", got)
+	}
+}
+
+func TestSolveLinear(t *testing.T) {
+	x, err := solveLinear([][]float64{{0, 2}, {3, 1}}, []float64{4, 5})
+	if err != nil || !almostEq(x[0], 1, 1e-15) || !almostEq(x[1], 2, 1e-15) {
+		t.Errorf("solveLinear got %v, %v, want [1 2]", x, err)
+	}
+	if _, err := solveLinear([][]float64{{1, 2}, {2, 4}}, []float64{1, 2}); err == nil {
+		t.Error("solveLinear accepted a singular system")
+	}
+}
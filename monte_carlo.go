@@ -0,0 +1,204 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// Payoff maps a simulated price path to the payoff received at expiry.
+// path[0] is the spot price at valuation, path[len(path)-1] the price at expiry,
+// the points in between are the monitoring dates of the simulation.
+//
+// Any func(path []float64) float64 can be used, the constructors below
+// cover the common European, Asian, barrier, and lookback payoffs.
+type Payoff func(path []float64) float64
+
+// PayoffCall returns the payoff of a European call option with the given strike.
+//
+// Payoff = max(S_T - Strike, 0)
+func PayoffCall(strike float64) Payoff {
+	return func(path []float64) float64 {
+		return math.Max(path[len(path)-1]-strike, 0)
+	}
+}
+
+// PayoffPut returns the payoff of a European put option with the given strike.
+//
+// Payoff = max(Strike - S_T, 0)
+func PayoffPut(strike float64) Payoff {
+	return func(path []float64) float64 {
+		return math.Max(strike-path[len(path)-1], 0)
+	}
+}
+
+// pathAverage returns the arithmetic average of the monitoring points,
+// the spot at path[0] is excluded because it is already known.
+func pathAverage(path []float64) float64 {
+	if len(path) == 1 {
+		return path[0]
+	}
+	sum := 0.0
+	for _, s := range path[1:] {
+		sum += s
+	}
+	return sum / float64(len(path)-1)
+}
+
+// PayoffAsianCall returns the payoff of an arithmetic-average-price Asian call.
+//
+// Payoff = max(Average(S) - Strike, 0)
+func PayoffAsianCall(strike float64) Payoff {
+	return func(path []float64) float64 {
+		return math.Max(pathAverage(path)-strike, 0)
+	}
+}
+
+// PayoffAsianPut returns the payoff of an arithmetic-average-price Asian put.
+//
+// Payoff = max(Strike - Average(S), 0)
+func PayoffAsianPut(strike float64) Payoff {
+	return func(path []float64) float64 {
+		return math.Max(strike-pathAverage(path), 0)
+	}
+}
+
+// PayoffLookbackCall returns the payoff of a floating-strike lookback call,
+// the holder buys at the lowest price observed over the life of the option.
+//
+// Payoff = S_T - Min(S)
+func PayoffLookbackCall() Payoff {
+	return func(path []float64) float64 {
+		low := path[0]
+		for _, s := range path {
+			low = math.Min(low, s)
+		}
+		return path[len(path)-1] - low
+	}
+}
+
+// PayoffLookbackPut returns the payoff of a floating-strike lookback put,
+// the holder sells at the highest price observed over the life of the option.
+//
+// Payoff = Max(S) - S_T
+func PayoffLookbackPut() Payoff {
+	return func(path []float64) float64 {
+		high := path[0]
+		for _, s := range path {
+			high = math.Max(high, s)
+		}
+		return high - path[len(path)-1]
+	}
+}
+
+// BarrierKind selects whether the barrier knocks the option in or out,
+// and whether it is crossed from below or from above.
+type BarrierKind int
+
+const (
+	UpAndOut BarrierKind = iota
+	UpAndIn
+	DownAndOut
+	DownAndIn
+)
+
+// PayoffBarrier wraps any payoff, typically [PayoffCall] or [PayoffPut],
+// with a barrier monitored at every point of the path.
+// Knock-out options pay nothing once the barrier is touched,
+// knock-in options pay nothing unless the barrier is touched.
+//
+// Note that the barrier is monitored discretely, at the simulation steps only,
+// so more steps bring the price closer to a continuously monitored barrier.
+func PayoffBarrier(kind BarrierKind, barrier float64, payoff Payoff) Payoff {
+	return func(path []float64) float64 {
+		touched := false
+		for _, s := range path {
+			if (kind == UpAndOut || kind == UpAndIn) && s >= barrier ||
+				(kind == DownAndOut || kind == DownAndIn) && s <= barrier {
+				touched = true
+				break
+			}
+		}
+		knockedIn := kind == UpAndIn || kind == DownAndIn
+		if touched != knockedIn {
+			return 0
+		}
+		return payoff(path)
+	}
+}
+
+// MonteCarlo describes a simulation of the underlying price as a geometric
+// Brownian motion under the risk-neutral measure.
+// Volatility and DividendYield are annual and continuously compounded.
+//
+// Seed makes the simulation reproducible: the same inputs and the same Seed
+// always produce the same price.
+type MonteCarlo struct {
+	Spot          float64
+	Volatility    float64
+	DividendYield float64
+	Years         float64
+	Steps         int
+	Paths         int
+	Seed          uint64
+}
+
+// MonteCarloResult is the simulated price of a payoff together with
+// the standard error of the estimate.
+// Roughly 95% of independent simulations land within
+// Price ± 1.96 * StandardError of the true price.
+type MonteCarloResult struct {
+	Price         float64
+	StandardError float64
+}
+
+// Price simulates mc.Paths paths of mc.Steps steps each,
+// evaluates payoff on every path and discounts the average payoff with r.
+// Math details:
+//
+// S_{t+dt} = S_t * e^{(ContinuousRate - DividendYield - Volatility^2 / 2) * dt + Volatility * \sqrt{dt} * Z}
+//
+// Price = DiscountFactor(Years) * Mean(Payoff)
+//
+// StandardError = DiscountFactor(Years) * StdDev(Payoff) / \sqrt{Paths}
+func (mc MonteCarlo) Price(payoff Payoff, r Rate) (MonteCarloResult, error) {
+	switch {
+	case payoff == nil:
+		return MonteCarloResult{}, errors.New("MonteCarlo: payoff is nil")
+	case mc.Paths < 2:
+		return MonteCarloResult{}, fmt.Errorf("MonteCarlo: at least two paths are required: %w", ErrInsufficientData)
+	case mc.Steps < 1:
+		return MonteCarloResult{}, fmt.Errorf("MonteCarlo: at least one step is required: %w", ErrInsufficientData)
+	case mc.Years <= 0:
+		return MonteCarloResult{}, errors.New("MonteCarlo: Years must be positive")
+	case mc.Spot <= 0 || mc.Volatility < 0:
+		return MonteCarloResult{}, errors.New("MonteCarlo: Spot must be positive and Volatility non-negative")
+	}
+
+	rng := rand.New(rand.NewPCG(mc.Seed, mc.Seed))
+	dt := mc.Years / float64(mc.Steps)
+	drift := (r.RateAnnualContinuous() - mc.DividendYield - mc.Volatility*mc.Volatility/2) * dt
+	diffusion := mc.Volatility * math.Sqrt(dt)
+
+	path := make([]float64, mc.Steps+1)
+	sum, sumSq := 0.0, 0.0
+	for range mc.Paths {
+		path[0] = mc.Spot
+		for i := 1; i <= mc.Steps; i++ {
+			path[i] = path[i-1] * math.Exp(drift+diffusion*rng.NormFloat64())
+		}
+		p := payoff(path)
+		sum += p
+		sumSq += p * p
+	}
+
+	n := float64(mc.Paths)
+	mean := sum / n
+	variance := math.Max((sumSq-n*mean*mean)/(n-1), 0) // sample variance
+	df := r.DiscountFactor(mc.Years)
+	return MonteCarloResult{
+		Price:         df * mean,
+		StandardError: df * math.Sqrt(variance/n),
+	}, nil
+}
@@ -0,0 +1,123 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// blackScholesCall is the closed-form oracle for a European call.
+func blackScholesCall(spot, strike, rate, vol, years float64) float64 {
+	d1 := (math.Log(spot/strike) + (rate+vol*vol/2)*years) / (vol * math.Sqrt(years))
+	d2 := d1 - vol*math.Sqrt(years)
+	n := func(x float64) float64 { return 0.5 * math.Erfc(-x/math.Sqrt2) }
+	return spot*n(d1) - strike*math.Exp(-rate*years)*n(d2)
+}
+
+var mcBase = MonteCarlo{
+	Spot:       100,
+	Volatility: 0.2,
+	Years:      1,
+	Steps:      50,
+	Paths:      20000,
+	Seed:       42,
+}
+
+// -----------------------------------------------------------------------------
+// European payoff against Black-Scholes
+// -----------------------------------------------------------------------------
+func TestMonteCarloEuropeanCall(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.05}
+	res, err := mcBase.Price(PayoffCall(100), r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := blackScholesCall(100, 100, 0.05, 0.2, 1)
+	if math.Abs(res.Price-want) > 4*res.StandardError {
+		t.Errorf("MC call %.4f ± %.4f, Black-Scholes %.4f", res.Price, res.StandardError, want)
+	}
+	if res.StandardError <= 0 {
+		t.Errorf("StandardError = %f, want positive", res.StandardError)
+	}
+
+	// same seed → same price
+	again, _ := mcBase.Price(PayoffCall(100), r)
+	if again != res {
+		t.Errorf("simulation not reproducible: %+v vs %+v", again, res)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Path-dependent payoffs
+// -----------------------------------------------------------------------------
+func TestMonteCarloPathDependent(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.05}
+	price := func(p Payoff) float64 {
+		res, err := mcBase.Price(p, r)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return res.Price
+	}
+
+	call := price(PayoffCall(100))
+	put := price(PayoffPut(100))
+
+	// averaging reduces volatility, so the Asian call is cheaper
+	if asian := price(PayoffAsianCall(100)); asian >= call {
+		t.Errorf("Asian call %.4f should be below European call %.4f", asian, call)
+	}
+	if asian := price(PayoffAsianPut(100)); asian >= put {
+		t.Errorf("Asian put %.4f should be below European put %.4f", asian, put)
+	}
+
+	// knock-in + knock-out = vanilla, path by path
+	in := price(PayoffBarrier(UpAndIn, 120, PayoffCall(100)))
+	out := price(PayoffBarrier(UpAndOut, 120, PayoffCall(100)))
+	if !almostEq(in+out, call, epsilon) {
+		t.Errorf("up-in %.4f + up-out %.4f != call %.4f", in, out, call)
+	}
+	in = price(PayoffBarrier(DownAndIn, 80, PayoffPut(100)))
+	out = price(PayoffBarrier(DownAndOut, 80, PayoffPut(100)))
+	if !almostEq(in+out, put, epsilon) {
+		t.Errorf("down-in %.4f + down-out %.4f != put %.4f", in, out, put)
+	}
+
+	// buying at the minimum beats buying at the money
+	if lb := price(PayoffLookbackCall()); lb <= call {
+		t.Errorf("lookback call %.4f should exceed European call %.4f", lb, call)
+	}
+	if lb := price(PayoffLookbackPut()); lb <= put {
+		t.Errorf("lookback put %.4f should exceed European put %.4f", lb, put)
+	}
+}
+
+func TestPathAverageSinglePoint(t *testing.T) {
+	if got := pathAverage([]float64{7}); got != 7 {
+		t.Errorf("pathAverage single point = %f, want 7", got)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Input validation
+// -----------------------------------------------------------------------------
+func TestMonteCarloErrors(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.05}
+	tests := []struct {
+		name   string
+		mc     MonteCarlo
+		payoff Payoff
+	}{
+		{"nil payoff", mcBase, nil},
+		{"one path", MonteCarlo{Spot: 100, Years: 1, Steps: 1, Paths: 1}, PayoffCall(100)},
+		{"zero steps", MonteCarlo{Spot: 100, Years: 1, Paths: 10}, PayoffCall(100)},
+		{"zero years", MonteCarlo{Spot: 100, Steps: 1, Paths: 10}, PayoffCall(100)},
+		{"negative vol", MonteCarlo{Spot: 100, Volatility: -1, Years: 1, Steps: 1, Paths: 10}, PayoffCall(100)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := tc.mc.Price(tc.payoff, r); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
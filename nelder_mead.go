@@ -0,0 +1,71 @@
+package gofinance
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// nelderMead minimises f from x0 with the Nelder-Mead simplex method,
+// starting from a simplex of x0 and x0 moved by step along each axis.
+// It stops when the values at the simplex vertices agree to within tol,
+// or after maxIter iterations, and returns the best vertex found.
+// Reference: https://en.wikipedia.org/wiki/Nelder%E2%80%93Mead_method
+func nelderMead(f func([]float64) float64, x0 []float64, step, tol float64, maxIter int) []float64 {
+	n := len(x0)
+	type vertex struct {
+		x []float64
+		f float64
+	}
+	simplex := make([]vertex, n+1)
+	simplex[0] = vertex{slices.Clone(x0), f(x0)}
+	for i := range n {
+		x := slices.Clone(x0)
+		x[i] += step
+		simplex[i+1] = vertex{x, f(x)}
+	}
+	// along moves from the centroid c through x by the factor t
+	along := func(c, x []float64, t float64) vertex {
+		y := make([]float64, n)
+		for i := range y {
+			y[i] = c[i] + t*(x[i]-c[i])
+		}
+		return vertex{y, f(y)}
+	}
+
+	for range maxIter {
+		slices.SortFunc(simplex, func(a, b vertex) int { return cmp.Compare(a.f, b.f) })
+		best, worst := simplex[0], simplex[n]
+		if worst.f-best.f <= tol*(1+math.Abs(best.f)) {
+			break
+		}
+		centroid := make([]float64, n)
+		for _, v := range simplex[:n] {
+			for i := range centroid {
+				centroid[i] += v.x[i] / float64(n)
+			}
+		}
+		reflected := along(centroid, worst.x, -1)
+		switch {
+		case reflected.f < best.f:
+			if expanded := along(centroid, worst.x, -2); expanded.f < reflected.f {
+				simplex[n] = expanded
+			} else {
+				simplex[n] = reflected
+			}
+		case reflected.f < simplex[n-1].f:
+			simplex[n] = reflected
+		default:
+			if contracted := along(centroid, worst.x, 0.5); contracted.f < worst.f {
+				simplex[n] = contracted
+			} else {
+				// shrink towards the best vertex
+				for k := 1; k <= n; k++ {
+					simplex[k] = along(best.x, simplex[k].x, 0.5)
+				}
+			}
+		}
+	}
+	slices.SortFunc(simplex, func(a, b vertex) int { return cmp.Compare(a.f, b.f) })
+	return simplex[0].x
+}
@@ -0,0 +1,117 @@
+package gofinance
+
+import "math"
+
+// PensionPlan is a final-salary defined benefit plan valued under IAS 19
+// with the projected unit credit method. Each year of service earns an
+// annual pension of AccrualRate times the final salary, paid at the start of
+// every year from RetirementAge while the member lives.
+// Before retirement members leave with probability Turnover a year,
+// forfeiting their benefit, and salaries grow at SalaryGrowth a year.
+// Mortality[x] is the probability of dying within a year at age x,
+// death within the year being certain at ages past the table.
+// Liabilities are discounted at DiscountRate.
+type PensionPlan struct {
+	AccrualRate   float64
+	RetirementAge int
+	SalaryGrowth  float64
+	Turnover      float64
+	Mortality     []float64
+	DiscountRate  Rate
+}
+
+// PlanMember is a plan member of integer Age with Service years of service
+// and current annual Salary, which for a pensioner is the final salary.
+type PlanMember struct {
+	Age     int
+	Service float64
+	Salary  float64
+}
+
+// PensionValuation is the IAS 19 disclosure of a plan: the defined benefit
+// obligation DBO, the current ServiceCost of the coming year's accrual,
+// and the InterestCost on both for the coming year.
+type PensionValuation struct {
+	DBO          float64
+	ServiceCost  float64
+	InterestCost float64
+}
+
+// survival returns the probability of living from age to age + years.
+func (p PensionPlan) survival(age, years int) float64 {
+	s := 1.0
+	for x := age; x < age+years; x++ {
+		if x >= len(p.Mortality) {
+			return 0
+		}
+		s *= 1 - p.Mortality[x]
+	}
+	return s
+}
+
+// annuityDue returns the present value at age of 1 a year paid at the start
+// of each year while alive.
+// Math details:
+//
+// AnnuityDue = \sum_{k >= 0} Survival(Age, k) * DiscountFactor(k)
+func (p PensionPlan) annuityDue(age int) float64 {
+	pv := 0.0
+	for k := 0; age+k <= len(p.Mortality); k++ {
+		pv += p.survival(age, k) * p.DiscountRate.DiscountFactor(float64(k))
+	}
+	return pv
+}
+
+// benefitValue returns the present value of a pension of 1 a year per year
+// of service for member m, including the salary projection to retirement.
+// Math details:
+//
+// n = RetirementAge - Age
+//
+// Value = Salary * (1 + SalaryGrowth)^n * (1 - Turnover)^n * Survival(Age, n) * DiscountFactor(n) * AnnuityDue(RetirementAge)
+func (p PensionPlan) benefitValue(m PlanMember) float64 {
+	if m.Age >= p.RetirementAge {
+		return m.Salary * p.annuityDue(m.Age)
+	}
+	n := p.RetirementAge - m.Age
+	nf := float64(n)
+	return m.Salary * math.Pow(1+p.SalaryGrowth, nf) * math.Pow(1-p.Turnover, nf) *
+		p.survival(m.Age, n) * p.DiscountRate.DiscountFactor(nf) * p.annuityDue(p.RetirementAge)
+}
+
+// DBO returns the defined benefit obligation of a member: the present value
+// of the pension earned by past service at projected final salary.
+// Math details:
+//
+// DBO = AccrualRate * Service * Value
+func (p PensionPlan) DBO(m PlanMember) float64 {
+	return p.AccrualRate * m.Service * p.benefitValue(m)
+}
+
+// ServiceCost returns the present value of the pension earned by one more
+// year of service, zero for pensioners.
+// Math details:
+//
+// ServiceCost = AccrualRate * Value
+func (p PensionPlan) ServiceCost(m PlanMember) float64 {
+	if m.Age >= p.RetirementAge {
+		return 0
+	}
+	return p.AccrualRate * p.benefitValue(m)
+}
+
+// Valuation values the members and breaks the obligation into the figures
+// of the IAS 19 disclosure. Service cost is taken to accrue at the start of
+// the year, so interest is due on it for the whole year.
+// Math details:
+//
+// InterestCost = (DBO + ServiceCost) * (1 / DiscountFactor(1) - 1)
+func (p PensionPlan) Valuation(members []PlanMember) PensionValuation {
+	var v PensionValuation
+	for _, m := range members {
+		v.DBO += p.DBO(m)
+		v.ServiceCost += p.ServiceCost(m)
+	}
+	v.InterestCost = (v.DBO + v.ServiceCost) * (1/p.DiscountRate.DiscountFactor(1) - 1)
+	return v
+}
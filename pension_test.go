@@ -0,0 +1,67 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// PensionPlan
+// -----------------------------------------------------------------------------
+func TestPensionPlan(t *testing.T) {
+	// death is certain during age 67: the pension is paid at 65, 66, and 67
+	mortality := make([]float64, 67)
+	r := RateEffective{0.04, 1}
+	plan := PensionPlan{
+		AccrualRate:   0.02,
+		RetirementAge: 65,
+		SalaryGrowth:  0.03,
+		Turnover:      0.05,
+		Mortality:     mortality,
+		DiscountRate:  r,
+	}
+	annuity := 1 + 1/1.04 + 1/1.04/1.04
+	if got := plan.annuityDue(65); !almostEq(got, annuity, 1e-12) {
+		t.Errorf("annuityDue got %f, want %f", got, annuity)
+	}
+
+	m := PlanMember{Age: 55, Service: 20, Salary: 50000}
+	value := 50000 * math.Pow(1.03, 10) * math.Pow(0.95, 10) / math.Pow(1.04, 10) * annuity
+	if got := plan.DBO(m); !almostEq(got, 0.02*20*value, 1e-6) {
+		t.Errorf("DBO got %f, want %f", got, 0.02*20*value)
+	}
+	if got := plan.ServiceCost(m); !almostEq(got, 0.02*value, 1e-6) {
+		t.Errorf("ServiceCost got %f, want %f", got, 0.02*value)
+	}
+
+	pensioner := PlanMember{Age: 66, Service: 30, Salary: 60000}
+	if got, want := plan.DBO(pensioner), 0.02*30*60000*(1+1/1.04); !almostEq(got, want, 1e-6) || plan.ServiceCost(pensioner) != 0 {
+		t.Errorf("pensioner DBO got %f, want %f", got, want)
+	}
+
+	v := plan.Valuation([]PlanMember{m, pensioner})
+	if !almostEq(v.DBO, plan.DBO(m)+plan.DBO(pensioner), 1e-6) {
+		t.Errorf("Valuation DBO got %f", v.DBO)
+	}
+	if !almostEq(v.InterestCost, (v.DBO+v.ServiceCost)*0.04, 1e-6) {
+		t.Errorf("Valuation InterestCost got %f, want %f", v.InterestCost, (v.DBO+v.ServiceCost)*0.04)
+	}
+}
+
+func TestPensionPlanMortality(t *testing.T) {
+	mortality := make([]float64, 100)
+	for x := range mortality {
+		mortality[x] = 0.01
+	}
+	plan := PensionPlan{AccrualRate: 0.01, RetirementAge: 60, Mortality: mortality, DiscountRate: RateEffective{0.03, 1}}
+	if got := plan.survival(50, 10); !almostEq(got, math.Pow(0.99, 10), 1e-12) {
+		t.Errorf("survival got %f", got)
+	}
+	if plan.survival(95, 10) != 0 {
+		t.Error("survival past the mortality table is not zero")
+	}
+	young, old := PlanMember{Age: 30, Service: 5, Salary: 1}, PlanMember{Age: 50, Service: 5, Salary: 1}
+	if plan.DBO(young) >= plan.DBO(old) {
+		t.Error("DBO of a younger member with equal service and salary is not smaller")
+	}
+}
@@ -0,0 +1,146 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"time"
+)
+
+// PeriodMetric computes a performance figure, for example a return or
+// a net flow, from the cash-flows dated within a period [start, end).
+type PeriodMetric func(flows CashFlows, start, end time.Time) float64
+
+// PeriodNetFlow is a [PeriodMetric] returning the sum of the flow Values.
+func PeriodNetFlow(flows CashFlows, start, end time.Time) float64 {
+	sum := 0.0
+	for _, cf := range flows {
+		sum += cf.Value
+	}
+	return sum
+}
+
+// MonthPeriod returns the bounds [start, end) of a calendar month in UTC,
+// for use with [PeriodLedger.Lock].
+func MonthPeriod(year int, month time.Month) (start, end time.Time) {
+	start = time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// QuarterPeriod returns the bounds [start, end) of a calendar quarter in UTC,
+// quarter being 1 to 4.
+func QuarterPeriod(year, quarter int) (start, end time.Time) {
+	start = time.Date(year, time.Month(3*(quarter-1)+1), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 3, 0)
+}
+
+// LockedPeriod is a reported figure frozen at Locked time.
+type LockedPeriod struct {
+	Start  time.Time
+	End    time.Time
+	Value  float64
+	Locked time.Time
+}
+
+// PeriodAdjustment documents a change to a locked period found when the
+// cash-flows were restated: the figure as stated before, as restated,
+// and the Delta between them, recorded at Recorded time.
+type PeriodAdjustment struct {
+	Start    time.Time
+	End      time.Time
+	Stated   float64
+	Restated float64
+	Delta    float64
+	Recorded time.Time
+}
+
+// PeriodLedger locks month-end, quarter-end, or any other reporting periods.
+// Once a period is locked its figure never changes:
+// [PeriodLedger.Reconcile] recomputes the figure from restated cash-flows
+// and records the difference as a [PeriodAdjustment] instead.
+//
+//	ledger := PeriodLedger{Metric: PeriodNetFlow}
+//	ledger.Lock(cfs, start, end, time.Now())
+//	// ... flows are amended ...
+//	adjustments := ledger.Reconcile(amended, time.Now())
+type PeriodLedger struct {
+	// Metric computes the figure of a period, nil means [PeriodNetFlow].
+	Metric PeriodMetric
+
+	// Tolerance is the smallest change reported as an adjustment.
+	Tolerance float64
+
+	periods     []LockedPeriod
+	adjustments []PeriodAdjustment
+}
+
+// compute applies the metric to the flows of [start, end).
+func (l *PeriodLedger) compute(cfs CashFlows, start, end time.Time) float64 {
+	var within CashFlows
+	for _, cf := range cfs {
+		if !cf.Date.Before(start) && cf.Date.Before(end) {
+			within = append(within, cf)
+		}
+	}
+	metric := l.Metric
+	if metric == nil {
+		metric = PeriodNetFlow
+	}
+	return metric(within, start, end)
+}
+
+// Lock computes the figure for [start, end) from cfs and freezes it.
+// Locked periods may not overlap.
+func (l *PeriodLedger) Lock(cfs CashFlows, start, end, now time.Time) (LockedPeriod, error) {
+	if !end.After(start) {
+		return LockedPeriod{}, errors.New("PeriodLedger.Lock requires end after start")
+	}
+	for _, p := range l.periods {
+		if start.Before(p.End) && p.Start.Before(end) {
+			return LockedPeriod{}, errors.New("PeriodLedger.Lock: period overlaps a locked period")
+		}
+	}
+	p := LockedPeriod{start, end, l.compute(cfs, start, end), now}
+	l.periods = append(l.periods, p)
+	return p, nil
+}
+
+// Periods returns the locked periods in the order they were locked.
+func (l *PeriodLedger) Periods() []LockedPeriod {
+	return slices.Clone(l.periods)
+}
+
+// Adjustments returns every adjustment recorded so far, oldest first.
+func (l *PeriodLedger) Adjustments() []PeriodAdjustment {
+	return slices.Clone(l.adjustments)
+}
+
+// Stated returns the current figure of a locked period:
+// its locked Value plus all adjustments recorded against it.
+func (l *PeriodLedger) Stated(p LockedPeriod) float64 {
+	v := p.Value
+	for _, a := range l.adjustments {
+		if a.Start.Equal(p.Start) && a.End.Equal(p.End) {
+			v += a.Delta
+		}
+	}
+	return v
+}
+
+// Reconcile recomputes every locked period from the restated cash-flows and,
+// where the figure moved by more than Tolerance since it was last stated,
+// records and returns a [PeriodAdjustment]. Locked values are left untouched.
+func (l *PeriodLedger) Reconcile(cfs CashFlows, now time.Time) []PeriodAdjustment {
+	var recorded []PeriodAdjustment
+	for _, p := range l.periods {
+		stated := l.Stated(p)
+		restated := l.compute(cfs, p.Start, p.End)
+		if math.Abs(restated-stated) <= l.Tolerance {
+			continue
+		}
+		a := PeriodAdjustment{p.Start, p.End, stated, restated, restated - stated, now}
+		l.adjustments = append(l.adjustments, a)
+		recorded = append(recorded, a)
+	}
+	return recorded
+}
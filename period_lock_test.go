@@ -0,0 +1,100 @@
+package gofinance
+
+import (
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// Period bounds
+// -----------------------------------------------------------------------------
+func TestMonthAndQuarterPeriod(t *testing.T) {
+	s, e := MonthPeriod(2024, time.December)
+	if !s.Equal(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)) || !e.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("MonthPeriod got [%v, %v)", s, e)
+	}
+	s, e = QuarterPeriod(2024, 3)
+	if !s.Equal(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)) || !e.Equal(time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("QuarterPeriod got [%v, %v)", s, e)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// PeriodLedger
+// -----------------------------------------------------------------------------
+func TestPeriodLedger(t *testing.T) {
+	jan, feb := anchor, anchor.AddDate(0, 1, 0)
+	cfs := CashFlows{
+		{Value: 100, Date: jan.AddDate(0, 0, 5)},
+		{Value: -30, Date: jan.AddDate(0, 0, 20)},
+		{Value: 50, Date: feb.AddDate(0, 0, 3)},
+	}
+	l := PeriodLedger{}
+	locked := anchor.AddDate(0, 2, 0)
+
+	js, je := MonthPeriod(2020, time.January)
+	pJan, err := l.Lock(cfs, js, je, locked)
+	if err != nil || pJan.Value != 70 {
+		t.Fatalf("Lock January got (%+v, %v), want value 70", pJan, err)
+	}
+	fs, fe := MonthPeriod(2020, time.February)
+	if _, err := l.Lock(cfs, fs, fe, locked); err != nil {
+		t.Fatalf("Lock February: %v", err)
+	}
+
+	// nothing changed: nothing to record
+	if adj := l.Reconcile(cfs, locked); len(adj) != 0 {
+		t.Errorf("Reconcile unchanged flows recorded %+v", adj)
+	}
+
+	// backdated flow into January
+	restated := append(cfs, CashFlow{Value: 5, Date: jan.AddDate(0, 0, 25)})
+	when := anchor.AddDate(0, 3, 0)
+	adj := l.Reconcile(restated, when)
+	if len(adj) != 1 || adj[0].Delta != 5 || adj[0].Stated != 70 || adj[0].Restated != 75 || !adj[0].Recorded.Equal(when) {
+		t.Fatalf("Reconcile got %+v, want one +5 adjustment to January", adj)
+	}
+
+	// history is preserved, the stated figure moves
+	if got := l.Periods()[0].Value; got != 70 {
+		t.Errorf("locked January value changed to %f", got)
+	}
+	if got := l.Stated(pJan); got != 75 {
+		t.Errorf("Stated January got %f, want 75", got)
+	}
+
+	// reconciling the same restatement again records nothing new
+	if adj := l.Reconcile(restated, when); len(adj) != 0 {
+		t.Errorf("repeated Reconcile recorded %+v", adj)
+	}
+	if got := len(l.Adjustments()); got != 1 {
+		t.Errorf("Adjustments got %d entries, want 1", got)
+	}
+}
+
+func TestPeriodLedgerMetricAndTolerance(t *testing.T) {
+	count := func(flows CashFlows, start, end time.Time) float64 { return float64(len(flows)) }
+	l := PeriodLedger{Metric: count, Tolerance: 1}
+	s, e := QuarterPeriod(2020, 1)
+	cfs := CashFlows{{Value: 1, Date: anchor}}
+	if p, _ := l.Lock(cfs, s, e, anchor); p.Value != 1 {
+		t.Errorf("custom metric got %f, want 1", p.Value)
+	}
+	cfs = append(cfs, CashFlow{Value: 1, Date: anchor})
+	if adj := l.Reconcile(cfs, anchor); len(adj) != 0 {
+		t.Errorf("change within tolerance recorded %+v", adj)
+	}
+}
+
+func TestPeriodLedgerErrors(t *testing.T) {
+	l := PeriodLedger{}
+	s, e := QuarterPeriod(2020, 1)
+	if _, err := l.Lock(nil, e, s, anchor); err == nil {
+		t.Error("expected error for end before start")
+	}
+	l.Lock(nil, s, e, anchor)
+	ms, me := MonthPeriod(2020, time.February)
+	if _, err := l.Lock(nil, ms, me, anchor); err == nil {
+		t.Error("expected error for a month inside a locked quarter")
+	}
+}
@@ -0,0 +1,183 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Portfolio holds asset weights and the periodic return series of each asset,
+// Returns[i][t] being the simple return of asset i in period t, with
+// PeriodsPerYear periods a year. The portfolio is rebalanced to Weights every
+// period. Statistics are annualized: means scale with PeriodsPerYear,
+// volatilities with its square root.
+// Use [NewPortfolio] to get the inputs validated.
+type Portfolio struct {
+	Weights        []float64
+	Returns        [][]float64
+	PeriodsPerYear int
+}
+
+// NewPortfolio builds a [Portfolio] from one weight and one return series per
+// asset, all series of the same length of at least two periods.
+// The slices are copied.
+func NewPortfolio(weights []float64, returns [][]float64, periodsPerYear int) (Portfolio, error) {
+	if len(weights) == 0 || len(weights) != len(returns) {
+		return Portfolio{}, errors.New("NewPortfolio requires one return series per weight")
+	}
+	if periodsPerYear <= 0 {
+		return Portfolio{}, errors.New("NewPortfolio requires positive periods per year")
+	}
+	n := len(returns[0])
+	if n < 2 {
+		return Portfolio{}, fmt.Errorf("NewPortfolio requires at least two periods: %w", ErrInsufficientData)
+	}
+	rs := make([][]float64, len(returns))
+	for i, r := range returns {
+		if len(r) != n {
+			return Portfolio{}, errors.New("NewPortfolio requires return series of equal length")
+		}
+		rs[i] = slices.Clone(r)
+	}
+	return Portfolio{slices.Clone(weights), rs, periodsPerYear}, nil
+}
+
+// mean is the arithmetic mean.
+func mean(xs []float64) float64 {
+	sum := 0.0
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
+
+// covariance is the sample covariance of two series of equal length.
+func covariance(xs, ys []float64) float64 {
+	mx, my := mean(xs), mean(ys)
+	sum := 0.0
+	for i := range xs {
+		sum += (xs[i] - mx) * (ys[i] - my)
+	}
+	return sum / float64(len(xs)-1)
+}
+
+// Series returns the portfolio's return in each period.
+// Math details:
+//
+// R_t = \sum_i Weight_i * Returns_{i,t}
+func (p Portfolio) Series() []float64 {
+	series := make([]float64, len(p.Returns[0]))
+	for i, w := range p.Weights {
+		for t, r := range p.Returns[i] {
+			series[t] += w * r
+		}
+	}
+	return series
+}
+
+// ExpectedReturn returns the annualized mean portfolio return.
+func (p Portfolio) ExpectedReturn() float64 {
+	return mean(p.Series()) * float64(p.PeriodsPerYear)
+}
+
+// Covariance returns the annualized sample covariance matrix of the assets.
+func (p Portfolio) Covariance() [][]float64 {
+	n := len(p.Returns)
+	cov := make([][]float64, n)
+	for i := range cov {
+		cov[i] = make([]float64, n)
+		for j := range cov[i] {
+			cov[i][j] = covariance(p.Returns[i], p.Returns[j]) * float64(p.PeriodsPerYear)
+		}
+	}
+	return cov
+}
+
+// Volatility returns the annualized standard deviation of portfolio returns.
+// Math details:
+//
+// Volatility = \sqrt{Weights^T * Covariance * Weights}
+func (p Portfolio) Volatility() float64 {
+	cov := p.Covariance()
+	variance := 0.0
+	for i, wi := range p.Weights {
+		for j, wj := range p.Weights {
+			variance += wi * wj * cov[i][j]
+		}
+	}
+	return math.Sqrt(variance)
+}
+
+// Sharpe returns the Sharpe ratio against the annual risk-free return riskFree.
+// Math details:
+//
+// Sharpe = (ExpectedReturn - RiskFree) / Volatility
+func (p Portfolio) Sharpe(riskFree float64) float64 {
+	return (p.ExpectedReturn() - riskFree) / p.Volatility()
+}
+
+// Sortino returns the Sortino ratio against the annual target return,
+// penalizing only returns below the target.
+// Math details:
+//
+// DownsideDeviation = \sqrt{Mean(min(0, R_t - Target / PeriodsPerYear)^2) * PeriodsPerYear}
+//
+// Sortino = (ExpectedReturn - Target) / DownsideDeviation
+func (p Portfolio) Sortino(target float64) float64 {
+	ppy := float64(p.PeriodsPerYear)
+	series := p.Series()
+	downside := make([]float64, len(series))
+	for t, r := range series {
+		d := math.Min(0, r-target/ppy)
+		downside[t] = d * d
+	}
+	return (p.ExpectedReturn() - target) / math.Sqrt(mean(downside)*ppy)
+}
+
+// Beta returns the sensitivity of portfolio returns to the benchmark returns,
+// a series over the same periods.
+// Math details:
+//
+// Beta = Cov(R, Benchmark) / Var(Benchmark)
+func (p Portfolio) Beta(benchmark []float64) float64 {
+	return covariance(p.Series(), benchmark) / covariance(benchmark, benchmark)
+}
+
+// Treynor returns the excess return over riskFree per unit of [Portfolio.Beta].
+// Math details:
+//
+// Treynor = (ExpectedReturn - RiskFree) / Beta
+func (p Portfolio) Treynor(riskFree float64, benchmark []float64) float64 {
+	return (p.ExpectedReturn() - riskFree) / p.Beta(benchmark)
+}
+
+// InformationRatio returns the annualized active return over the benchmark
+// per unit of tracking error.
+// Math details:
+//
+// InformationRatio = Mean(R - Benchmark) * PeriodsPerYear / (StdDev(R - Benchmark) * \sqrt{PeriodsPerYear})
+func (p Portfolio) InformationRatio(benchmark []float64) float64 {
+	series := p.Series()
+	active := make([]float64, len(series))
+	for t := range series {
+		active[t] = series[t] - benchmark[t]
+	}
+	ppy := float64(p.PeriodsPerYear)
+	return mean(active) * ppy / (math.Sqrt(covariance(active, active)) * math.Sqrt(ppy))
+}
+
+// MaxDrawdown returns the largest fall of the portfolio's cumulative value
+// from a previous peak, as a fraction of the peak.
+// Math details:
+//
+// MaxDrawdown = max_t (1 - Wealth_t / max_{s <= t} Wealth_s),   Wealth_t = \prod_{s <= t} (1 + R_s)
+func (p Portfolio) MaxDrawdown() float64 {
+	wealth, peak, drawdown := 1.0, 1.0, 0.0
+	for _, r := range p.Series() {
+		wealth *= 1 + r
+		peak = math.Max(peak, wealth)
+		drawdown = math.Max(drawdown, 1-wealth/peak)
+	}
+	return drawdown
+}
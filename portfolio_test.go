@@ -0,0 +1,94 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Portfolio statistics
+// -----------------------------------------------------------------------------
+func TestPortfolio(t *testing.T) {
+	a := []float64{0.02, -0.01, 0.03, 0.00}
+	b := []float64{0.01, 0.01, -0.02, 0.02}
+	p, err := NewPortfolio([]float64{0.5, 0.5}, [][]float64{a, b}, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	series := p.Series()
+	want := []float64{0.015, 0, 0.005, 0.01}
+	for i := range want {
+		if !almostEq(series[i], want[i], 1e-15) {
+			t.Errorf("Series[%d] got %f, want %f", i, series[i], want[i])
+		}
+	}
+	if got := p.ExpectedReturn(); !almostEq(got, 0.0075*12, 1e-12) {
+		t.Errorf("ExpectedReturn got %f, want 0.09", got)
+	}
+	vol := math.Sqrt(covariance(series, series) * 12)
+	if got := p.Volatility(); !almostEq(got, vol, 1e-12) {
+		t.Errorf("Volatility got %f, want %f", got, vol)
+	}
+	if got := p.Sharpe(0.03); !almostEq(got, 0.06/vol, 1e-9) {
+		t.Errorf("Sharpe got %f, want %f", got, 0.06/vol)
+	}
+	// monthly target 0.01: shortfalls of 0.01 and 0.005 in months 2 and 3
+	if got := p.Sortino(0.12); !almostEq(got, (0.09-0.12)/math.Sqrt((0.01*0.01+0.005*0.005)/4*12), 1e-9) {
+		t.Errorf("Sortino got %f", got)
+	}
+	cov := p.Covariance()
+	if !almostEq(cov[0][1], cov[1][0], 1e-18) || !almostEq(cov[0][0], covariance(a, a)*12, 1e-15) {
+		t.Errorf("Covariance got %v", cov)
+	}
+}
+
+func TestPortfolioBenchmark(t *testing.T) {
+	bench := []float64{0.01, -0.02, 0.03, 0.01, -0.01}
+	doubled := make([]float64, len(bench))
+	for i, r := range bench {
+		doubled[i] = 2*r + 0.001
+	}
+	p, _ := NewPortfolio([]float64{1}, [][]float64{doubled}, 12)
+	if got := p.Beta(bench); !almostEq(got, 2, 1e-12) {
+		t.Errorf("Beta got %f, want 2", got)
+	}
+	if got := p.Treynor(0.01, bench); !almostEq(got, (p.ExpectedReturn()-0.01)/2, 1e-12) {
+		t.Errorf("Treynor got %f", got)
+	}
+	active := make([]float64, len(bench))
+	for i := range bench {
+		active[i] = doubled[i] - bench[i]
+	}
+	want := mean(active) * 12 / math.Sqrt(covariance(active, active)*12)
+	if got := p.InformationRatio(bench); !almostEq(got, want, 1e-12) {
+		t.Errorf("InformationRatio got %f, want %f", got, want)
+	}
+}
+
+func TestPortfolioMaxDrawdown(t *testing.T) {
+	p, _ := NewPortfolio([]float64{1}, [][]float64{{0.1, -0.2, 0.05, -0.1, 0.5}}, 12)
+	// peak 1.1, trough 1.1 * 0.8 * 1.05 * 0.9 = 0.8316
+	if got := p.MaxDrawdown(); !almostEq(got, 1-0.8*1.05*0.9, 1e-12) {
+		t.Errorf("MaxDrawdown got %f, want %f", got, 1-0.8*1.05*0.9)
+	}
+}
+
+func TestNewPortfolioErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []float64
+		returns [][]float64
+		ppy     int
+	}{
+		{"no assets", nil, nil, 12},
+		{"weight count", []float64{1, 0}, [][]float64{{0.1, 0.2}}, 12},
+		{"short series", []float64{1}, [][]float64{{0.1}}, 12},
+		{"ragged series", []float64{0.5, 0.5}, [][]float64{{0.1, 0.2}, {0.1}}, 12},
+		{"periods per year", []float64{1}, [][]float64{{0.1, 0.2}}, 0},
+	}
+	for _, tt := range tests {
+		if _, err := NewPortfolio(tt.weights, tt.returns, tt.ppy); err == nil {
+			t.Errorf("%s: NewPortfolio accepted invalid input", tt.name)
+		}
+	}
+}
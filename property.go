@@ -0,0 +1,161 @@
+package gofinance
+
+import (
+	"errors"
+	"time"
+)
+
+// Property is an income-producing real-estate investment bought on Date
+// for Price, closing costs included. Rents is the rent roll of gross
+// rental income and Expenses the operating expenses, as positive
+// amounts; Sale is the net proceeds of the exit, none if zero.
+// Loan is the mortgage, a [LoanPool] without prepayments or defaults
+// starting on Date, none if its Balance is zero.
+type Property struct {
+	Date     time.Time
+	Price    float64
+	Rents    CashFlows
+	Expenses CashFlows
+	Sale     CashFlow
+	Loan     LoanPool
+}
+
+// NOI returns the net operating income of the period after from, up to
+// and including to: rents less operating expenses, before debt service.
+func (p Property) NOI(from, to time.Time) float64 {
+	noi := 0.0
+	for _, cf := range p.Rents {
+		if cf.Date.After(from) && !cf.Date.After(to) {
+			noi += cf.Value
+		}
+	}
+	for _, cf := range p.Expenses {
+		if cf.Date.After(from) && !cf.Date.After(to) {
+			noi -= cf.Value
+		}
+	}
+	return noi
+}
+
+// CapRate returns the going-in capitalization rate, the first year's NOI
+// over the Price.
+func (p Property) CapRate() float64 {
+	return p.NOI(p.Date, p.Date.AddDate(1, 0, 0)) / p.Price
+}
+
+// DSCR returns the first year's debt service coverage ratio of the Loan.
+// Math details:
+//
+// DSCR = NOI_1 / DebtService_1
+func (p Property) DSCR() (float64, error) {
+	if p.Loan.Balance == 0 {
+		return 0, errors.New("Property.DSCR requires a Loan")
+	}
+	service, err := p.firstYearDebtService()
+	if err != nil {
+		return 0, err
+	}
+	return p.NOI(p.Date, p.Date.AddDate(1, 0, 0)) / service, nil
+}
+
+// CashOnCash returns the first year's cash-on-cash return: the NOI less
+// debt service of the year over the equity invested.
+// Math details:
+//
+// CashOnCash = (NOI_1 - DebtService_1) / (Price - Loan)
+func (p Property) CashOnCash() (float64, error) {
+	if p.Price <= p.Loan.Balance {
+		return 0, errors.New("Property.CashOnCash requires a Price above the Loan")
+	}
+	service, err := p.firstYearDebtService()
+	if err != nil {
+		return 0, err
+	}
+	return (p.NOI(p.Date, p.Date.AddDate(1, 0, 0)) - service) / (p.Price - p.Loan.Balance), nil
+}
+
+// CashFlows returns the unlevered cash-flows of the investment, the
+// Price paid on Date, the NOI of every rent or expense date, and the Sale.
+func (p Property) CashFlows() (CashFlows, error) {
+	if p.Price <= 0 {
+		return nil, errors.New("Property requires a positive Price")
+	}
+	if p.Sale.Value != 0 && !p.Sale.Date.After(p.Date) {
+		return nil, errors.New("Property requires a Sale after Date")
+	}
+	flows := append(CashFlows{{-p.Price, p.Date}}, p.Rents...)
+	for _, cf := range p.Expenses {
+		flows = append(flows, CashFlow{-cf.Value, cf.Date})
+	}
+	if p.Sale.Value != 0 {
+		flows = append(flows, p.Sale)
+	}
+	return flows.SortedCopy(), nil
+}
+
+// LeveredCashFlows returns the equity cash-flows of the investment: the
+// Price less the Loan on Date, the NOI less the mortgage payments, and
+// the Sale less the balance outstanding. [CashFlows.IRR] of them is the
+// levered IRR.
+func (p Property) LeveredCashFlows() (CashFlows, error) {
+	flows, err := p.CashFlows()
+	if err != nil || p.Loan.Balance == 0 {
+		return flows, err
+	}
+	service, payoff, err := p.debtService()
+	if err != nil {
+		return nil, err
+	}
+	flows[0].Value += p.Loan.Balance
+	for _, cf := range service {
+		flows = append(flows, CashFlow{-cf.Value, cf.Date})
+	}
+	if payoff > 0 {
+		flows = append(flows, CashFlow{-payoff, p.Sale.Date})
+	}
+	return flows.SortedCopy(), nil
+}
+
+// firstYearDebtService returns the mortgage payments of the year after
+// Date, none if there is no Loan.
+func (p Property) firstYearDebtService() (float64, error) {
+	if p.Loan.Balance == 0 {
+		return 0, nil
+	}
+	service, _, err := p.debtService()
+	if err != nil {
+		return 0, err
+	}
+	total := 0.0
+	for _, cf := range service {
+		if !cf.Date.After(p.Date.AddDate(1, 0, 0)) {
+			total += cf.Value
+		}
+	}
+	return total, nil
+}
+
+// debtService returns the mortgage payments up to the Sale and the
+// balance outstanding after them, repaid from the Sale.
+func (p Property) debtService() (CashFlows, float64, error) {
+	if !p.Loan.Start.Equal(p.Date) || p.Loan.Prepayment != nil || p.Loan.Default != nil {
+		return nil, 0, errors.New("Property requires a Loan starting on Date without prepayments or defaults")
+	}
+	proj, err := p.Loan.Project()
+	if err != nil {
+		return nil, 0, err
+	}
+	var service CashFlows
+	balance := p.Loan.Balance
+	for _, period := range proj {
+		if p.Sale.Value != 0 && period.Date.After(p.Sale.Date) {
+			break
+		}
+		service = append(service, CashFlow{period.Interest + period.ScheduledPrincipal, period.Date})
+		balance = period.EndingBalance
+	}
+	if p.Sale.Value == 0 {
+		balance = 0
+	}
+	return service, balance, nil
+}
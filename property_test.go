@@ -0,0 +1,105 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// testProperty is bought for 1,000,000 with a 700,000 30-year mortgage at
+// 6 %, lets for 10,000 a month against 3,000 of expenses, and is sold
+// after five years for 1,200,000.
+func testProperty() Property {
+	p := Property{
+		Date:  anchor,
+		Price: 1_000_000,
+		Sale:  CashFlow{1_200_000, addMonths(anchor, 60)},
+		Loan:  LoanPool{Balance: 700_000, Rate: 0.06, Months: 360, Start: anchor},
+	}
+	for m := 1; m <= 60; m++ {
+		p.Rents = append(p.Rents, CashFlow{10_000, addMonths(anchor, m)})
+		p.Expenses = append(p.Expenses, CashFlow{3_000, addMonths(anchor, m)})
+	}
+	return p
+}
+
+// -----------------------------------------------------------------------------
+// Property: NOI, CapRate, DSCR, CashOnCash
+// -----------------------------------------------------------------------------
+func TestPropertyMetrics(t *testing.T) {
+	p := testProperty()
+	payment := 700_000 * 0.005 / (1 - math.Pow(1.005, -360))
+
+	if got := p.NOI(anchor, addMonths(anchor, 12)); !almostEq(got, 84_000, 1e-9) {
+		t.Errorf("NOI got %v, want 84000", got)
+	}
+	if got := p.CapRate(); !almostEq(got, 0.084, epsilon) {
+		t.Errorf("CapRate got %v, want 0.084", got)
+	}
+	if got, err := p.DSCR(); err != nil || !almostEq(got, 84_000/(12*payment), 1e-9) {
+		t.Errorf("DSCR got %v, %v, want %v", got, err, 84_000/(12*payment))
+	}
+	if got, err := p.CashOnCash(); err != nil || !almostEq(got, (84_000-12*payment)/300_000, 1e-9) {
+		t.Errorf("CashOnCash got %v, %v", got, err)
+	}
+
+	// an all-cash purchase earns the cap rate on its equity
+	cash := testProperty()
+	cash.Loan = LoanPool{}
+	if got, err := cash.CashOnCash(); err != nil || !almostEq(got, cash.CapRate(), epsilon) {
+		t.Errorf("all-cash CashOnCash got %v, %v", got, err)
+	}
+	if _, err := cash.DSCR(); err == nil {
+		t.Error("DSCR without a loan: expected error")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Property.CashFlows, Property.LeveredCashFlows
+// -----------------------------------------------------------------------------
+func TestPropertyCashFlows(t *testing.T) {
+	p := testProperty()
+	unlevered, err := p.CashFlows()
+	if err != nil {
+		t.Fatal(err)
+	}
+	levered, err := p.LeveredCashFlows()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the equity pays the debt service and the balloon out of the sale
+	payment := 700_000 * 0.005 / (1 - math.Pow(1.005, -360))
+	balloon := 700_000*math.Pow(1.005, 60) - payment*(math.Pow(1.005, 60)-1)/0.005
+	sum := func(flows CashFlows) float64 {
+		total := 0.0
+		for _, cf := range flows {
+			total += cf.Value
+		}
+		return total
+	}
+	want := sum(unlevered) + 700_000 - 60*payment - balloon
+	if got := sum(levered); !almostEq(got, want, 1e-6) {
+		t.Errorf("levered total got %v, want %v", got, want)
+	}
+	if levered[0].Value != -300_000 || !levered[0].Date.Equal(anchor) {
+		t.Errorf("equity invested got %v, want -300000", levered[0])
+	}
+
+	// borrowing at 6 % against an 8.4 % yield with appreciation levers up
+	unleveredIRR, _ := unlevered.IRR()
+	leveredIRR, err := levered.IRR()
+	if err != nil || leveredIRR.RateAnnualEffective() <= unleveredIRR.RateAnnualEffective() {
+		t.Errorf("levered IRR %v, unlevered %v", leveredIRR, unleveredIRR)
+	}
+
+	bad := testProperty()
+	bad.Sale.Date = anchor
+	if _, err := bad.CashFlows(); err == nil {
+		t.Error("sale on the purchase date: expected error")
+	}
+	bad = testProperty()
+	bad.Loan.Start = addMonths(anchor, 1)
+	if _, err := bad.LeveredCashFlows(); err == nil {
+		t.Error("loan starting after the purchase: expected error")
+	}
+}
@@ -0,0 +1,142 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// solveLinear solves m x = rhs by Gaussian elimination with partial pivoting.
+// m and rhs are overwritten.
+func solveLinear(m [][]float64, rhs []float64) ([]float64, error) {
+	n := len(rhs)
+	scale := 0.0
+	for _, row := range m {
+		for _, v := range row {
+			scale = math.Max(scale, math.Abs(v))
+		}
+	}
+	for col := range n {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(m[r][col]) > math.Abs(m[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(m[pivot][col]) <= 1e-13*scale {
+			return nil, errors.New("solveLinear: singular system")
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		rhs[col], rhs[pivot] = rhs[pivot], rhs[col]
+		for r := col + 1; r < n; r++ {
+			f := m[r][col] / m[col][col]
+			for c := col; c < n; c++ {
+				m[r][c] -= f * m[col][c]
+			}
+			rhs[r] -= f * rhs[col]
+		}
+	}
+	x := make([]float64, n)
+	for r := n - 1; r >= 0; r-- {
+		sum := rhs[r]
+		for c := r + 1; c < n; c++ {
+			sum -= m[r][c] * x[c]
+		}
+		x[r] = sum / m[r][r]
+	}
+	return x, nil
+}
+
+// solveQP minimizes the convex quadratic 1/2 x^T q x + c^T x subject to the
+// equality constraints a x = a x0 and x >= 0, with the primal active-set
+// method (Nocedal and Wright, Algorithm 16.3). x0 must be feasible and
+// zero exactly on the bounds listed in fixed, the initial working set.
+func solveQP(q [][]float64, c []float64, a [][]float64, x0 []float64, fixed []bool, maxIterations int) ([]float64, error) {
+	n, m := len(x0), len(a)
+	x := append([]float64(nil), x0...)
+	working := append([]bool(nil), fixed...)
+
+	for range maxIterations {
+		g := make([]float64, n)
+		for i := range n {
+			g[i] = c[i]
+			for j := range n {
+				g[i] += q[i][j] * x[j]
+			}
+		}
+		var free []int
+		for i := range n {
+			if !working[i] {
+				free = append(free, i)
+			}
+		}
+
+		// KKT system over the free variables: [Q_FF, -A_F^T; A_F, 0] [p; nu] = [-g_F; 0]
+		k := len(free) + m
+		kkt := make([][]float64, k)
+		rhs := make([]float64, k)
+		for r := range kkt {
+			kkt[r] = make([]float64, k)
+		}
+		for r, i := range free {
+			for s, j := range free {
+				kkt[r][s] = q[i][j]
+			}
+			for e := range m {
+				kkt[r][len(free)+e] = -a[e][i]
+				kkt[len(free)+e][r] = a[e][i]
+			}
+			rhs[r] = -g[i]
+		}
+		sol, err := solveLinear(kkt, rhs)
+		if err != nil {
+			return nil, err
+		}
+		p := make([]float64, n)
+		size, step := 0.0, 0.0
+		for r, i := range free {
+			p[i] = sol[r]
+			size = math.Max(size, math.Abs(x[i]))
+			step = math.Max(step, math.Abs(p[i]))
+		}
+
+		if step <= 1e-12*(1+size) {
+			// bound multipliers: g_i - (A^T nu)_i, must be non-negative
+			worst, worstMu := -1, 0.0
+			for i := range n {
+				if !working[i] {
+					continue
+				}
+				mu := g[i]
+				for e := range m {
+					mu -= a[e][i] * sol[len(free)+e]
+				}
+				if mu < worstMu {
+					worst, worstMu = i, mu
+				}
+			}
+			if worst < 0 || worstMu > -1e-14*(1+math.Abs(g[worst])) {
+				return x, nil
+			}
+			working[worst] = false
+			continue
+		}
+
+		alpha, blocking := 1.0, -1
+		for _, i := range free {
+			if p[i] < 0 {
+				if t := -x[i] / p[i]; t < alpha {
+					alpha, blocking = t, i
+				}
+			}
+		}
+		for _, i := range free {
+			x[i] += alpha * p[i]
+		}
+		if blocking >= 0 {
+			x[blocking] = 0
+			working[blocking] = true
+		}
+	}
+	return nil, fmt.Errorf("solveQP: %w", ErrMaxIterations)
+}
@@ -1,6 +1,9 @@
 package gofinance
 
-import "math"
+import (
+	"errors"
+	"math"
+)
 
 // Rate represents an interest rate, discount rate, compound rate, etc.
 type Rate interface {
@@ -150,3 +153,55 @@ func (r RateAnnualContinuous) RateAnnualEffective() float64 {
 func (r RateAnnualContinuous) RateAnnualContinuous() float64 {
 	return r.Value
 }
+
+// validPeriodsPerYear reports whether periods is a usable compounding frequency.
+func validPeriodsPerYear(periods float64) bool {
+	return periods > 0 && !math.IsInf(periods, 1)
+}
+
+// finite reports whether x is neither NaN nor infinite.
+func finite(x float64) bool {
+	return !math.IsNaN(x) && !math.IsInf(x, 0)
+}
+
+// NewRateAnnualPercentage returns a [RateAnnualPercentage], rejecting
+// values that would give infinite or NaN discount factors:
+// non-positive or infinite PeriodsPerYear, NaN or infinite values,
+// and rates of -100% or less per period.
+func NewRateAnnualPercentage(value, periodsPerYear float64) (RateAnnualPercentage, error) {
+	if !validPeriodsPerYear(periodsPerYear) {
+		return RateAnnualPercentage{}, errors.New("NewRateAnnualPercentage requires positive finite PeriodsPerYear")
+	}
+	if !finite(value) {
+		return RateAnnualPercentage{}, errors.New("NewRateAnnualPercentage requires a finite value")
+	}
+	if value/periodsPerYear <= -1 {
+		return RateAnnualPercentage{}, errors.New("NewRateAnnualPercentage requires a rate above -100% per period")
+	}
+	return RateAnnualPercentage{value, periodsPerYear}, nil
+}
+
+// NewRateEffective returns a [RateEffective], rejecting values that would
+// give infinite or NaN discount factors: non-positive or infinite
+// PeriodsPerYear, NaN or infinite values, and rates of -100% or less.
+func NewRateEffective(value, periodsPerYear float64) (RateEffective, error) {
+	if !validPeriodsPerYear(periodsPerYear) {
+		return RateEffective{}, errors.New("NewRateEffective requires positive finite PeriodsPerYear")
+	}
+	if !finite(value) {
+		return RateEffective{}, errors.New("NewRateEffective requires a finite value")
+	}
+	if value <= -1 {
+		return RateEffective{}, errors.New("NewRateEffective requires a rate above -100%")
+	}
+	return RateEffective{value, periodsPerYear}, nil
+}
+
+// NewRateAnnualContinuous returns a [RateAnnualContinuous],
+// rejecting NaN and infinite values.
+func NewRateAnnualContinuous(value float64) (RateAnnualContinuous, error) {
+	if !finite(value) {
+		return RateAnnualContinuous{}, errors.New("NewRateAnnualContinuous requires a finite value")
+	}
+	return RateAnnualContinuous{value}, nil
+}
@@ -0,0 +1,99 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+)
+
+// ToAnnualPercentage converts any rate to the equivalent annual percentage
+// rate compounded periodsPerYear times a year, for example an APR
+// compounded monthly to one compounded quarterly.
+// Math details:
+//
+// (1 + AnnualPercentageRate / Periods)^Periods = 1 + EffectiveAnnualRate
+//
+// AnnualPercentageRate = Periods * ((1 + EffectiveAnnualRate)^{1 / Periods} - 1)
+func ToAnnualPercentage(r Rate, periodsPerYear float64) RateAnnualPercentage {
+	return RateAnnualPercentage{periodsPerYear * math.Expm1(r.RateAnnualContinuous()/periodsPerYear), periodsPerYear}
+}
+
+// ToEffective converts any rate to the equivalent effective rate per
+// period, with periodsPerYear periods a year.
+// Math details:
+//
+// (1 + EffectiveRate)^Periods = 1 + EffectiveAnnualRate
+//
+// EffectiveRate = (1 + EffectiveAnnualRate)^{1 / Periods} - 1
+func ToEffective(r Rate, periodsPerYear float64) RateEffective {
+	return RateEffective{math.Expm1(r.RateAnnualContinuous() / periodsPerYear), periodsPerYear}
+}
+
+// ToContinuous converts any rate to the equivalent continuous rate.
+func ToContinuous(r Rate) RateAnnualContinuous {
+	return RateAnnualContinuous{r.RateAnnualContinuous()}
+}
+
+// AddSpread returns the rate with basisPoints added to its quoted Value,
+// in its own convention: 5% APR monthly plus 25 basis points is 5.25% APR
+// monthly. Rates of other types get the spread as a continuous rate,
+// as in [YieldCurveShifted].
+func AddSpread(r Rate, basisPoints float64) Rate {
+	spread := basisPoints / 10000
+	switch r := r.(type) {
+	case RateAnnualPercentage:
+		return RateAnnualPercentage{r.Value + spread, r.PeriodsPerYear}
+	case RateEffective:
+		return RateEffective{r.Value + spread, r.PeriodsPerYear}
+	case RateAnnualContinuous:
+		return RateAnnualContinuous{r.Value + spread}
+	case RateReal:
+		return RateReal{r.Value + spread}
+	case RateSimple:
+		return RateSimple{r.Value + spread, r.DaysPerYear}
+	case RateBankDiscount:
+		return RateBankDiscount{r.Value + spread, r.DaysPerYear}
+	}
+	return RateAnnualContinuous{r.RateAnnualContinuous() + spread}
+}
+
+// Scale returns the rate with its quoted Value multiplied by factor,
+// in its own convention, for example 0.7 of a rate for an after-tax yield.
+// Rates of other types are scaled as continuous rates.
+func Scale(r Rate, factor float64) Rate {
+	switch r := r.(type) {
+	case RateAnnualPercentage:
+		return RateAnnualPercentage{r.Value * factor, r.PeriodsPerYear}
+	case RateEffective:
+		return RateEffective{r.Value * factor, r.PeriodsPerYear}
+	case RateAnnualContinuous:
+		return RateAnnualContinuous{r.Value * factor}
+	case RateReal:
+		return RateReal{r.Value * factor}
+	case RateSimple:
+		return RateSimple{r.Value * factor, r.DaysPerYear}
+	case RateBankDiscount:
+		return RateBankDiscount{r.Value * factor, r.DaysPerYear}
+	}
+	return RateAnnualContinuous{r.RateAnnualContinuous() * factor}
+}
+
+// Blend returns the weighted average of rates as an annual effective
+// [Rate], for example a blended cost of funds. Weights are normalised
+// to sum to one.
+// Math details:
+//
+// Blend = \sum_i Weight_i * EffectiveAnnualRate_i / \sum_i Weight_i
+func Blend(rates []Rate, weights []float64) (Rate, error) {
+	if len(rates) == 0 || len(rates) != len(weights) {
+		return nil, errors.New("Blend requires one weight per rate")
+	}
+	total, blend := 0.0, 0.0
+	for i, r := range rates {
+		total += weights[i]
+		blend += weights[i] * r.RateAnnualEffective()
+	}
+	if total == 0 {
+		return nil, errors.New("Blend requires weights not summing to zero")
+	}
+	return RateEffective{blend / total, 1}, nil
+}
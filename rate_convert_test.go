@@ -0,0 +1,84 @@
+package gofinance
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Conversions
+// -----------------------------------------------------------------------------
+func TestRateConversions(t *testing.T) {
+	monthly := RateAnnualPercentage{0.06, 12}
+
+	quarterly := ToAnnualPercentage(monthly, 4)
+	if quarterly.PeriodsPerYear != 4 || !almostEq(quarterly.RateAnnualEffective(), monthly.RateAnnualEffective(), epsilon) {
+		t.Errorf("ToAnnualPercentage got %+v", quarterly)
+	}
+	// 1.005^3 - 1 a quarter
+	if want := 4 * 0.015075125; !almostEq(quarterly.Value, want, 1e-12) {
+		t.Errorf("ToAnnualPercentage got %.12f, want %.12f", quarterly.Value, want)
+	}
+
+	perMonth := ToEffective(monthly, 12)
+	if !almostEq(perMonth.Value, 0.005, epsilon) || perMonth.PeriodsPerYear != 12 {
+		t.Errorf("ToEffective got %+v, want 0.005 a month", perMonth)
+	}
+
+	cont := ToContinuous(RateEffective{0.05, 1})
+	if !almostEq(cont.Value, 0.04879016416943205, epsilon) {
+		t.Errorf("ToContinuous got %+v", cont)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Arithmetic
+// -----------------------------------------------------------------------------
+func TestAddSpreadScale(t *testing.T) {
+	tests := []struct {
+		rate   Rate
+		spread Rate
+		scaled Rate
+	}{
+		{RateAnnualPercentage{0.05, 12}, RateAnnualPercentage{0.0525, 12}, RateAnnualPercentage{0.025, 12}},
+		{RateEffective{0.01, 4}, RateEffective{0.0125, 4}, RateEffective{0.005, 4}},
+		{RateAnnualContinuous{0.04}, RateAnnualContinuous{0.0425}, RateAnnualContinuous{0.02}},
+		{RateReal{0.01}, RateReal{0.0125}, RateReal{0.005}},
+		{RateSimple{0.04, 360}, RateSimple{0.0425, 360}, RateSimple{0.02, 360}},
+		{RateBankDiscount{0.04, 360}, RateBankDiscount{0.0425, 360}, RateBankDiscount{0.02, 360}},
+	}
+	for _, tt := range tests {
+		if got := AddSpread(tt.rate, 25); !sameRate(got, tt.spread) {
+			t.Errorf("AddSpread(%#v) got %#v, want %#v", tt.rate, got, tt.spread)
+		}
+		if got := Scale(tt.rate, 0.5); !sameRate(got, tt.scaled) {
+			t.Errorf("Scale(%#v) got %#v, want %#v", tt.rate, got, tt.scaled)
+		}
+	}
+
+	// a Rate of any other type is treated as continuous
+	if got := AddSpread(rateOnly{RateAnnualContinuous{0.03}}, 100); !almostEq(got.RateAnnualContinuous(), 0.04, epsilon) {
+		t.Errorf("AddSpread fallback got %#v", got)
+	}
+}
+
+// sameRate reports whether two rates are of the same type and value.
+func sameRate(a, b Rate) bool {
+	return fmt.Sprintf("%T", a) == fmt.Sprintf("%T", b) && almostEq(a.RateAnnualEffective(), b.RateAnnualEffective(), epsilon)
+}
+
+// rateOnly hides the concrete type of a Rate.
+type rateOnly struct{ Rate }
+
+func TestBlend(t *testing.T) {
+	got, err := Blend([]Rate{RateEffective{0.04, 1}, RateEffective{0.10, 1}}, []float64{3, 1})
+	if err != nil || !almostEq(got.RateAnnualEffective(), 0.055, epsilon) {
+		t.Errorf("Blend got %v, %v, want 0.055", got, err)
+	}
+	if _, err := Blend([]Rate{RateEffective{0.04, 1}}, nil); err == nil {
+		t.Error("Blend accepted mismatched weights")
+	}
+	if _, err := Blend([]Rate{RateEffective{0.04, 1}, RateEffective{0.05, 1}}, []float64{1, -1}); err == nil {
+		t.Error("Blend accepted weights summing to zero")
+	}
+}
@@ -0,0 +1,134 @@
+package gofinance
+
+import "math"
+
+// RateSimple implements [Rate] for a money-market simple interest rate,
+// accruing linearly over days counted on a year of DaysPerYear days,
+// 360 for ACT/360 or 365 for ACT/365.
+// Years are converted to days at 365 a year, matching the year fractions
+// of a non-leap year; use [RateSimple.DiscountFactorDays] for exact days.
+// As interest does not compound, the annual rates it converts to are
+// those of a one-year investment.
+type RateSimple struct {
+	Value       float64
+	DaysPerYear float64
+}
+
+// DiscountFactorDays returns the discount factor over days calendar days.
+// Math details:
+//
+// DiscountFactor = 1 / (1 + SimpleRate * Days / DaysPerYear)
+func (r RateSimple) DiscountFactorDays(days float64) float64 {
+	return 1 / (1 + r.Value*days/r.DaysPerYear)
+}
+
+// DiscountFactor implements [Rate].
+// DiscountFactor returns discount factor based on number of years.
+// Math details:
+//
+// DiscountFactor = 1 / (1 + SimpleRate * Years * 365 / DaysPerYear)
+func (r RateSimple) DiscountFactor(years float64) float64 {
+	return r.DiscountFactorDays(years * 365)
+}
+
+// RateAnnualEffective implements [Rate].
+// RateAnnualEffective converts any rate to effective annual rate.
+// Math details:
+//
+// EffectiveAnnualRate = SimpleRate * 365 / DaysPerYear
+func (r RateSimple) RateAnnualEffective() float64 {
+	return 1/r.DiscountFactor(1) - 1
+}
+
+// RateAnnualContinuous implements [Rate].
+// RateAnnualContinuous converts any rate to continuous rate.
+// Math details:
+//
+// ContinuousRate = ln(1 + EffectiveAnnualRate)
+func (r RateSimple) RateAnnualContinuous() float64 {
+	return math.Log1p(r.RateAnnualEffective())
+}
+
+// RateBankDiscount implements [Rate] for a bank discount rate, the quote
+// of Treasury bills and other discount instruments: the discount from face
+// value is the rate times days over DaysPerYear, usually 360.
+// Years are converted to days at 365 a year, as for [RateSimple].
+// The discount factor turns negative beyond DaysPerYear / Value days.
+type RateBankDiscount struct {
+	Value       float64
+	DaysPerYear float64
+}
+
+// BankDiscountFromPrice returns the bank discount rate of a bill priced at
+// price per 1 of face value with days to maturity.
+// Math details:
+//
+// BankDiscountRate = (1 - Price) * DaysPerYear / Days
+func BankDiscountFromPrice(price, days, daysPerYear float64) RateBankDiscount {
+	return RateBankDiscount{(1 - price) * daysPerYear / days, daysPerYear}
+}
+
+// DiscountFactorDays returns the price per 1 of face value of a bill
+// with days to maturity.
+// Math details:
+//
+// DiscountFactor = 1 - BankDiscountRate * Days / DaysPerYear
+func (r RateBankDiscount) DiscountFactorDays(days float64) float64 {
+	return 1 - r.Value*days/r.DaysPerYear
+}
+
+// DiscountFactor implements [Rate].
+// DiscountFactor returns discount factor based on number of years.
+// Math details:
+//
+// DiscountFactor = 1 - BankDiscountRate * Years * 365 / DaysPerYear
+func (r RateBankDiscount) DiscountFactor(years float64) float64 {
+	return r.DiscountFactorDays(years * 365)
+}
+
+// RateAnnualEffective implements [Rate].
+// RateAnnualEffective converts any rate to effective annual rate,
+// that of a one-year bill.
+// Math details:
+//
+// EffectiveAnnualRate = 1 / DiscountFactor(1) - 1
+func (r RateBankDiscount) RateAnnualEffective() float64 {
+	return 1/r.DiscountFactor(1) - 1
+}
+
+// RateAnnualContinuous implements [Rate].
+// RateAnnualContinuous converts any rate to continuous rate.
+// Math details:
+//
+// ContinuousRate = ln(1 + EffectiveAnnualRate)
+func (r RateBankDiscount) RateAnnualContinuous() float64 {
+	return math.Log1p(r.RateAnnualEffective())
+}
+
+// MoneyMarketYield returns the simple rate earned on the same day count
+// by a bill with days to maturity, its CD-equivalent yield.
+// Math details:
+//
+// MoneyMarketYield = DaysPerYear * BankDiscountRate / (DaysPerYear - BankDiscountRate * Days)
+func (r RateBankDiscount) MoneyMarketYield(days float64) RateSimple {
+	return RateSimple{r.DaysPerYear * r.Value / (r.DaysPerYear - r.Value*days), r.DaysPerYear}
+}
+
+// BondEquivalentYield returns the yield of a bill with days to maturity
+// comparable to the semiannual yield of a coupon bond, on a 365-day year.
+// Up to half a year, 182 days, it is the simple ACT/365 yield; beyond, it
+// solves for the semiannual yield that grows the price to face value with
+// simple interest after the first half year.
+// Math details:
+//
+// BondEquivalentYield = 365 * BankDiscountRate / (DaysPerYear - BankDiscountRate * Days)   Days <= 182
+//
+// BondEquivalentYield = (-2 * Days / 365 + 2 * \sqrt{(Days / 365)^2 - (2 * Days / 365 - 1) * (1 - 1 / Price)}) / (2 * Days / 365 - 1)   Days > 182
+func (r RateBankDiscount) BondEquivalentYield(days float64) float64 {
+	if days <= 182 {
+		return 365 * r.Value / (r.DaysPerYear - r.Value*days)
+	}
+	price := r.DiscountFactorDays(days)
+	t := days / 365
+	return (-2*t + 2*math.Sqrt(t*t-(2*t-1)*(1-1/price))) / (2*t - 1)
+}
@@ -0,0 +1,63 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// RateSimple
+// -----------------------------------------------------------------------------
+func TestRateSimple(t *testing.T) {
+	r := RateSimple{0.05, 360}
+	if got := r.DiscountFactorDays(90); !almostEq(got, 1/1.0125, epsilon) {
+		t.Errorf("DiscountFactorDays got %f, want %f", got, 1/1.0125)
+	}
+	if got := r.DiscountFactor(0.5); !almostEq(got, 1/(1+0.05*182.5/360), epsilon) {
+		t.Errorf("DiscountFactor got %f", got)
+	}
+	if got := r.RateAnnualEffective(); !almostEq(got, 0.05*365/360, epsilon) {
+		t.Errorf("RateAnnualEffective got %f, want %f", got, 0.05*365/360)
+	}
+	if got := r.RateAnnualContinuous(); !almostEq(got, math.Log(1+0.05*365/360), epsilon) {
+		t.Errorf("RateAnnualContinuous got %f", got)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// RateBankDiscount
+// -----------------------------------------------------------------------------
+func TestRateBankDiscount(t *testing.T) {
+	d := RateBankDiscount{0.08, 360}
+
+	if got := d.DiscountFactorDays(150); !almostEq(got, 1-0.08*150/360, epsilon) {
+		t.Errorf("DiscountFactorDays got %f", got)
+	}
+	if got := BankDiscountFromPrice(d.DiscountFactorDays(150), 150, 360); !almostEq(got.Value, 0.08, epsilon) {
+		t.Errorf("BankDiscountFromPrice got %+v, want 0.08", got)
+	}
+	if got := d.RateAnnualEffective(); !almostEq(got, 1/(1-0.08*365/360)-1, epsilon) {
+		t.Errorf("RateAnnualEffective got %f", got)
+	}
+
+	mmy := d.MoneyMarketYield(150)
+	if !almostEq(mmy.Value, 28.8/348, epsilon) || mmy.DaysPerYear != 360 {
+		t.Errorf("MoneyMarketYield got %+v, want %f", mmy, 28.8/348)
+	}
+	// the money-market yield earns back face value from the bill price
+	if got := d.DiscountFactorDays(150) / mmy.DiscountFactorDays(150); !almostEq(got, 1, epsilon) {
+		t.Errorf("MoneyMarketYield does not reprice the bill: %f", got)
+	}
+
+	if got := d.BondEquivalentYield(150); !almostEq(got, 29.2/348, epsilon) {
+		t.Errorf("BondEquivalentYield got %f, want %f", got, 29.2/348)
+	}
+
+	// beyond half a year the yield compounds once then accrues simply to maturity
+	long := RateBankDiscount{0.05, 360}
+	y := long.BondEquivalentYield(364)
+	price := long.DiscountFactorDays(364)
+	if got := price * (1 + y/2) * (1 + y*(364.0/365-0.5)); !almostEq(got, 1, 1e-12) {
+		t.Errorf("BondEquivalentYield %f grows the price to %f, want 1", y, got)
+	}
+}
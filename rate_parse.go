@@ -0,0 +1,121 @@
+package gofinance
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// rateKind is the kind of [Rate] named in a rate string.
+type rateKind int
+
+const (
+	kindUnspecified rateKind = iota
+	kindPercentage
+	kindEffective
+	kindContinuous
+)
+
+// rateKindKeywords maps keywords to the kind of rate they name.
+var rateKindKeywords = map[string]rateKind{
+	"apr":        kindPercentage,
+	"nominal":    kindPercentage,
+	"effective":  kindEffective,
+	"eff":        kindEffective,
+	"ear":        kindEffective,
+	"aer":        kindEffective,
+	"apy":        kindEffective,
+	"cont":       kindContinuous,
+	"continuous": kindContinuous,
+	"cc":         kindContinuous,
+}
+
+// rateFrequencyKeywords maps keywords to compounding periods per year.
+var rateFrequencyKeywords = map[string]float64{
+	"annual":       1,
+	"annually":     1,
+	"yearly":       1,
+	"semiannual":   2,
+	"semiannually": 2,
+	"semi-annual":  2,
+	"quarterly":    4,
+	"monthly":      12,
+	"weekly":       52,
+	"daily":        365,
+}
+
+// rateNumber matches the leading number of a rate string and its unit.
+var rateNumber = regexp.MustCompile(`^([+-]?(?:\d+\.?\d*|\.\d+)(?:e[+-]?\d+)?)\s*(%|bps|bp)?`)
+
+// ParseRate parses a human-readable rate specification into a [Rate].
+// The input is case-insensitive and consists of a number followed by
+// optional keywords separated by spaces.
+//
+// The number is a percentage with a percent sign, basis points with bp or
+// bps, or otherwise a decimal:
+//
+//   - 5%, 250bp, 250 bps, 0.05
+//
+// Keywords select the kind of rate:
+//
+//   - apr, nominal for [RateAnnualPercentage]
+//   - effective, eff, ear, aer, apy for [RateEffective]
+//   - cont, continuous, cc for [RateAnnualContinuous]
+//
+// and the compounding frequency, optionally preceded by compounded:
+//
+//   - annual, annually, yearly, semiannual, semiannually, semi-annual,
+//     quarterly, monthly, weekly, daily (365 a year)
+//
+// A frequency without a kind is an annual percentage rate, so
+// "5% monthly" equals "5% APR monthly"; a kind without a frequency is
+// annual, and a bare number is an effective annual rate.
+// With effective the frequency is that of the rate itself,
+// so "0.4% effective monthly" is 0.4% a month.
+// Continuous rates take no frequency. The rate is validated as by
+// [NewRateEffective] and the other constructors; unparsable input
+// returns an [ErrUnsupportedRateFormat].
+func ParseRate(s string) (Rate, error) {
+	input := strings.ToLower(strings.TrimSpace(s))
+	m := rateNumber.FindStringSubmatch(input)
+	if m == nil {
+		return nil, ErrUnsupportedRateFormat{s}
+	}
+	value, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return nil, ErrUnsupportedRateFormat{s}
+	}
+	switch m[2] {
+	case "%":
+		value /= 100
+	case "bp", "bps":
+		value /= 10000
+	}
+
+	kind, periods := kindUnspecified, 0.0
+	for _, word := range strings.Fields(input[len(m[0]):]) {
+		if k, ok := rateKindKeywords[word]; ok && kind == kindUnspecified {
+			kind = k
+		} else if p, ok := rateFrequencyKeywords[word]; ok && periods == 0 {
+			periods = p
+		} else if word != "compounded" {
+			return nil, ErrUnsupportedRateFormat{s}
+		}
+	}
+
+	switch kind {
+	case kindContinuous:
+		if periods != 0 {
+			return nil, ErrUnsupportedRateFormat{s}
+		}
+		return NewRateAnnualContinuous(value)
+	case kindEffective:
+		return NewRateEffective(value, max(periods, 1))
+	case kindPercentage:
+		return NewRateAnnualPercentage(value, max(periods, 1))
+	}
+	if periods != 0 {
+		return NewRateAnnualPercentage(value, periods)
+	}
+	return NewRateEffective(value, 1)
+}
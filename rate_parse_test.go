@@ -0,0 +1,60 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// ParseRate
+// -----------------------------------------------------------------------------
+func TestParseRate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input string
+		want  Rate
+	}{
+		{"5%", RateEffective{0.05, 1}},
+		{"0.05", RateEffective{0.05, 1}},
+		{"5% APR monthly", RateAnnualPercentage{0.05, 12}},
+		{"5% monthly", RateAnnualPercentage{0.05, 12}},
+		{"5 % apr compounded quarterly", RateAnnualPercentage{0.05, 4}},
+		{"5% APR", RateAnnualPercentage{0.05, 1}},
+		{"4.2% cont", RateAnnualContinuous{0.042}},
+		{"  4.2% Continuous ", RateAnnualContinuous{0.042}},
+		{"0.4% effective monthly", RateEffective{0.004, 12}},
+		{"3% AER", RateEffective{0.03, 1}},
+		{"250bp", RateEffective{0.025, 1}},
+		{"25 bps semi-annual", RateAnnualPercentage{0.0025, 2}},
+		{"-0.5% cc", RateAnnualContinuous{-0.005}},
+		{"1e-2 daily", RateAnnualPercentage{0.01, 365}},
+	}
+	for _, c := range cases {
+		got, err := ParseRate(c.input)
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", c.input, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%q: got %#v, want %#v", c.input, got, c.want)
+		}
+	}
+}
+
+func TestParseRate_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		"",
+		"five percent",
+		"5% sometimes",
+		"4% cont monthly",
+		"5% apr cont",
+		"5% monthly quarterly",
+		"%5",
+		"-200%",
+		"-1200% monthly",
+	} {
+		if got, err := ParseRate(input); err == nil {
+			t.Errorf("%q: expected error, got %#v", input, got)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+)
+
+// RateTable is a matrix of rates quoted for the tenors of its columns,
+// Rates[i][j] for Tenors[j] years, e.g. a vendor feed of curves by date.
+// The cells may mix conventions.
+type RateTable struct {
+	Tenors []float64
+	Rates  [][]Rate
+}
+
+// ParseRateTable reads a table of rate strings with [ParseRate], every
+// row holding a cell per tenor. All cells are read; the ones that fail
+// are left nil and reported together in an [ErrRateTable].
+func ParseRateTable(tenors []float64, cells [][]string) (RateTable, error) {
+	t := RateTable{Tenors: tenors, Rates: make([][]Rate, len(cells))}
+	var failed ErrRateTable
+	for i, row := range cells {
+		t.Rates[i] = make([]Rate, len(row))
+		for j, cell := range row {
+			r, err := ParseRate(cell)
+			if err != nil {
+				failed = append(failed, ErrRateCell{i, j, err})
+				continue
+			}
+			t.Rates[i][j] = r
+		}
+	}
+	if err := t.validateShape(); err != nil {
+		return t, err
+	}
+	if failed != nil {
+		return t, failed
+	}
+	return t, nil
+}
+
+// validateShape checks that the tenors are positive and every row has
+// a cell for each of them.
+func (t RateTable) validateShape() error {
+	for _, tenor := range t.Tenors {
+		if !(tenor > 0) || math.IsInf(tenor, 0) {
+			return errors.New("RateTable: Tenors must be positive")
+		}
+	}
+	for _, row := range t.Rates {
+		if len(row) != len(t.Tenors) {
+			return errors.New("RateTable: every row needs a rate per tenor")
+		}
+	}
+	return nil
+}
+
+// Convert returns the table quoted in convention, each cell as the rate
+// of that convention with the same discount factor over its tenor:
+// [RateSimple] on a 365-day year, [RateAnnualContinuous], or
+// [RateAnnualPercentage] with the convention's frequency.
+//
+// Every cell is converted in one step from its own discount factor,
+// never through another quote, so converting twice gives the same table
+// as converting once and the order of conversions does not matter.
+// Cells that are nil or have no finite positive discount factor are
+// left nil and reported together in an [ErrRateTable],
+// the other cells are converted.
+// Math details:
+//
+// Growth = 1 / DiscountFactor(Tenor)
+//
+// Simple = (Growth - 1) / Tenor,   Continuous = ln(Growth) / Tenor,   Periodic = Periods * (Growth^{1 / (Periods * Tenor)} - 1)
+func (t RateTable) Convert(convention RateConvention) (RateTable, error) {
+	if err := t.validateShape(); err != nil {
+		return RateTable{}, err
+	}
+	periods := convention.periodsPerYear()
+	if periods == 0 && convention != ConventionSimple && convention != ConventionContinuous {
+		return RateTable{}, errors.New("RateTable.Convert: unknown convention")
+	}
+	out := RateTable{Tenors: t.Tenors, Rates: make([][]Rate, len(t.Rates))}
+	var failed ErrRateTable
+	for i, row := range t.Rates {
+		out.Rates[i] = make([]Rate, len(row))
+		for j, r := range row {
+			if r == nil {
+				failed = append(failed, ErrRateCell{i, j, errors.New("missing rate")})
+				continue
+			}
+			tenor := t.Tenors[j]
+			df := r.DiscountFactor(tenor)
+			if !(df > 0) || math.IsInf(df, 0) {
+				failed = append(failed, ErrRateCell{i, j, errors.New("discount factor is not finite and positive")})
+				continue
+			}
+			value := ForwardRate(r, 0, tenor, convention)
+			switch convention {
+			case ConventionSimple:
+				out.Rates[i][j] = RateSimple{value, 365}
+			case ConventionContinuous:
+				out.Rates[i][j] = RateAnnualContinuous{value}
+			default:
+				out.Rates[i][j] = RateAnnualPercentage{value, periods}
+			}
+		}
+	}
+	if failed != nil {
+		return out, failed
+	}
+	return out, nil
+}
+
+// Values returns the quoted values of the table, NaN for nil cells and
+// for rate types without a quoted value.
+func (t RateTable) Values() [][]float64 {
+	values := make([][]float64, len(t.Rates))
+	for i, row := range t.Rates {
+		values[i] = make([]float64, len(row))
+		for j, r := range row {
+			values[i][j] = math.NaN()
+			switch r := r.(type) {
+			case RateAnnualPercentage:
+				values[i][j] = r.Value
+			case RateEffective:
+				values[i][j] = r.Value
+			case RateAnnualContinuous:
+				values[i][j] = r.Value
+			case RateSimple:
+				values[i][j] = r.Value
+			case RateBankDiscount:
+				values[i][j] = r.Value
+			case RateReal:
+				values[i][j] = r.Value
+			}
+		}
+	}
+	return values
+}
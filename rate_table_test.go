@@ -0,0 +1,103 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// RateTable
+// -----------------------------------------------------------------------------
+func TestRateTableConvert(t *testing.T) {
+	table := RateTable{
+		Tenors: []float64{0.5, 2},
+		Rates: [][]Rate{
+			{RateAnnualPercentage{0.04, 12}, RateEffective{0.05, 1}},
+			{RateAnnualContinuous{0.03}, RateSimple{0.045, 365}},
+		},
+	}
+	for _, c := range []RateConvention{ConventionSimple, ConventionContinuous, ConventionAnnual, ConventionSemiAnnual, ConventionMonthly} {
+		converted, err := table.Convert(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, row := range converted.Rates {
+			for j, r := range row {
+				tenor := table.Tenors[j]
+				if want := table.Rates[i][j].DiscountFactor(tenor); !almostEq(r.DiscountFactor(tenor), want, epsilon) {
+					t.Errorf("convention %d cell (%d, %d) discount factor got %f, want %f", c, i, j, r.DiscountFactor(tenor), want)
+				}
+			}
+		}
+		// converting again changes nothing
+		again, _ := converted.Convert(c)
+		for i, row := range again.Values() {
+			for j, v := range row {
+				if w := converted.Values()[i][j]; !almostEq(v, w, epsilon) {
+					t.Errorf("convention %d cell (%d, %d) reconverted got %f, want %f", c, i, j, v, w)
+				}
+			}
+		}
+	}
+
+	// going through another convention first ends in the same table
+	direct, _ := table.Convert(ConventionQuarterly)
+	simple, _ := table.Convert(ConventionSimple)
+	indirect, _ := simple.Convert(ConventionQuarterly)
+	for i, row := range direct.Values() {
+		for j, v := range row {
+			if w := indirect.Values()[i][j]; !almostEq(v, w, epsilon) {
+				t.Errorf("cell (%d, %d) via simple got %f, want %f", i, j, w, v)
+			}
+		}
+	}
+	if got, want := direct.Values()[1][0], 4*(math.Exp(0.03/4)-1); !almostEq(got, want, epsilon) {
+		t.Errorf("continuous to quarterly got %f, want %f", got, want)
+	}
+	if got, want := simple.Values()[0][1], (1.05*1.05-1)/2; !almostEq(got, want, epsilon) {
+		t.Errorf("effective to simple got %f, want %f", got, want)
+	}
+}
+
+func TestRateTableFailures(t *testing.T) {
+	table, err := ParseRateTable([]float64{1, 5}, [][]string{
+		{"5% apr monthly", "4.5%"},
+		{"250bp cont", "lots"},
+		{"4%", "3%"},
+	})
+	var failed ErrRateTable
+	if !errors.As(err, &failed) || len(failed) != 1 || failed[0].Row != 1 || failed[0].Column != 1 {
+		t.Fatalf("ParseRateTable got %v, want cell (1, 1) failed", err)
+	}
+	var format ErrUnsupportedRateFormat
+	if !errors.As(err, &format) || format.Input != "lots" {
+		t.Errorf("ParseRateTable got %v, want the cause reachable", err)
+	}
+	if table.Rates[1][1] != nil || table.Rates[1][0] == nil {
+		t.Errorf("ParseRateTable got %v", table.Rates)
+	}
+
+	// the missing cell and a negative discount factor both fail, the rest converts
+	table.Rates[2][1] = RateEffective{-1.5, 1}
+	converted, err := table.Convert(ConventionContinuous)
+	if !errors.As(err, &failed) || len(failed) != 2 || failed[0].Row != 1 || failed[1].Row != 2 || failed[1].Column != 1 {
+		t.Fatalf("Convert got %v, want cells (1, 1) and (2, 1) failed", err)
+	}
+	if converted.Rates[0][0] == nil || converted.Rates[2][0] == nil || converted.Rates[2][1] != nil {
+		t.Errorf("Convert got %v", converted.Rates)
+	}
+	if v := converted.Values(); !math.IsNaN(v[1][1]) || !almostEq(v[1][0], 0.025, epsilon) {
+		t.Errorf("Values got %v", v)
+	}
+
+	if _, err := (RateTable{Tenors: []float64{0}, Rates: [][]Rate{{RateEffective{0.05, 1}}}}).Convert(ConventionAnnual); err == nil {
+		t.Error("Convert accepted a zero tenor")
+	}
+	if _, err := (RateTable{Tenors: []float64{1, 2}, Rates: [][]Rate{{RateEffective{0.05, 1}}}}).Convert(ConventionAnnual); err == nil {
+		t.Error("Convert accepted a short row")
+	}
+	if _, err := table.Convert(RateConvention(99)); err == nil {
+		t.Error("Convert accepted an unknown convention")
+	}
+}
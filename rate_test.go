@@ -223,3 +223,52 @@ func TestRateInterfaceSatisfaction(t *testing.T) {
 		_ Rate = RateAnnualContinuous{Value: 0.01}
 	)
 }
+
+// -----------------------------------------------------------------------------
+// Constructors
+// -----------------------------------------------------------------------------
+func TestRateConstructors(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+
+	if r, err := NewRateAnnualPercentage(0.05, 12); err != nil || r != (RateAnnualPercentage{0.05, 12}) {
+		t.Errorf("NewRateAnnualPercentage got %+v, %v", r, err)
+	}
+	if r, err := NewRateEffective(-0.5, 1); err != nil || r != (RateEffective{-0.5, 1}) {
+		t.Errorf("NewRateEffective got %+v, %v", r, err)
+	}
+	if r, err := NewRateAnnualContinuous(-0.01); err != nil || r != (RateAnnualContinuous{-0.01}) {
+		t.Errorf("NewRateAnnualContinuous got %+v, %v", r, err)
+	}
+
+	invalid := []struct {
+		name    string
+		value   float64
+		periods float64
+	}{
+		{"zero periods", 0.05, 0},
+		{"negative periods", 0.05, -12},
+		{"infinite periods", 0.05, inf},
+		{"NaN periods", 0.05, nan},
+		{"NaN value", nan, 12},
+		{"infinite value", inf, 12},
+	}
+	for _, tt := range invalid {
+		if _, err := NewRateAnnualPercentage(tt.value, tt.periods); err == nil {
+			t.Errorf("NewRateAnnualPercentage %s: expected error", tt.name)
+		}
+		if _, err := NewRateEffective(tt.value, tt.periods); err == nil {
+			t.Errorf("NewRateEffective %s: expected error", tt.name)
+		}
+	}
+	if _, err := NewRateAnnualPercentage(-12, 12); err == nil {
+		t.Error("NewRateAnnualPercentage accepted -100% per period")
+	}
+	if _, err := NewRateEffective(-1, 12); err == nil {
+		t.Error("NewRateEffective accepted -100%")
+	}
+	for _, v := range []float64{nan, inf, -inf} {
+		if _, err := NewRateAnnualContinuous(v); err == nil {
+			t.Errorf("NewRateAnnualContinuous(%f): expected error", v)
+		}
+	}
+}
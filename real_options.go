@@ -0,0 +1,105 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+)
+
+// RealOptionKind is the managerial flexibility valued by a [RealOption].
+type RealOptionKind int
+
+const (
+	// OptionDefer is the right to invest Cost in the project at any time
+	// until expiry, an American call on the project value.
+	OptionDefer RealOptionKind = iota
+	// OptionExpand is the right to scale the project up by Factor,
+	// e.g. 0.3 for 30%, for a further Cost.
+	OptionExpand
+	// OptionAbandon is the right to sell the project for Cost,
+	// its salvage value, an American put on the project value.
+	OptionAbandon
+)
+
+// RealOption treats the present value of a project's cash-flows, Value,
+// as the underlying asset of an option, moving lognormally with annual
+// Volatility on a Cox-Ross-Rubinstein binomial lattice of Steps steps up
+// to expiry in Years. Rate is the risk-free rate and Yield, zero if nil,
+// the value lost to cash-flows paid out while the option is alive, like
+// the dividend yield of [BlackScholesCall].
+type RealOption struct {
+	Kind       RealOptionKind
+	Value      float64
+	Cost       float64
+	Factor     float64
+	Volatility float64
+	Years      float64
+	Steps      int
+	Rate       Rate
+	Yield      Rate
+}
+
+// RealOptionValue splits the strategic NPV of a project with flexibility
+// into its Static NPV, without the option, and the Option premium.
+type RealOptionValue struct {
+	Strategic float64
+	Static    float64
+	Option    float64
+}
+
+// Valuation rolls the lattice back from expiry, exercising wherever that
+// beats keeping the option alive. The static NPV is Value - Cost for
+// [OptionDefer], investing now, and Value for the other kinds.
+// Math details:
+//
+// u = e^{Volatility * \sqrt{dt}},   d = 1 / u,   p = (e^{(r - q) dt} - d) / (u - d)
+//
+// Node(k, j) = max(Exercise(V_{k,j}), e^{-r dt} * (p * Node(k+1, j+1) + (1 - p) * Node(k+1, j)))
+//
+// Exercise: defer max(V - Cost, 0),   expand max(V, (1 + Factor) V - Cost),   abandon max(V, Cost)
+func (o RealOption) Valuation() (RealOptionValue, error) {
+	if o.Value <= 0 || o.Volatility <= 0 || o.Years <= 0 || o.Steps <= 0 {
+		return RealOptionValue{}, errors.New("RealOption requires positive Value, Volatility, Years, and Steps")
+	}
+	if o.Rate == nil {
+		return RealOptionValue{}, errors.New("RealOption requires a Rate")
+	}
+	r, q := o.Rate.RateAnnualContinuous(), 0.0
+	if o.Yield != nil {
+		q = o.Yield.RateAnnualContinuous()
+	}
+	dt := o.Years / float64(o.Steps)
+	u := math.Exp(o.Volatility * math.Sqrt(dt))
+	d := 1 / u
+	p := (math.Exp((r-q)*dt) - d) / (u - d)
+	if p <= 0 || p >= 1 {
+		return RealOptionValue{}, errors.New("RealOption requires more Steps for the rates and volatility")
+	}
+	disc := math.Exp(-r * dt)
+
+	var exercise func(v float64) float64
+	static := o.Value
+	switch o.Kind {
+	case OptionDefer:
+		exercise = func(v float64) float64 { return math.Max(v-o.Cost, 0) }
+		static = o.Value - o.Cost
+	case OptionExpand:
+		exercise = func(v float64) float64 { return math.Max(v, (1+o.Factor)*v-o.Cost) }
+	case OptionAbandon:
+		exercise = func(v float64) float64 { return math.Max(v, o.Cost) }
+	default:
+		return RealOptionValue{}, errors.New("RealOption: unknown kind")
+	}
+
+	// node j of step k has value Value * u^j * d^{k-j}
+	nodes := make([]float64, o.Steps+1)
+	for j := range nodes {
+		nodes[j] = exercise(o.Value * math.Pow(u, float64(2*j-o.Steps)))
+	}
+	for k := o.Steps - 1; k >= 0; k-- {
+		for j := 0; j <= k; j++ {
+			hold := disc * (p*nodes[j+1] + (1-p)*nodes[j])
+			nodes[j] = math.Max(exercise(o.Value*math.Pow(u, float64(2*j-k))), hold)
+		}
+	}
+	return RealOptionValue{nodes[0], static, nodes[0] - static}, nil
+}
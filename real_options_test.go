@@ -0,0 +1,61 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// RealOption.Valuation
+// -----------------------------------------------------------------------------
+func TestRealOption(t *testing.T) {
+	r := RateAnnualContinuous{0.05}
+	noYield := RateAnnualContinuous{0}
+	base := RealOption{Value: 100, Volatility: 0.3, Years: 2, Steps: 500, Rate: r}
+
+	// without payouts deferring is never early, so the European call price applies
+	deferral := base
+	deferral.Kind, deferral.Cost = OptionDefer, 105
+	deferred, err := deferral.Valuation()
+	bs := BlackScholesCall(100, 105, 0.3, r, noYield, 2)
+	if err != nil || !almostEq(deferred.Strategic, bs, 0.05) || !almostEq(deferred.Static, -5, epsilon) ||
+		!almostEq(deferred.Option, deferred.Strategic+5, epsilon) {
+		t.Errorf("defer got %+v, %v, want strategic NPV near %v", deferred, err, bs)
+	}
+
+	// expanding by 30% for 40 is 0.3 calls on the project struck at 40 / 0.3
+	expand := base
+	expand.Kind, expand.Factor, expand.Cost = OptionExpand, 0.3, 40
+	v, _ := expand.Valuation()
+	want := 0.3 * BlackScholesCall(100, 40/0.3, 0.3, r, noYield, 2)
+	if !almostEq(v.Static, 100, epsilon) || !almostEq(v.Option, want, 0.05) {
+		t.Errorf("expand got %+v, want option near %v", v, want)
+	}
+
+	// abandoning is an American put, worth at least the European one
+	abandon := base
+	abandon.Kind, abandon.Cost = OptionAbandon, 90
+	v, _ = abandon.Valuation()
+	european := BlackScholesPut(100, 90, 0.3, r, noYield, 2)
+	if v.Option < european || v.Option > european+1 {
+		t.Errorf("abandon got %+v, want option just above %v", v, european)
+	}
+
+	// cash-flows paid out make waiting costlier and the deferral option cheaper
+	deferral.Yield = RateAnnualContinuous{0.06}
+	leaking, _ := deferral.Valuation()
+	if leaking.Option >= deferred.Option {
+		t.Errorf("defer with yield got %+v, want below %+v", leaking, deferred)
+	}
+	if eu := BlackScholesCall(100, 105, 0.3, r, deferral.Yield, 2); leaking.Strategic < eu-0.05 {
+		t.Errorf("American deferral %v below European %v", leaking.Strategic, eu)
+	}
+
+	for _, bad := range []RealOption{
+		{Value: 100, Volatility: 0.3, Years: 2, Steps: 10},
+		{Value: 0, Volatility: 0.3, Years: 2, Steps: 10, Rate: r},
+		{Kind: RealOptionKind(7), Value: 100, Volatility: 0.3, Years: 2, Steps: 10, Rate: r},
+		{Value: 100, Volatility: 0.01, Years: 10, Steps: 1, Rate: RateAnnualContinuous{0.5}},
+	} {
+		if _, err := bad.Valuation(); err == nil {
+			t.Errorf("Valuation(%+v): expected error", bad)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package gofinance
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// ReportFormat selects how [CashFlows.Report] renders.
+type ReportFormat int
+
+const (
+	// ReportText is a plain text table with aligned columns, for terminals.
+	ReportText ReportFormat = iota
+	// ReportMarkdown is a Markdown table.
+	ReportMarkdown
+	// ReportCSV is comma-separated values with a header row and numbers
+	// at full precision, for spreadsheets and audit trails.
+	ReportCSV
+)
+
+// reportHeader names the columns of a cash-flow report.
+var reportHeader = []string{"Date", "Years", "Cash Flow", "Discount Factor", "Present Value", "Cumulative PV"}
+
+// reportRow is a row of a cash-flow report: a cash-flow, or a subtotal
+// when label is set, with the fields that apply to it.
+type reportRow struct {
+	label      string
+	date       time.Time
+	years      float64
+	value      float64
+	df         float64
+	pv         float64
+	cumulative float64
+}
+
+// reportRows returns the cash-flows in date order discounted on the curve,
+// each calendar year followed by its subtotal and the whole by the total.
+func (cfs CashFlows) reportRows(curve YieldCurve, valuationDate time.Time) []reportRow {
+	var rows []reportRow
+	cumulative := 0.0
+	yearValue, yearPV, totalValue := 0.0, 0.0, 0.0
+	ordered := cfs.SortedCopy()
+	for i, cf := range ordered {
+		years := cf.YearsFrom(valuationDate)
+		df := curve.DiscountFactor(years)
+		pv := cf.Value * df
+		cumulative += pv
+		yearValue += cf.Value
+		yearPV += pv
+		totalValue += cf.Value
+		rows = append(rows, reportRow{date: cf.Date, years: years, value: cf.Value, df: df, pv: pv, cumulative: cumulative})
+		if i == len(ordered)-1 || ordered[i+1].Date.Year() != cf.Date.Year() {
+			rows = append(rows, reportRow{label: fmt.Sprintf("%d subtotal", cf.Date.Year()), value: yearValue, pv: yearPV, cumulative: cumulative})
+			yearValue, yearPV = 0, 0
+		}
+	}
+	return append(rows, reportRow{label: "Total", value: totalValue, pv: cumulative, cumulative: cumulative})
+}
+
+// cells formats the row, amounts to places decimals or at full precision
+// when places is negative.
+func (r reportRow) cells(places int) []string {
+	num := func(x float64, p int) string {
+		if places < 0 {
+			return strconv.FormatFloat(x, 'f', -1, 64)
+		}
+		return strconv.FormatFloat(x, 'f', p, 64)
+	}
+	if r.label != "" {
+		return []string{r.label, "", num(r.value, places), "", num(r.pv, places), num(r.cumulative, places)}
+	}
+	return []string{r.date.Format(time.DateOnly), num(r.years, 4), num(r.value, places), num(r.df, 6), num(r.pv, places), num(r.cumulative, places)}
+}
+
+// Report writes the cash-flows in date order to w as a table in format:
+// for each cash-flow its date, the years from valuationDate, its value,
+// the discount factor of the curve, its present value, and the running
+// total of present values, with a subtotal after each calendar year and
+// a total at the end. Text and Markdown round amounts to 2 decimals,
+// CSV keeps full precision. A [Rate] serves as a flat curve.
+func (cfs CashFlows) Report(w io.Writer, curve YieldCurve, valuationDate time.Time, format ReportFormat) error {
+	rows := cfs.reportRows(curve, valuationDate)
+	switch format {
+	case ReportText:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintln(tw, strings.Join(reportHeader, "\t")+"\t")
+		for _, r := range rows {
+			fmt.Fprintln(tw, strings.Join(r.cells(2), "\t")+"\t")
+		}
+		return tw.Flush()
+	case ReportMarkdown:
+		var b strings.Builder
+		b.WriteString("| " + strings.Join(reportHeader, " | ") + " |\n")
+		b.WriteString("|---" + strings.Repeat("|--:", len(reportHeader)-1) + "|\n")
+		for _, r := range rows {
+			cells := r.cells(2)
+			if r.label != "" {
+				for i, c := range cells {
+					if c != "" {
+						cells[i] = "**" + c + "**"
+					}
+				}
+			}
+			b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+		}
+		_, err := io.WriteString(w, b.String())
+		return err
+	case ReportCSV:
+		cw := csv.NewWriter(w)
+		cw.Write(reportHeader)
+		for _, r := range rows {
+			cw.Write(r.cells(-1))
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+	return errors.New("CashFlows.Report: unknown format")
+}
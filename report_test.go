@@ -0,0 +1,62 @@
+package gofinance
+
+import (
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Cash-flow reports
+// -----------------------------------------------------------------------------
+func TestCashFlowsReport(t *testing.T) {
+	cfs := CashFlows{{110, anchor.AddDate(1, 6, 0)}, {-100, anchor}, {10, anchor.AddDate(0, 6, 0)}, {10, anchor.AddDate(1, 0, 0)}}
+	r := RateEffective{0.05, 1}
+
+	var md strings.Builder
+	if err := cfs.Report(&md, r, anchor, ReportMarkdown); err != nil {
+		t.Fatal(err)
+	}
+	want := `| Date | Years | Cash Flow | Discount Factor | Present Value | Cumulative PV |
+|---|--:|--:|--:|--:|--:|
+| 2020-01-01 | 0.0000 | -100.00 | 1.000000 | -100.00 | -100.00 |
+| 2020-07-01 | 0.4973 | 10.00 | 0.976030 | 9.76 | -90.24 |
+| **2020 subtotal** |  | **-90.00** |  | **-90.24** | **-90.24** |
+| 2021-01-01 | 1.0000 | 10.00 | 0.952381 | 9.52 | -80.72 |
+| 2021-07-01 | 1.4959 | 110.00 | 0.929615 | 102.26 | 21.54 |
+| **2021 subtotal** |  | **120.00** |  | **111.78** | **21.54** |
+| **Total** |  | **30.00** |  | **21.54** | **21.54** |
+`
+	if md.String() != want {
+		t.Errorf("Markdown report got\n%s\nwant\n%s", md.String(), want)
+	}
+
+	var text strings.Builder
+	cfs.Report(&text, r, anchor, ReportText)
+	lines := strings.Split(strings.TrimRight(text.String(), "\n"), "\n")
+	if len(lines) != 8 || !strings.HasSuffix(lines[7], "21.54") || !strings.Contains(lines[3], "2020 subtotal") {
+		t.Errorf("text report got\n%s", text.String())
+	}
+	for _, l := range lines[1:] {
+		if len(l) != len(lines[0]) {
+			t.Errorf("text report columns not aligned:\n%s", text.String())
+			break
+		}
+	}
+
+	// CSV keeps full precision: the total is the NPV
+	var out strings.Builder
+	cfs.Report(&out, r, anchor, ReportCSV)
+	records, err := csv.NewReader(strings.NewReader(out.String())).ReadAll()
+	if err != nil || len(records) != 8 || records[0][0] != "Date" || records[7][0] != "Total" {
+		t.Fatalf("CSV report got %v, %v", records, err)
+	}
+	if total, _ := strconv.ParseFloat(records[7][4], 64); !almostEq(total, cfs.NPVCurve(r, anchor), epsilon) {
+		t.Errorf("CSV total got %v, want %v", total, cfs.NPVCurve(r, anchor))
+	}
+
+	if err := cfs.Report(&out, r, anchor, ReportFormat(9)); err == nil {
+		t.Error("Report accepted an unknown format")
+	}
+}
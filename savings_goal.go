@@ -0,0 +1,151 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// SavingsGoal is a savings plan: a Present balance plus a Contribution
+// paid PeriodsPerYear times a year for Years, growing at Rate towards the
+// Target. Contributions are paid at the end of each period, or at the
+// start when Due is true (an annuity due).
+//
+// Each of the Required methods is a goal seek: it solves for one input,
+// ignoring its current value, so that the plan reaches the Target exactly.
+type SavingsGoal struct {
+	Target         float64
+	Present        float64
+	Contribution   float64
+	PeriodsPerYear float64
+	Years          float64
+	Rate           Rate
+	Due            bool
+}
+
+// growth returns the compound factor over years at the continuous rate c
+// and the future value of a contribution of 1 per period, the annuity
+// factor, using expm1 so that rates near zero keep their precision.
+// Math details:
+//
+// Growth = e^{c * Years},   i = e^{c / PeriodsPerYear} - 1
+//
+// Annuity = (Growth - 1) / i * (1 + i if Due),   Annuity = PeriodsPerYear * Years if i = 0
+func (g SavingsGoal) growth(c, years float64) (growth, annuity float64) {
+	growth = math.Exp(c * years)
+	i := math.Expm1(c / g.PeriodsPerYear)
+	if i == 0 {
+		return growth, g.PeriodsPerYear * years
+	}
+	annuity = math.Expm1(c*years) / i
+	if g.Due {
+		annuity *= 1 + i
+	}
+	return growth, annuity
+}
+
+// validate checks the inputs every goal seek needs.
+func (g SavingsGoal) validate() error {
+	switch {
+	case g.Rate == nil:
+		return errors.New("SavingsGoal: Rate is nil")
+	case !validPeriodsPerYear(g.PeriodsPerYear):
+		return errors.New("SavingsGoal: PeriodsPerYear must be positive")
+	}
+	return nil
+}
+
+// FutureValue returns the balance of the plan after Years.
+// Math details:
+//
+// FutureValue = Present * Growth + Contribution * Annuity
+func (g SavingsGoal) FutureValue() (float64, error) {
+	if err := g.validate(); err != nil {
+		return 0, err
+	}
+	growth, annuity := g.growth(g.Rate.RateAnnualContinuous(), g.Years)
+	return g.Present*growth + g.Contribution*annuity, nil
+}
+
+// RequiredContribution returns the contribution per period that reaches
+// the Target after Years, negative when the Present balance alone
+// overshoots it and withdrawals are possible.
+// Math details:
+//
+// Contribution = (Target - Present * Growth) / Annuity
+func (g SavingsGoal) RequiredContribution() (float64, error) {
+	if err := g.validate(); err != nil {
+		return 0, err
+	}
+	if g.Years <= 0 {
+		return 0, errors.New("SavingsGoal.RequiredContribution: Years must be positive")
+	}
+	growth, annuity := g.growth(g.Rate.RateAnnualContinuous(), g.Years)
+	return (g.Target - g.Present*growth) / annuity, nil
+}
+
+// RequiredYears returns the horizon that reaches the Target, zero if the
+// Present balance already does. The horizon is not rounded to whole
+// periods, round it up for the first contribution date at or past the
+// Target.
+// Math details:
+//
+// Growth = (Target + Contribution * k / i) / (Present + Contribution * k / i),   k = 1 + i if Due, 1 otherwise
+//
+// Years = ln(Growth) / ln(1 + i) / PeriodsPerYear,   Years = (Target - Present) / Contribution / PeriodsPerYear if i = 0
+func (g SavingsGoal) RequiredYears() (float64, error) {
+	if err := g.validate(); err != nil {
+		return 0, err
+	}
+	if g.Present >= g.Target {
+		return 0, nil
+	}
+	i := math.Expm1(g.Rate.RateAnnualContinuous() / g.PeriodsPerYear)
+	var periods float64
+	if i == 0 {
+		periods = (g.Target - g.Present) / g.Contribution
+	} else {
+		k := 1.0
+		if g.Due {
+			k += i
+		}
+		c := g.Contribution * k / i
+		periods = math.Log((g.Target+c)/(g.Present+c)) / math.Log1p(i)
+	}
+	if !(periods >= 0) || math.IsInf(periods, 0) {
+		return 0, errors.New("SavingsGoal.RequiredYears: Target is never reached")
+	}
+	return periods / g.PeriodsPerYear, nil
+}
+
+// RequiredRate returns the annual effective rate that reaches the Target
+// after Years, ignoring Rate. The future value rises with the rate as long
+// as the balance and contributions are not negative, so the continuous
+// rate is bracketed between that of -99% and a growing upper bound and
+// found with Brent's method.
+func (g SavingsGoal) RequiredRate(opts ...SolverOptions) (RateEffective, error) {
+	switch {
+	case !validPeriodsPerYear(g.PeriodsPerYear):
+		return RateEffective{}, errors.New("SavingsGoal: PeriodsPerYear must be positive")
+	case g.Years <= 0:
+		return RateEffective{}, errors.New("SavingsGoal.RequiredRate: Years must be positive")
+	case g.Present < 0 || g.Contribution < 0 || g.Present+g.Contribution == 0:
+		return RateEffective{}, errors.New("SavingsGoal.RequiredRate: requires a positive balance or contributions")
+	}
+	excess := func(c float64) float64 {
+		growth, annuity := g.growth(c, g.Years)
+		return g.Present*growth + g.Contribution*annuity - g.Target
+	}
+	lo, hi := math.Log(0.01), 1.0
+	for excess(hi) < 0 {
+		if hi > 100 {
+			return RateEffective{}, errors.New("SavingsGoal.RequiredRate: Target is never reached")
+		}
+		hi *= 2
+	}
+	root, err := brent(excess, lo, hi, solverOptions(opts))
+	if err != nil {
+		return RateEffective{}, fmt.Errorf("SavingsGoal.RequiredRate: %w", err)
+	}
+	return RateEffective{math.Expm1(root), 1}, nil
+}
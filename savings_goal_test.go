@@ -0,0 +1,99 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Future value
+// -----------------------------------------------------------------------------
+func TestSavingsGoalFutureValue(t *testing.T) {
+	// 1% a month for a year, 100 a month on top of 1000
+	annuity := (math.Pow(1.01, 12) - 1) / 0.01
+	tests := []struct {
+		name string
+		goal SavingsGoal
+		want float64
+	}{
+		{"ordinary", SavingsGoal{Present: 1000, Contribution: 100, PeriodsPerYear: 12, Years: 1, Rate: RateAnnualPercentage{0.12, 12}}, 1000*math.Pow(1.01, 12) + 100*annuity},
+		{"due", SavingsGoal{Present: 1000, Contribution: 100, PeriodsPerYear: 12, Years: 1, Rate: RateAnnualPercentage{0.12, 12}, Due: true}, 1000*math.Pow(1.01, 12) + 100*annuity*1.01},
+		{"zero rate", SavingsGoal{Present: 1000, Contribution: 100, PeriodsPerYear: 12, Years: 2, Rate: RateEffective{0, 1}}, 1000 + 2400},
+	}
+	for _, tt := range tests {
+		got, err := tt.goal.FutureValue()
+		if err != nil || !almostEq(got, tt.want, 1e-9) {
+			t.Errorf("%s: FutureValue got %f, %v, want %f", tt.name, got, err, tt.want)
+		}
+	}
+	if _, err := (SavingsGoal{PeriodsPerYear: 12}).FutureValue(); err == nil {
+		t.Error("FutureValue accepted a nil Rate")
+	}
+	if _, err := (SavingsGoal{Rate: RateEffective{0.05, 1}}).FutureValue(); err == nil {
+		t.Error("FutureValue accepted zero PeriodsPerYear")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Goal seek
+// -----------------------------------------------------------------------------
+func TestSavingsGoalRequired(t *testing.T) {
+	goals := []SavingsGoal{
+		{Target: 100000, Present: 5000, Contribution: 500, PeriodsPerYear: 12, Years: 10, Rate: RateAnnualPercentage{0.06, 12}},
+		{Target: 100000, Present: 5000, Contribution: 500, PeriodsPerYear: 12, Years: 10, Rate: RateAnnualContinuous{0.05}, Due: true},
+		{Target: 50000, Present: 0, Contribution: 3000, PeriodsPerYear: 4, Years: 3.5, Rate: RateEffective{0.04, 1}},
+		{Target: 50000, Present: 2000, Contribution: 1000, PeriodsPerYear: 12, Years: 4, Rate: RateEffective{0, 1}},
+	}
+	for i, g := range goals {
+		// each goal seek must reproduce the plan's own future value
+		fv, _ := g.FutureValue()
+		g.Target = fv
+
+		c := g
+		c.Contribution = 0
+		got, err := c.RequiredContribution()
+		if err != nil || !almostEq(got, g.Contribution, 1e-9) {
+			t.Errorf("goal %d: RequiredContribution got %f, %v, want %f", i, got, err, g.Contribution)
+		}
+
+		y := g
+		y.Years = 0
+		years, err := y.RequiredYears()
+		if err != nil || !almostEq(years, g.Years, 1e-9) {
+			t.Errorf("goal %d: RequiredYears got %f, %v, want %f", i, years, err, g.Years)
+		}
+
+		r, err := g.RequiredRate()
+		if err != nil || !almostEq(r.RateAnnualEffective(), g.Rate.RateAnnualEffective(), 1e-9) {
+			t.Errorf("goal %d: RequiredRate got %g, %v, want %g", i, r.RateAnnualEffective(), err, g.Rate.RateAnnualEffective())
+		}
+	}
+}
+
+func TestSavingsGoalEdgeCases(t *testing.T) {
+	g := SavingsGoal{Target: 1000, Present: 2000, PeriodsPerYear: 12, Years: 5, Rate: RateEffective{0.05, 1}}
+	if years, err := g.RequiredYears(); err != nil || years != 0 {
+		t.Errorf("RequiredYears got %f, %v, want 0 once the target is met", years, err)
+	}
+	if c, _ := g.RequiredContribution(); c >= 0 {
+		t.Errorf("RequiredContribution got %f, want a withdrawal", c)
+	}
+
+	// without contributions a shrinking balance never grows
+	g = SavingsGoal{Target: 1000, Present: 500, PeriodsPerYear: 12, Rate: RateEffective{-0.02, 1}}
+	if _, err := g.RequiredYears(); err == nil {
+		t.Error("RequiredYears accepted an unreachable target")
+	}
+	if _, err := (SavingsGoal{Target: 1000, PeriodsPerYear: 12, Rate: RateEffective{0.05, 1}}).RequiredContribution(); err == nil {
+		t.Error("RequiredContribution accepted zero Years")
+	}
+	if _, err := (SavingsGoal{Target: 1000, PeriodsPerYear: 12, Years: 5}).RequiredRate(); err == nil {
+		t.Error("RequiredRate accepted no balance and no contributions")
+	}
+	// even -99% a year leaves more than the target
+	g = SavingsGoal{Target: 1, Present: 1000, PeriodsPerYear: 1, Years: 1}
+	if _, err := g.RequiredRate(); !errors.Is(err, ErrNoRootBracketed) {
+		t.Errorf("RequiredRate got %v, want ErrNoRootBracketed", err)
+	}
+}
@@ -0,0 +1,91 @@
+package gofinance
+
+import (
+	"errors"
+	"time"
+)
+
+// addMonths shifts t by n months, clamping the day to the end of the target
+// month, so that 2024-01-31 plus one month is 2024-02-29 and not 2024-03-02
+// as with [time.Time.AddDate].
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(d, last)-1)
+}
+
+// StubType places the irregular period of a [ScheduleStub] when the
+// term is not a whole number of periods.
+type StubType int
+
+const (
+	// StubShortFirst rolls dates backward from end, a short first period.
+	StubShortFirst StubType = iota
+	// StubLongFirst merges the short first period into the next one.
+	StubLongFirst
+	// StubShortLast rolls dates forward from start, a short last period.
+	StubShortLast
+	// StubLongLast merges the short last period into the one before.
+	StubLongLast
+)
+
+// Schedule returns the regular payment dates of an instrument running from
+// start to end with periodsPerYear payments a year.
+// Dates are rolled backward from end in steps of 12 / periodsPerYear months,
+// so an irregular period, if any, is the first one.
+// start itself is not a payment date and is not returned, end always is.
+//
+// periodsPerYear must be one of 1, 2, 3, 4, 6, 12.
+// No business-day adjustment is applied.
+func Schedule(start, end time.Time, periodsPerYear int) ([]time.Time, error) {
+	return ScheduleStub(start, end, periodsPerYear, StubShortFirst)
+}
+
+// ScheduleStub is [Schedule] with the irregular period placed by stub:
+// dates roll backward from end for a first stub and forward from start
+// for a last stub, a long stub spanning the short one and its regular
+// neighbour. A whole number of periods has no stub whatever the type.
+func ScheduleStub(start, end time.Time, periodsPerYear int, stub StubType) ([]time.Time, error) {
+	if periodsPerYear <= 0 || 12%periodsPerYear != 0 {
+		return nil, errors.New("Schedule requires periodsPerYear to divide 12")
+	}
+	if !end.After(start) {
+		return nil, errors.New("Schedule requires end after start")
+	}
+	step := 12 / periodsPerYear
+
+	switch stub {
+	case StubShortFirst, StubLongFirst:
+		var reversed []time.Time
+		for k := 0; ; k++ {
+			d := addMonths(end, -k*step)
+			if !d.After(start) {
+				break
+			}
+			reversed = append(reversed, d)
+		}
+		if n := len(reversed); stub == StubLongFirst && n > 1 && !addMonths(end, -n*step).Equal(start) {
+			reversed = reversed[:n-1]
+		}
+		dates := make([]time.Time, len(reversed))
+		for i, d := range reversed {
+			dates[len(reversed)-1-i] = d
+		}
+		return dates, nil
+	case StubShortLast, StubLongLast:
+		var dates []time.Time
+		for k := 1; ; k++ {
+			d := addMonths(start, k*step)
+			if !d.Before(end) {
+				break
+			}
+			dates = append(dates, d)
+		}
+		if n := len(dates); stub == StubLongLast && n > 0 && !addMonths(start, (n+1)*step).Equal(end) {
+			dates = dates[:n-1]
+		}
+		return append(dates, end), nil
+	}
+	return nil, errors.New("Schedule requires a known StubType")
+}
@@ -0,0 +1,100 @@
+package gofinance
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// addMonths
+// -----------------------------------------------------------------------------
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		in   time.Time
+		n    int
+		want time.Time
+	}{
+		{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), 1, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC), 1, time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC), -6, time.Date(2023, 9, 30, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC), 12, time.Date(2025, 5, 15, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range tests {
+		if got := addMonths(tc.in, tc.n); !got.Equal(tc.want) {
+			t.Errorf("addMonths(%v, %d) got %v, want %v", tc.in, tc.n, got, tc.want)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Schedule
+// -----------------------------------------------------------------------------
+func TestSchedule(t *testing.T) {
+	start := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	// regular: two years semi-annual
+	got, err := Schedule(start, start.AddDate(2, 0, 0), 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 4 || !got[0].Equal(time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)) ||
+		!got[3].Equal(time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("semi-annual schedule incorrect: %v", got)
+	}
+
+	// irregular: short first period
+	got, err = Schedule(start, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 || !got[0].Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("stub schedule incorrect: %v", got)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// ScheduleStub
+// -----------------------------------------------------------------------------
+func TestScheduleStub(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	start, end := date(2024, 1, 15), date(2025, 4, 15) // two and a half semi-annual periods
+	tests := []struct {
+		stub StubType
+		want []time.Time
+	}{
+		{StubShortFirst, []time.Time{date(2024, 4, 15), date(2024, 10, 15), end}},
+		{StubLongFirst, []time.Time{date(2024, 10, 15), end}},
+		{StubShortLast, []time.Time{date(2024, 7, 15), date(2025, 1, 15), end}},
+		{StubLongLast, []time.Time{date(2024, 7, 15), end}},
+	}
+	for _, tc := range tests {
+		got, err := ScheduleStub(start, end, 2, tc.stub)
+		if err != nil || !slices.EqualFunc(got, tc.want, time.Time.Equal) {
+			t.Errorf("stub %d got %v, %v, want %v", tc.stub, got, err, tc.want)
+		}
+		// a whole number of periods has no stub
+		regular, _ := ScheduleStub(start, date(2025, 7, 15), 2, tc.stub)
+		if len(regular) != 3 || !regular[0].Equal(date(2024, 7, 15)) {
+			t.Errorf("stub %d on a regular term got %v", tc.stub, regular)
+		}
+	}
+
+	// a term shorter than one period is a single stub
+	if got, _ := ScheduleStub(start, date(2024, 3, 1), 2, StubLongFirst); len(got) != 1 || !got[0].Equal(date(2024, 3, 1)) {
+		t.Errorf("single stub got %v", got)
+	}
+	if _, err := ScheduleStub(start, end, 2, StubType(9)); err == nil {
+		t.Error("unknown stub type: expected error")
+	}
+}
+
+func TestScheduleErrors(t *testing.T) {
+	start := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	if _, err := Schedule(start, start.AddDate(1, 0, 0), 5); err == nil {
+		t.Error("expected error for 5 periods per year, got nil")
+	}
+	if _, err := Schedule(start, start, 4); err == nil {
+		t.Error("expected error for end not after start, got nil")
+	}
+}
@@ -0,0 +1,146 @@
+package gofinance
+
+import "errors"
+
+// ZScoreModel selects the variant of Altman's Z-score and its coefficients.
+type ZScoreModel int
+
+const (
+	// ZScorePublic is the original 1968 Z-score for public manufacturers,
+	// using the market value of equity.
+	ZScorePublic ZScoreModel = iota
+	// ZScorePrivate is the Z'-score for private firms, re-estimated
+	// with the book value of equity.
+	ZScorePrivate
+	// ZScoreNonManufacturing is the Z''-score for non-manufacturers and
+	// emerging markets, which drops asset turnover to be less sensitive
+	// to industry.
+	ZScoreNonManufacturing
+)
+
+// CreditZone classifies a Z-score against the published cut-offs.
+type CreditZone int
+
+const (
+	ZoneDistress CreditZone = iota
+	ZoneGrey
+	ZoneSafe
+)
+
+// ZScore is a computed Altman Z-score and its zone.
+type ZScore struct {
+	Score float64
+	Zone  CreditZone
+}
+
+// AltmanZ returns the Altman Z-score of the statements under model.
+// marketEquity is the market value of equity, used only by [ZScorePublic],
+// the other models use the book Equity.
+// Math details:
+//
+// X1 = (CurrentAssets - CurrentLiabilities) / TotalAssets,   X2 = RetainedEarnings / TotalAssets,   X3 = EBIT / TotalAssets
+//
+// X4 = Equity / TotalLiabilities,   X5 = Revenue / TotalAssets
+//
+// Z = 1.2 X1 + 1.4 X2 + 3.3 X3 + 0.6 X4 + 1.0 X5,   distress < 1.81, safe > 2.99
+//
+// Z' = 0.717 X1 + 0.847 X2 + 3.107 X3 + 0.420 X4 + 0.998 X5,   distress < 1.23, safe > 2.90
+//
+// Z″ = 6.56 X1 + 3.26 X2 + 6.72 X3 + 1.05 X4,   distress < 1.10, safe > 2.60
+func AltmanZ(s FinancialStatements, marketEquity float64, model ZScoreModel) (ZScore, error) {
+	bs, is := s.Balance, s.Income
+	if bs.TotalAssets <= 0 || bs.TotalLiabilities <= 0 {
+		return ZScore{}, errors.New("AltmanZ requires positive total assets and liabilities")
+	}
+	x1 := (bs.CurrentAssets - bs.CurrentLiabilities) / bs.TotalAssets
+	x2 := bs.RetainedEarnings / bs.TotalAssets
+	x3 := is.EBIT / bs.TotalAssets
+	x4 := bs.Equity / bs.TotalLiabilities
+	x5 := is.Revenue / bs.TotalAssets
+
+	var z, distress, safe float64
+	switch model {
+	case ZScorePublic:
+		if marketEquity <= 0 {
+			return ZScore{}, errors.New("AltmanZ requires a positive market value of equity")
+		}
+		x4 = marketEquity / bs.TotalLiabilities
+		z = 1.2*x1 + 1.4*x2 + 3.3*x3 + 0.6*x4 + 1.0*x5
+		distress, safe = 1.81, 2.99
+	case ZScorePrivate:
+		z = 0.717*x1 + 0.847*x2 + 3.107*x3 + 0.420*x4 + 0.998*x5
+		distress, safe = 1.23, 2.90
+	case ZScoreNonManufacturing:
+		z = 6.56*x1 + 3.26*x2 + 6.72*x3 + 1.05*x4
+		distress, safe = 1.10, 2.60
+	default:
+		return ZScore{}, errors.New("AltmanZ: unknown model")
+	}
+
+	zone := ZoneGrey
+	if z < distress {
+		zone = ZoneDistress
+	} else if z > safe {
+		zone = ZoneSafe
+	}
+	return ZScore{z, zone}, nil
+}
+
+// FScore is Piotroski's F-score: Score counts the nine signals that hold,
+// 8 or 9 marking a financially strong firm and 0 to 2 a weak one.
+type FScore struct {
+	Score int
+
+	// profitability
+	PositiveROA      bool
+	PositiveCashFlow bool
+	ImprovingROA     bool
+	CashAboveIncome  bool
+
+	// leverage, liquidity, and source of funds
+	LowerLeverage      bool
+	ImprovingLiquidity bool
+	NoDilution         bool
+
+	// operating efficiency
+	ImprovingMargin   bool
+	ImprovingTurnover bool
+}
+
+// PiotroskiF returns the F-score of the current period against the prior one.
+// Return on assets, leverage, and asset turnover are measured on period-end
+// total assets, as in [FinancialStatements.Ratios].
+// Math details:
+//
+// ROA > 0,   OperatingCashFlow > 0,   ΔROA > 0,   OperatingCashFlow > NetIncome
+//
+// Δ(LongTermDebt / TotalAssets) < 0,   ΔCurrentRatio > 0,   ΔSharesOutstanding <= 0
+//
+// ΔGrossMargin > 0,   ΔAssetTurnover > 0
+func PiotroskiF(current, prior FinancialStatements) FScore {
+	now, before := current.Ratios(), prior.Ratios()
+	cb, pb := current.Balance, prior.Balance
+	f := FScore{
+		PositiveROA:      now.ReturnOnAssets > 0,
+		PositiveCashFlow: current.CashFlow.OperatingCashFlow > 0,
+		ImprovingROA:     now.ReturnOnAssets > before.ReturnOnAssets,
+		CashAboveIncome:  current.CashFlow.OperatingCashFlow > current.Income.NetIncome,
+
+		LowerLeverage:      cb.LongTermDebt/cb.TotalAssets < pb.LongTermDebt/pb.TotalAssets,
+		ImprovingLiquidity: now.CurrentRatio > before.CurrentRatio,
+		NoDilution:         cb.SharesOutstanding <= pb.SharesOutstanding,
+
+		ImprovingMargin:   now.GrossMargin > before.GrossMargin,
+		ImprovingTurnover: now.AssetTurnover > before.AssetTurnover,
+	}
+	for _, signal := range []bool{
+		f.PositiveROA, f.PositiveCashFlow, f.ImprovingROA, f.CashAboveIncome,
+		f.LowerLeverage, f.ImprovingLiquidity, f.NoDilution,
+		f.ImprovingMargin, f.ImprovingTurnover,
+	} {
+		if signal {
+			f.Score++
+		}
+	}
+	return f
+}
@@ -0,0 +1,69 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// AltmanZ
+// -----------------------------------------------------------------------------
+func TestAltmanZ(t *testing.T) {
+	s := testStatements()[0]
+	// X1 = 0.2, X2 = 0.25, X3 = 0.15, X5 = 1, book X4 = 400 / 600
+	tests := []struct {
+		name  string
+		model ZScoreModel
+		want  float64
+		zone  CreditZone
+	}{
+		{"Z", ZScorePublic, 1.2*0.2 + 1.4*0.25 + 3.3*0.15 + 0.6*1.5 + 1.0, ZoneGrey},
+		{"Z'", ZScorePrivate, 0.717*0.2 + 0.847*0.25 + 3.107*0.15 + 0.420*400/600 + 0.998, ZoneGrey},
+		{"Z''", ZScoreNonManufacturing, 6.56*0.2 + 3.26*0.25 + 6.72*0.15 + 1.05*400/600, ZoneSafe},
+	}
+	for _, tc := range tests {
+		z, err := AltmanZ(s, 900, tc.model)
+		if err != nil || !almostEq(z.Score, tc.want, epsilon) || z.Zone != tc.zone {
+			t.Errorf("%s got %+v, %v, want %v in zone %v", tc.name, z, err, tc.want, tc.zone)
+		}
+	}
+
+	// a firm burning through its equity is in distress
+	weak := s
+	weak.Balance.RetainedEarnings = -300
+	weak.Income.EBIT = -50
+	if z, _ := AltmanZ(weak, 200, ZScorePublic); z.Zone != ZoneDistress {
+		t.Errorf("weak firm got %+v, want distress", z)
+	}
+
+	if _, err := AltmanZ(s, 0, ZScorePublic); err == nil {
+		t.Error("public Z without market equity: expected error")
+	}
+	if _, err := AltmanZ(FinancialStatements{}, 900, ZScorePrivate); err == nil {
+		t.Error("empty balance sheet: expected error")
+	}
+	if _, err := AltmanZ(s, 900, ZScoreModel(9)); err == nil {
+		t.Error("unknown model: expected error")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// PiotroskiF
+// -----------------------------------------------------------------------------
+func TestPiotroskiF(t *testing.T) {
+	periods := testStatements()
+
+	// every signal improves from the first year to the second
+	if f := PiotroskiF(periods[1], periods[0]); f.Score != 9 {
+		t.Errorf("improving firm got %+v, want 9", f)
+	}
+
+	// the reverse keeps only the level signals and the unchanged share count
+	f := PiotroskiF(periods[0], periods[1])
+	if f.Score != 4 || !f.PositiveROA || !f.PositiveCashFlow || !f.CashAboveIncome || !f.NoDilution {
+		t.Errorf("deteriorating firm got %+v, want 4", f)
+	}
+
+	diluted := periods[1]
+	diluted.Balance.SharesOutstanding = 120
+	if f := PiotroskiF(diluted, periods[0]); f.Score != 8 || f.NoDilution {
+		t.Errorf("diluted firm got %+v, want 8", f)
+	}
+}
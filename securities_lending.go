@@ -0,0 +1,123 @@
+package gofinance
+
+import (
+	"errors"
+	"time"
+)
+
+// SecuritiesLoan is a loan of Quantity securities from Start to the
+// scheduled End, zero for an open loan, valued from the lender's side.
+// The borrower posts collateral of Margin times the market value,
+// for example 1.02, marked to market daily.
+//
+// Against non-cash collateral the borrower pays FeeRate on the market value.
+// Against cash collateral, when CashCollateral is set, the lender earns
+// ReinvestmentRate on the cash and pays the borrower RebateRate on it;
+// a negative rebate charges the borrower for hard-to-borrow securities.
+// Rates are simple annual rates accrued per calendar day over DaysPerYear.
+//
+// A Recall, unless zero, ends the loan RecallNoticeDays later if that is
+// before End.
+type SecuritiesLoan struct {
+	Quantity         float64
+	Start            time.Time
+	End              time.Time
+	Margin           float64
+	CashCollateral   bool
+	FeeRate          float64
+	RebateRate       float64
+	ReinvestmentRate float64
+	DaysPerYear      float64
+	Recall           time.Time
+	RecallNoticeDays int
+}
+
+// LoanAccrual is the lender's accrual for one day of a [SecuritiesLoan]:
+// the marked MarketValue and Collateral, the Fee received, the Rebate paid,
+// the Reinvestment income on cash collateral, and their Net.
+type LoanAccrual struct {
+	Date         time.Time
+	MarketValue  float64
+	Collateral   float64
+	Fee          float64
+	Rebate       float64
+	Reinvestment float64
+	Net          float64
+}
+
+// Termination returns the date the loan ends, the earlier of End and the
+// recall settlement, zero for an open loan not recalled.
+func (l SecuritiesLoan) Termination() time.Time {
+	end := l.End
+	if !l.Recall.IsZero() {
+		settle := l.Recall.AddDate(0, 0, l.RecallNoticeDays)
+		if end.IsZero() || settle.Before(end) {
+			end = settle
+		}
+	}
+	return end
+}
+
+// EquivalentFee returns the fee the lender earns on cash collateral,
+// comparable to the fee against non-cash collateral.
+// Math details:
+//
+// EquivalentFee = (ReinvestmentRate - RebateRate) * Margin
+func (l SecuritiesLoan) EquivalentFee() float64 {
+	if !l.CashCollateral {
+		return l.FeeRate
+	}
+	return (l.ReinvestmentRate - l.RebateRate) * l.Margin
+}
+
+// Accrue returns the daily accruals of the loan from Start until it
+// terminates or until asOf, whichever comes first, marking the securities
+// with the latest price on or before each day.
+// Math details:
+//
+// MarketValue_d = Quantity * Price(d)
+//
+// Collateral_d = Margin * MarketValue_d
+//
+// Net_d = (FeeRate * MarketValue_d + (ReinvestmentRate - RebateRate) * Collateral_d) / DaysPerYear
+func (l SecuritiesLoan) Accrue(prices PriceIndex, asOf time.Time) ([]LoanAccrual, error) {
+	if l.DaysPerYear <= 0 {
+		return nil, errors.New("SecuritiesLoan.Accrue requires positive DaysPerYear")
+	}
+	end := l.Termination()
+	if end.IsZero() || asOf.Before(end) {
+		end = asOf
+	}
+	var accruals []LoanAccrual
+	for d := l.Start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		price, err := prices.Level(d)
+		if err != nil {
+			return nil, err
+		}
+		a := LoanAccrual{Date: d, MarketValue: l.Quantity * price}
+		a.Collateral = l.Margin * a.MarketValue
+		if l.CashCollateral {
+			a.Rebate = l.RebateRate * a.Collateral / l.DaysPerYear
+			a.Reinvestment = l.ReinvestmentRate * a.Collateral / l.DaysPerYear
+		} else {
+			a.Fee = l.FeeRate * a.MarketValue / l.DaysPerYear
+		}
+		a.Net = a.Fee + a.Reinvestment - a.Rebate
+		accruals = append(accruals, a)
+	}
+	return accruals, nil
+}
+
+// NetIncome returns the lender's net income over the loan until asOf,
+// the sum of the Net of the [SecuritiesLoan.Accrue] accruals.
+func (l SecuritiesLoan) NetIncome(prices PriceIndex, asOf time.Time) (float64, error) {
+	accruals, err := l.Accrue(prices, asOf)
+	if err != nil {
+		return 0, err
+	}
+	net := 0.0
+	for _, a := range accruals {
+		net += a.Net
+	}
+	return net, nil
+}
@@ -0,0 +1,87 @@
+package gofinance
+
+import (
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// SecuritiesLoan
+// -----------------------------------------------------------------------------
+func TestSecuritiesLoanTermination(t *testing.T) {
+	end := anchor.AddDate(0, 1, 0)
+	tests := []struct {
+		name string
+		loan SecuritiesLoan
+		want int // days after anchor, -1 for open
+	}{
+		{"term", SecuritiesLoan{Start: anchor, End: end}, 31},
+		{"recalled early", SecuritiesLoan{Start: anchor, End: end, Recall: anchor.AddDate(0, 0, 10), RecallNoticeDays: 2}, 12},
+		{"recalled late", SecuritiesLoan{Start: anchor, End: end, Recall: anchor.AddDate(0, 0, 30), RecallNoticeDays: 2}, 31},
+		{"open recalled", SecuritiesLoan{Start: anchor, Recall: anchor.AddDate(0, 0, 5), RecallNoticeDays: 3}, 8},
+		{"open", SecuritiesLoan{Start: anchor}, -1},
+	}
+	for _, tt := range tests {
+		got := tt.loan.Termination()
+		if tt.want < 0 {
+			if !got.IsZero() {
+				t.Errorf("%s: got %v, want zero", tt.name, got)
+			}
+		} else if !got.Equal(anchor.AddDate(0, 0, tt.want)) {
+			t.Errorf("%s: got %v, want %d days", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSecuritiesLoanAccrue(t *testing.T) {
+	prices := TimeSeries{{anchor, 10}, {anchor.AddDate(0, 0, 2), 12}}
+
+	fee := SecuritiesLoan{Quantity: 3600, Start: anchor, End: anchor.AddDate(0, 0, 4), Margin: 1.05, FeeRate: 0.01, DaysPerYear: 360}
+	accruals, err := fee.Accrue(prices, anchor.AddDate(1, 0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// market values 36000, 36000, 43200, 43200 at 1% / 360
+	want := []float64{1, 1, 1.2, 1.2}
+	if len(accruals) != len(want) {
+		t.Fatalf("Accrue got %d days, want %d", len(accruals), len(want))
+	}
+	for i, a := range accruals {
+		if !almostEq(a.Fee, want[i], epsilon) || !almostEq(a.Net, want[i], epsilon) || a.Rebate != 0 {
+			t.Errorf("day %d got %+v", i, a)
+		}
+	}
+	if !almostEq(accruals[2].Collateral, 45360, epsilon) {
+		t.Errorf("Collateral got %f, want 45360", accruals[2].Collateral)
+	}
+
+	// cash collateral, recalled after a day with a one-day notice
+	cash := SecuritiesLoan{Quantity: 3600, Start: anchor, Margin: 1, CashCollateral: true,
+		RebateRate: 0.03, ReinvestmentRate: 0.04, DaysPerYear: 360, Recall: anchor.AddDate(0, 0, 1), RecallNoticeDays: 1}
+	net, err := cash.NetIncome(prices, anchor.AddDate(1, 0, 0))
+	if err != nil || !almostEq(net, 2, epsilon) {
+		t.Errorf("NetIncome got %f, %v, want 2", net, err)
+	}
+	if got := cash.EquivalentFee(); !almostEq(got, 0.01, epsilon) {
+		t.Errorf("EquivalentFee got %f, want 0.01", got)
+	}
+
+	// an open loan accrues until asOf
+	open := fee
+	open.End = time.Time{}
+	if accruals, err := open.Accrue(prices, anchor.AddDate(0, 0, 3)); err != nil || len(accruals) != 3 {
+		t.Errorf("open loan got %d days, %v, want 3", len(accruals), err)
+	}
+
+	if _, err := fee.Accrue(prices, anchor.AddDate(0, 0, -1)); err != nil {
+		t.Errorf("Accrue before Start got error %v", err)
+	}
+	early := fee
+	early.Start = anchor.AddDate(0, 0, -1)
+	if _, err := early.Accrue(prices, anchor.AddDate(1, 0, 0)); err == nil {
+		t.Error("Accrue accepted a day without a price")
+	}
+	if _, err := (SecuritiesLoan{}).Accrue(prices, anchor); err == nil {
+		t.Error("Accrue accepted zero DaysPerYear")
+	}
+}
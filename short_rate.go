@@ -0,0 +1,269 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// ShortRateModel is a stochastic model of the instantaneous short rate.
+// Its bond prices make it a [YieldCurve], so a calibrated model can be used
+// wherever a curve is, and Simulate draws short-rate paths for simulations.
+type ShortRateModel interface {
+	YieldCurve
+
+	// Simulate returns paths of the short rate over years in steps equal
+	// time steps, path[0] being the current rate R0.
+	// The same seed always produces the same paths.
+	Simulate(years float64, steps, paths int, seed uint64) [][]float64
+}
+
+// Vasicek implements [ShortRateModel] for the Vasicek model: the short rate
+// reverts to Theta at speed Kappa with normal shocks of annual volatility
+// Sigma, starting from R0. Rates are continuously compounded.
+// Math details:
+//
+// dr = Kappa * (Theta - r) * dt + Sigma * dW
+type Vasicek struct {
+	Kappa float64
+	Theta float64
+	Sigma float64
+	R0    float64
+}
+
+// DiscountFactor implements [YieldCurve] with the price of a zero-coupon bond.
+// Math details:
+//
+// B = (1 - e^{-Kappa * Years}) / Kappa
+//
+// DiscountFactor = e^{(Theta - Sigma^2 / (2 * Kappa^2)) * (B - Years) - Sigma^2 * B^2 / (4 * Kappa) - B * R0}
+func (m Vasicek) DiscountFactor(years float64) float64 {
+	b := -math.Expm1(-m.Kappa*years) / m.Kappa
+	s2 := m.Sigma * m.Sigma
+	return math.Exp((m.Theta-s2/(2*m.Kappa*m.Kappa))*(b-years) - s2*b*b/(4*m.Kappa) - b*m.R0)
+}
+
+// Simulate implements [ShortRateModel] with the exact Gaussian transition.
+// Math details:
+//
+// r_{t+dt} = Theta + (r_t - Theta) * e^{-Kappa * dt} + Sigma * \sqrt{(1 - e^{-2 * Kappa * dt}) / (2 * Kappa)} * Z
+func (m Vasicek) Simulate(years float64, steps, paths int, seed uint64) [][]float64 {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	dt := years / float64(steps)
+	decay := math.Exp(-m.Kappa * dt)
+	sd := m.Sigma * math.Sqrt(-math.Expm1(-2*m.Kappa*dt)/(2*m.Kappa))
+	out := make([][]float64, paths)
+	for p := range out {
+		path := make([]float64, steps+1)
+		path[0] = m.R0
+		for i := 1; i <= steps; i++ {
+			path[i] = m.Theta + (path[i-1]-m.Theta)*decay + sd*rng.NormFloat64()
+		}
+		out[p] = path
+	}
+	return out
+}
+
+// CIR implements [ShortRateModel] for the Cox-Ingersoll-Ross model: like
+// [Vasicek] with shocks scaled by the square root of the rate, which keeps
+// it from turning negative.
+// Math details:
+//
+// dr = Kappa * (Theta - r) * dt + Sigma * \sqrt{r} * dW
+type CIR struct {
+	Kappa float64
+	Theta float64
+	Sigma float64
+	R0    float64
+}
+
+// DiscountFactor implements [YieldCurve] with the price of a zero-coupon bond.
+// Math details:
+//
+// h = \sqrt{Kappa^2 + 2 * Sigma^2},   D = (h + Kappa) * (e^{h * Years} - 1) + 2 * h
+//
+// B = 2 * (e^{h * Years} - 1) / D,   A = (2 * h * e^{(Kappa + h) * Years / 2} / D)^{2 * Kappa * Theta / Sigma^2}
+//
+// DiscountFactor = A * e^{-B * R0}
+func (m CIR) DiscountFactor(years float64) float64 {
+	h := math.Sqrt(m.Kappa*m.Kappa + 2*m.Sigma*m.Sigma)
+	g := math.Expm1(h * years)
+	d := (h+m.Kappa)*g + 2*h
+	b := 2 * g / d
+	logA := 2 * m.Kappa * m.Theta / (m.Sigma * m.Sigma) * (math.Log(2*h/d) + (m.Kappa+h)*years/2)
+	return math.Exp(logA - b*m.R0)
+}
+
+// Simulate implements [ShortRateModel] with a full-truncation Euler scheme,
+// which uses the positive part of the rate in the drift and the shocks.
+// Math details:
+//
+// r_{t+dt} = r_t + Kappa * (Theta - r_t^+) * dt + Sigma * \sqrt{r_t^+ * dt} * Z
+func (m CIR) Simulate(years float64, steps, paths int, seed uint64) [][]float64 {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	dt := years / float64(steps)
+	out := make([][]float64, paths)
+	for p := range out {
+		path := make([]float64, steps+1)
+		path[0] = m.R0
+		for i := 1; i <= steps; i++ {
+			r := max(path[i-1], 0)
+			path[i] = path[i-1] + m.Kappa*(m.Theta-r)*dt + m.Sigma*math.Sqrt(r*dt)*rng.NormFloat64()
+		}
+		out[p] = path
+	}
+	return out
+}
+
+// zeroRates returns the continuously compounded zero rates of the curve at years.
+func zeroRates(curve YieldCurve, years []float64) []float64 {
+	z := make([]float64, len(years))
+	for i, t := range years {
+		z[i] = -math.Log(curve.DiscountFactor(t)) / t
+	}
+	return z
+}
+
+// fitCurve minimises the squared zero rate errors of the model built by
+// model from unconstrained parameters, starting from x0.
+func fitCurve(curve YieldCurve, years []float64, x0 []float64, model func([]float64) YieldCurve) ([]float64, error) {
+	if len(years) < 2 {
+		return nil, fmt.Errorf("short-rate calibration requires two or more maturities: %w", ErrInsufficientData)
+	}
+	for _, t := range years {
+		if t <= 0 {
+			return nil, errors.New("short-rate calibration requires positive maturities")
+		}
+	}
+	target := zeroRates(curve, years)
+	sse := func(x []float64) float64 {
+		sum := 0.0
+		for i, z := range zeroRates(model(x), years) {
+			if math.IsNaN(z) {
+				return math.Inf(1)
+			}
+			sum += (z - target[i]) * (z - target[i])
+		}
+		return sum
+	}
+	x := x0
+	for range 5 { // restarts refresh a collapsed simplex
+		x = nelderMead(sse, x, 0.1, 1e-16, 2000)
+	}
+	return x, nil
+}
+
+// CalibrateVasicek fits the [Vasicek] model to the zero rates of curve at
+// the maturities years by least squares. Kappa and Sigma are kept positive.
+// A curve alone identifies Sigma only through the convexity of long rates,
+// so fix expectations of the volatility with [CalibrateVasicekHistorical].
+func CalibrateVasicek(curve YieldCurve, years []float64) (Vasicek, error) {
+	z := zeroRates(curve, years)
+	model := func(x []float64) YieldCurve {
+		return Vasicek{math.Exp(x[0]), x[1], math.Exp(x[2]), x[3]}
+	}
+	x, err := fitCurve(curve, years, []float64{math.Log(0.5), z[len(z)-1], math.Log(0.01), z[0]}, model)
+	if err != nil {
+		return Vasicek{}, err
+	}
+	return model(x).(Vasicek), nil
+}
+
+// CalibrateCIR fits the [CIR] model to the zero rates of curve at the
+// maturities years by least squares. All parameters are kept positive,
+// so curves implying negative rates cannot be fitted.
+func CalibrateCIR(curve YieldCurve, years []float64) (CIR, error) {
+	z := zeroRates(curve, years)
+	model := func(x []float64) YieldCurve {
+		return CIR{math.Exp(x[0]), math.Exp(x[1]), math.Exp(x[2]), math.Exp(x[3])}
+	}
+	guess := []float64{math.Log(0.5), math.Log(max(z[len(z)-1], 1e-4)), math.Log(0.05), math.Log(max(z[0], 1e-4))}
+	x, err := fitCurve(curve, years, guess, model)
+	if err != nil {
+		return CIR{}, err
+	}
+	return model(x).(CIR), nil
+}
+
+// CalibrateVasicekHistorical fits the [Vasicek] model to a series of short
+// rates observed periodsPerYear times a year, by regressing each rate on the
+// previous one, the exact discretisation of the model. R0 is the last rate.
+// Math details:
+//
+// r_{t+1} = a + b * r_t + e_t
+//
+// Kappa = -ln(b) * PeriodsPerYear,   Theta = a / (1 - b),   Sigma = StdDev(e) * \sqrt{2 * Kappa / (1 - b^2)}
+func CalibrateVasicekHistorical(rates []float64, periodsPerYear float64) (Vasicek, error) {
+	// two parameters leave len(rates) - 3 degrees of freedom for Sigma
+	if len(rates) < 4 {
+		return Vasicek{}, fmt.Errorf("CalibrateVasicekHistorical requires four or more rates: %w", ErrInsufficientData)
+	}
+	x, y := rates[:len(rates)-1], rates[1:]
+	b := covariance(x, y) / covariance(x, x)
+	if !(b > 0 && b < 1) {
+		return Vasicek{}, errors.New("CalibrateVasicekHistorical: rates do not mean-revert")
+	}
+	a := mean(y) - b*mean(x)
+	resid := make([]float64, len(x))
+	for i := range x {
+		resid[i] = y[i] - a - b*x[i]
+	}
+	kappa := -math.Log(b) * periodsPerYear
+	sd := math.Sqrt(sumSquares(resid) / float64(len(resid)-2))
+	return Vasicek{kappa, a / (1 - b), sd * math.Sqrt(2*kappa/(1-b*b)), rates[len(rates)-1]}, nil
+}
+
+// CalibrateCIRHistorical fits the [CIR] model to a series of positive short
+// rates observed periodsPerYear times a year, by least squares on the Euler
+// discretisation scaled to constant variance. R0 is the last rate.
+// Math details:
+//
+// (r_{t+1} - r_t) / \sqrt{r_t} = Kappa * Theta * dt / \sqrt{r_t} - Kappa * dt * \sqrt{r_t} + Sigma * \sqrt{dt} * e_t
+func CalibrateCIRHistorical(rates []float64, periodsPerYear float64) (CIR, error) {
+	// two parameters leave len(rates) - 3 degrees of freedom for Sigma
+	if len(rates) < 4 {
+		return CIR{}, fmt.Errorf("CalibrateCIRHistorical requires four or more rates: %w", ErrInsufficientData)
+	}
+	dt := 1 / periodsPerYear
+	n := len(rates) - 1
+	// normal equations of y = c1 * x1 + c2 * x2
+	var s11, s12, s22, s1y, s2y float64
+	ys, x1s, x2s := make([]float64, n), make([]float64, n), make([]float64, n)
+	for i := range n {
+		r := rates[i]
+		if r <= 0 {
+			return CIR{}, errors.New("CalibrateCIRHistorical requires positive rates")
+		}
+		sq := math.Sqrt(r)
+		ys[i], x1s[i], x2s[i] = (rates[i+1]-r)/sq, dt/sq, dt*sq
+		s11 += x1s[i] * x1s[i]
+		s12 += x1s[i] * x2s[i]
+		s22 += x2s[i] * x2s[i]
+		s1y += x1s[i] * ys[i]
+		s2y += x2s[i] * ys[i]
+	}
+	c, err := solveLinear([][]float64{{s11, s12}, {s12, s22}}, []float64{s1y, s2y})
+	if err != nil {
+		return CIR{}, err
+	}
+	kappa := -c[1]
+	if kappa <= 0 {
+		return CIR{}, errors.New("CalibrateCIRHistorical: rates do not mean-revert")
+	}
+	resid := make([]float64, n)
+	for i := range n {
+		resid[i] = ys[i] - c[0]*x1s[i] - c[1]*x2s[i]
+	}
+	sigma := math.Sqrt(sumSquares(resid)/float64(n-2)) / math.Sqrt(dt)
+	return CIR{kappa, c[0] / kappa, sigma, rates[n]}, nil
+}
+
+// sumSquares returns the sum of the squares of xs.
+func sumSquares(xs []float64) float64 {
+	sum := 0.0
+	for _, x := range xs {
+		sum += x * x
+	}
+	return sum
+}
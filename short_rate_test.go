@@ -0,0 +1,141 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Bond prices
+// -----------------------------------------------------------------------------
+func TestShortRateDiscountFactors(t *testing.T) {
+	// without volatility the short rate follows its mean-reversion path
+	v := Vasicek{Kappa: 0.3, Theta: 0.05, Sigma: 0, R0: 0.02}
+	cir := CIR{Kappa: 0.3, Theta: 0.05, Sigma: 1e-4, R0: 0.02}
+	for _, years := range []float64{0.5, 5, 30} {
+		integral := 0.05*years + (0.02-0.05)*(1-math.Exp(-0.3*years))/0.3
+		if got, want := v.DiscountFactor(years), math.Exp(-integral); !almostEq(got, want, 1e-12) {
+			t.Errorf("Vasicek DiscountFactor(%v) got %f, want %f", years, got, want)
+		}
+		if got, want := cir.DiscountFactor(years), math.Exp(-integral); !almostEq(got, want, 1e-5) {
+			t.Errorf("CIR DiscountFactor(%v) got %f, want %f", years, got, want)
+		}
+	}
+
+	// bond prices match the simulated expectation of the discounted short rate
+	models := []ShortRateModel{
+		Vasicek{Kappa: 0.5, Theta: 0.04, Sigma: 0.02, R0: 0.03},
+		CIR{Kappa: 0.5, Theta: 0.04, Sigma: 0.1, R0: 0.03},
+	}
+	for _, m := range models {
+		paths := m.Simulate(5, 500, 4000, 11)
+		sum := 0.0
+		for _, path := range paths {
+			integral := 0.0
+			for i := 1; i < len(path); i++ {
+				integral += (path[i-1] + path[i]) / 2 * 0.01
+			}
+			sum += math.Exp(-integral)
+		}
+		if got, want := sum/4000, m.DiscountFactor(5); math.Abs(got-want) > 2e-3 {
+			t.Errorf("%T simulated DiscountFactor got %f, want %f", m, got, want)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Calibration to a curve
+// -----------------------------------------------------------------------------
+func TestCalibrateToCurve(t *testing.T) {
+	years := []float64{0.25, 0.5, 1, 2, 3, 5, 7, 10, 20, 30}
+
+	v := Vasicek{Kappa: 0.4, Theta: 0.045, Sigma: 0.015, R0: 0.02}
+	fitV, err := CalibrateVasicek(v, years)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := CIR{Kappa: 0.25, Theta: 0.05, Sigma: 0.08, R0: 0.015}
+	fitC, err := CalibrateCIR(c, years)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, z := range zeroRates(v, years) {
+		if got := zeroRates(fitV, years)[i]; math.Abs(got-z) > 1e-6 {
+			t.Errorf("Vasicek fit at %v got %f, want %f", years[i], got, z)
+		}
+	}
+	for i, z := range zeroRates(c, years) {
+		if got := zeroRates(fitC, years)[i]; math.Abs(got-z) > 1e-6 {
+			t.Errorf("CIR fit at %v got %f, want %f", years[i], got, z)
+		}
+	}
+
+	// an upward sloping zero curve is reproduced closely
+	curve, _ := NewYieldCurveZero([]float64{1, 5, 30}, []float64{0.02, 0.03, 0.035})
+	fit, err := CalibrateVasicek(curve, years)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, z := range zeroRates(curve, years) {
+		if got := zeroRates(fit, years)[i]; math.Abs(got-z) > 2e-3 {
+			t.Errorf("curve fit at %v got %f, want %f", years[i], got, z)
+		}
+	}
+
+	if _, err := CalibrateVasicek(curve, []float64{1}); err == nil {
+		t.Error("CalibrateVasicek accepted a single maturity")
+	}
+	if _, err := CalibrateCIR(curve, []float64{0, 1}); err == nil {
+		t.Error("CalibrateCIR accepted a zero maturity")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Calibration to history
+// -----------------------------------------------------------------------------
+func TestCalibrateHistorical(t *testing.T) {
+	v := Vasicek{Kappa: 0.8, Theta: 0.04, Sigma: 0.01, R0: 0.02}
+	rates := v.Simulate(500, 500*52, 1, 5)[0]
+	fitV, err := CalibrateVasicekHistorical(rates, 52)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(fitV.Kappa-0.8) > 0.2 || math.Abs(fitV.Theta-0.04) > 0.002 || math.Abs(fitV.Sigma-0.01) > 0.0005 {
+		t.Errorf("CalibrateVasicekHistorical got %+v", fitV)
+	}
+	if fitV.R0 != rates[len(rates)-1] {
+		t.Errorf("R0 got %f, want the last rate", fitV.R0)
+	}
+
+	c := CIR{Kappa: 0.8, Theta: 0.04, Sigma: 0.05, R0: 0.02}
+	rates = c.Simulate(500, 500*52, 1, 5)[0]
+	fitC, err := CalibrateCIRHistorical(rates, 52)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(fitC.Kappa-0.8) > 0.2 || math.Abs(fitC.Theta-0.04) > 0.002 || math.Abs(fitC.Sigma-0.05) > 0.0025 {
+		t.Errorf("CalibrateCIRHistorical got %+v", fitC)
+	}
+
+	// three rates leave no degrees of freedom for Sigma, four leave one
+	short := []float64{0.02, 0.03, 0.035}
+	if _, err := CalibrateVasicekHistorical(short, 52); err == nil {
+		t.Error("CalibrateVasicekHistorical accepted three rates")
+	}
+	if _, err := CalibrateCIRHistorical(short, 52); err == nil {
+		t.Error("CalibrateCIRHistorical accepted three rates")
+	}
+	four := append(short, 0.034)
+	if fit, err := CalibrateVasicekHistorical(four, 52); err != nil || math.IsNaN(fit.Sigma) || math.IsInf(fit.Sigma, 0) {
+		t.Errorf("CalibrateVasicekHistorical of four rates got %+v, %v", fit, err)
+	}
+	if fit, err := CalibrateCIRHistorical(four, 52); err != nil || math.IsNaN(fit.Sigma) || math.IsInf(fit.Sigma, 0) {
+		t.Errorf("CalibrateCIRHistorical of four rates got %+v, %v", fit, err)
+	}
+	if _, err := CalibrateVasicekHistorical([]float64{0.01, 0.02, 0.03, 0.04}, 52); err == nil {
+		t.Error("CalibrateVasicekHistorical accepted trending rates")
+	}
+	if _, err := CalibrateCIRHistorical([]float64{0.01, -0.01, 0.02, 0.03}, 52); err == nil {
+		t.Error("CalibrateCIRHistorical accepted a negative rate")
+	}
+}
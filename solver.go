@@ -0,0 +1,289 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// SolverOptions controls the precision and effort of the numerical solvers
+// behind [CashFlows.IRR] and the other functions that search for a rate.
+//
+// A zero field selects the corresponding value of [DefaultSolverOptions],
+// so SolverOptions{MaxIterations: 500} only changes the iteration cap.
+type SolverOptions struct {
+	// AbsTolerance stops the search once the root is known to within
+	// this absolute distance.
+	AbsTolerance float64
+
+	// RelTolerance stops the search once the root is known to within
+	// this fraction of its own magnitude.
+	// The solver stops as soon as either tolerance is met.
+	RelTolerance float64
+
+	// MaxIterations caps the number of refinement steps,
+	// the solver returns an error when the cap is hit.
+	MaxIterations int
+
+	// InitialGuess is where the search starts. For rate solvers
+	// it is an annual continuously compounded rate.
+	// Zero means unset like the other fields, so a search cannot be
+	// started at exactly 0; pass a tiny value such as 1e-9 instead.
+	InitialGuess float64
+
+	// Method selects the strategy of [Solve], the other solvers ignore it.
+	Method SolveMethod
+}
+
+// DefaultSolverOptions returns the options used when no [SolverOptions]
+// are supplied. AbsTolerance of 1e-12 matches the 12 digits of precision
+// used by IRR historically. Each call returns a fresh copy, so the
+// defaults cannot be changed for the whole process.
+func DefaultSolverOptions() SolverOptions {
+	return SolverOptions{
+		AbsTolerance:  1e-12,
+		RelTolerance:  1e-12,
+		MaxIterations: 100,
+		InitialGuess:  0.10,
+	}
+}
+
+// machineEpsilon is the distance between 1 and the next float64.
+const machineEpsilon = 0x1p-52
+
+// withDefaults fills zero fields from [DefaultSolverOptions],
+// including an InitialGuess of 0, which is indistinguishable from unset.
+func (o SolverOptions) withDefaults() SolverOptions {
+	if o.AbsTolerance == 0 {
+		o.AbsTolerance = DefaultSolverOptions().AbsTolerance
+	}
+	if o.RelTolerance == 0 {
+		o.RelTolerance = DefaultSolverOptions().RelTolerance
+	}
+	if o.MaxIterations == 0 {
+		o.MaxIterations = DefaultSolverOptions().MaxIterations
+	}
+	if o.InitialGuess == 0 {
+		o.InitialGuess = DefaultSolverOptions().InitialGuess
+	}
+	return o
+}
+
+// solverOptions picks the first of the optional variadic options,
+// so functions can accept opts ...SolverOptions and stay backward compatible.
+func solverOptions(opts []SolverOptions) SolverOptions {
+	if len(opts) == 0 {
+		return DefaultSolverOptions()
+	}
+	return opts[0].withDefaults()
+}
+
+// brent finds a root of f in [a, b] with Brent's method, combining
+// bisection, secant steps, and inverse quadratic interpolation.
+// f(a) and f(b) must have opposite signs.
+// Reference: https://en.wikipedia.org/wiki/Brent%27s_method
+func brent(f func(float64) float64, a, b float64, opts SolverOptions) (float64, error) {
+	fa, fb := f(a), f(b)
+	if fa*fb > 0 {
+		return 0, fmt.Errorf("brent: %w", ErrNoRootBracketed)
+	}
+	c, fc := b, fb
+	var d, e float64
+	for range opts.MaxIterations {
+		if fb*fc > 0 {
+			// root lies between a and b, restart c from a
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		tol := 2*machineEpsilon*math.Abs(b) +
+			0.5*math.Max(opts.AbsTolerance, opts.RelTolerance*math.Abs(b))
+		m := 0.5 * (c - b)
+		if math.Abs(m) <= tol || fb == 0 {
+			return b, nil
+		}
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			// attempt interpolation
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				// secant
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				// inverse quadratic
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*m*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			} else {
+				p = -p
+			}
+			if 2*p < math.Min(3*m*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				// interpolation failed, bisect
+				d = m
+				e = d
+			}
+		} else {
+			// bounds decreasing too slowly, bisect
+			d = m
+			e = d
+		}
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, m)
+		}
+		fb = f(b)
+	}
+	return 0, fmt.Errorf("brent: %w", ErrMaxIterations)
+}
+
+// SolveMethod selects the root-finding strategy of [Solve].
+type SolveMethod int
+
+const (
+	// SolveBrent brackets a sign change around the initial guess and
+	// then runs Brent's method, which always converges once bracketed.
+	SolveBrent SolveMethod = iota
+	// SolveNewton runs Newton's method from the initial guess with a
+	// numerical derivative, faster on smooth functions but without
+	// a guarantee of convergence.
+	SolveNewton
+)
+
+// maxBracketSteps caps the doublings of the search interval in [Solve],
+// enough to reach beyond 1e18 from a unit step.
+const maxBracketSteps = 60
+
+// Solve finds an x with f(x) = 0 near opts.InitialGuess, with the method
+// and tolerances of the optional [SolverOptions], so that any metric can
+// be goal-sought. For example the price at which an investment returns
+// 12% a year:
+//
+//	price, err := Solve(func(p float64) float64 {
+//		flows := append(CashFlows{{-p, start}}, income...)
+//		r, _ := flows.IRR()
+//		return r.RateAnnualEffective() - 0.12
+//	}, SolverOptions{InitialGuess: 100})
+//
+// With [SolveBrent], the default, the interval around the guess doubles
+// until f changes sign over it; NaN values of f, outside its domain,
+// stop the growth on that side. Failures are an [ErrSolve].
+func Solve(f func(float64) float64, opts ...SolverOptions) (float64, error) {
+	o := solverOptions(opts)
+	t := &solveTrace{f: f, fx: math.Inf(1)}
+	if o.Method == SolveNewton {
+		return t.newton(o)
+	}
+	a, b, err := t.bracket(o.InitialGuess)
+	if err != nil {
+		return t.fail(SolveBrent, err)
+	}
+	return t.brent(a, b, o)
+}
+
+// SolveBracket finds a root of f between a and b with Brent's method,
+// f(a) and f(b) must have opposite signs. Failures are an [ErrSolve].
+func SolveBracket(f func(float64) float64, a, b float64, opts ...SolverOptions) (float64, error) {
+	t := &solveTrace{f: f, fx: math.Inf(1)}
+	return t.brent(a, b, solverOptions(opts))
+}
+
+// solveTrace evaluates f for [Solve] keeping the best point seen,
+// reported by [ErrSolve] on failure.
+type solveTrace struct {
+	f     func(float64) float64
+	x, fx float64
+}
+
+// eval returns f(x), recording x if it is the closest to a root so far.
+func (t *solveTrace) eval(x float64) float64 {
+	fx := t.f(x)
+	if math.Abs(fx) < math.Abs(t.fx) {
+		t.x, t.fx = x, fx
+	}
+	return fx
+}
+
+// fail wraps err in an [ErrSolve] with the best point seen.
+func (t *solveTrace) fail(method SolveMethod, err error) (float64, error) {
+	if errors.Is(err, ErrNoRootBracketed) {
+		err = ErrNoRootBracketed
+	} else if errors.Is(err, ErrMaxIterations) {
+		err = ErrMaxIterations
+	}
+	return math.NaN(), ErrSolve{method, t.x, t.fx, err}
+}
+
+// brent runs [brent] on [a, b].
+func (t *solveTrace) brent(a, b float64, o SolverOptions) (float64, error) {
+	root, err := brent(t.eval, a, b, o)
+	if err != nil {
+		return t.fail(SolveBrent, err)
+	}
+	return root, nil
+}
+
+// bracket widens an interval around guess until f changes sign over it.
+func (t *solveTrace) bracket(guess float64) (a, b float64, err error) {
+	step := math.Max(math.Abs(guess)/10, 0.1)
+	a, b = guess-step, guess+step
+	fa, fb := t.eval(a), t.eval(b)
+	for range maxBracketSteps {
+		if fa*fb <= 0 {
+			return a, b, nil
+		}
+		if math.IsNaN(fa) && math.IsNaN(fb) {
+			break
+		}
+		step *= 2
+		if math.IsNaN(fa) {
+			a = (a + guess) / 2 // pull back inside the domain
+		} else if math.Abs(fa) < math.Abs(fb) || math.IsNaN(fb) {
+			a = guess - step
+		}
+		if math.IsNaN(fb) {
+			b = (b + guess) / 2
+		} else if math.Abs(fb) <= math.Abs(fa) || math.IsNaN(fa) {
+			b = guess + step
+		}
+		fa, fb = t.eval(a), t.eval(b)
+	}
+	return 0, 0, ErrNoRootBracketed
+}
+
+// newton runs Newton's method from o.InitialGuess with a central
+// difference derivative.
+func (t *solveTrace) newton(o SolverOptions) (float64, error) {
+	x := o.InitialGuess
+	for range o.MaxIterations {
+		fx := t.eval(x)
+		if fx == 0 {
+			return x, nil
+		}
+		h := 1e-6 * math.Max(1, math.Abs(x))
+		slope := (t.f(x+h) - t.f(x-h)) / (2 * h)
+		if slope == 0 || !finite(slope) || !finite(fx) {
+			return t.fail(SolveNewton, ErrZeroDerivative)
+		}
+		dx := fx / slope
+		x -= dx
+		if math.Abs(dx) <= math.Max(o.AbsTolerance, o.RelTolerance*math.Abs(x)) {
+			t.eval(x)
+			return x, nil
+		}
+	}
+	return t.fail(SolveNewton, ErrMaxIterations)
+}
@@ -0,0 +1,62 @@
+//go:build solvercompare
+
+// Comparison tests of the in-package root finder against
+// github.com/khezen/rootfinding, the external solver it replaced, run with
+//
+//	go test -tags solvercompare -run Compare
+package gofinance
+
+import (
+	"math"
+	"testing"
+
+	"github.com/khezen/rootfinding"
+)
+
+// -----------------------------------------------------------------------------
+// brent against rootfinding.Brent
+// -----------------------------------------------------------------------------
+func TestCompareBrentRootfinding(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(float64) float64
+		a, b float64
+	}{
+		{"cubic", func(x float64) float64 { return x*x*x - 2*x - 5 }, 2, 3},
+		{"cosine", math.Cos, 0, 3},
+		{"exponential", func(x float64) float64 { return math.Exp(x) - 10 }, 0, 5},
+		{"flat near root", func(x float64) float64 { return math.Pow(x-1, 3) }, 0, 3},
+		{"steep", func(x float64) float64 { return math.Atan(1e6 * (x - 0.3)) }, 0, 1},
+		{"annuity", func(r float64) float64 { return (1-math.Pow(1+r, -30))/r - 15 }, 0.001, 0.5},
+		{"npv", func(r float64) float64 { return -100 + 60*math.Exp(-r) + 60*math.Exp(-2*r) }, -0.999999, 0.5},
+	}
+	// a triple root slows Brent's method to near bisection speed,
+	// beyond the default iteration cap
+	opts := SolverOptions{MaxIterations: 500}.withDefaults()
+	for _, tc := range tests {
+		got, err := brent(tc.f, tc.a, tc.b, opts)
+		if err != nil {
+			t.Errorf("%s: brent: %v", tc.name, err)
+			continue
+		}
+		// 12 digits is the precision IRR used with the external solver
+		want, err := rootfinding.Brent(tc.f, tc.a, tc.b, 12)
+		if err != nil {
+			t.Errorf("%s: rootfinding.Brent: %v", tc.name, err)
+			continue
+		}
+		if !almostEq(got, want, 1e-9) {
+			t.Errorf("%s: brent got %.15g, rootfinding.Brent %.15g", tc.name, got, want)
+		}
+	}
+}
+
+func TestCompareBrentRootfindingErrors(t *testing.T) {
+	f := func(x float64) float64 { return x*x + 1 }
+	if _, err := brent(f, 1, 2, DefaultSolverOptions()); err == nil {
+		t.Error("brent: expected error for unbracketed root, got nil")
+	}
+	if _, err := rootfinding.Brent(f, 1, 2, 12); err == nil {
+		t.Error("rootfinding.Brent: expected error for unbracketed root, got nil")
+	}
+}
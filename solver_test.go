@@ -0,0 +1,177 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// SolverOptions defaults
+// -----------------------------------------------------------------------------
+func TestSolverOptionsDefaults(t *testing.T) {
+	if got := solverOptions(nil); got != DefaultSolverOptions() {
+		t.Errorf("no options: got %+v, want %+v", got, DefaultSolverOptions())
+	}
+
+	got := solverOptions([]SolverOptions{{MaxIterations: 7}})
+	want := DefaultSolverOptions()
+	want.MaxIterations = 7
+	if got != want {
+		t.Errorf("partial options: got %+v, want %+v", got, want)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// brent
+// -----------------------------------------------------------------------------
+func TestBrent(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(float64) float64
+		a, b float64
+		want float64
+	}{
+		{"linear", func(x float64) float64 { return 2*x - 1 }, 0, 3, 0.5},
+		{"quadratic", func(x float64) float64 { return x*x - 2 }, 0, 2, math.Sqrt2},
+		{"cubic reversed bounds", func(x float64) float64 { return x*x*x - 8 }, 5, 0, 2},
+		{"root at bound", func(x float64) float64 { return math.Sin(x) }, 0, 1, 0},
+		{"exponential", func(x float64) float64 { return math.Exp(x) - 10 }, -5, 5, math.Log(10)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := brent(tc.f, tc.a, tc.b, DefaultSolverOptions())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !almostEq(got, tc.want, 1e-11) {
+				t.Errorf("brent got %.15f, want %.15f", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBrentErrors(t *testing.T) {
+	f := func(x float64) float64 { return x*x + 1 }
+	if _, err := brent(f, -1, 1, DefaultSolverOptions()); err == nil {
+		t.Error("expected error for unbracketed root, got nil")
+	}
+
+	g := func(x float64) float64 { return math.Exp(x) - 10 }
+	if _, err := brent(g, -50, 50, SolverOptions{AbsTolerance: 1e-15, MaxIterations: 2}); err == nil {
+		t.Error("expected error when MaxIterations is exceeded, got nil")
+	}
+}
+
+func TestBrentLooseTolerance(t *testing.T) {
+	f := func(x float64) float64 { return x*x - 2 }
+	got, err := brent(f, 0, 2, SolverOptions{AbsTolerance: 1e-3, MaxIterations: 100})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if math.Abs(got-math.Sqrt2) > 1e-3 {
+		t.Errorf("brent got %f, want within 1e-3 of %f", got, math.Sqrt2)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// IRR with options
+// -----------------------------------------------------------------------------
+func TestIRRWithOptions(t *testing.T) {
+	cfs := CashFlows{
+		{-100, anchor},
+		{110, anchor.AddDate(1, 0, 0)},
+	}
+	want := math.Log(1.1)
+
+	for _, guess := range []float64{-0.5, 0.01, 0.5, 3} {
+		irr, err := cfs.IRR(SolverOptions{InitialGuess: guess})
+		if err != nil {
+			t.Fatalf("guess %v: unexpected error: %v", guess, err)
+		}
+		if !almostEq(irr.RateAnnualContinuous(), want, 1e-9) {
+			t.Errorf("guess %v: IRR got %.10f, want %.10f", guess, irr.RateAnnualContinuous(), want)
+		}
+	}
+
+	multi := CashFlows{
+		{-1000, anchor},
+		{400, anchor.AddDate(1, 0, 0)},
+		{400, anchor.AddDate(2, 0, 0)},
+		{400, anchor.AddDate(3, 0, 0)},
+	}
+	if _, err := multi.IRR(SolverOptions{MaxIterations: 1, InitialGuess: 3}); err == nil {
+		t.Error("expected error with MaxIterations 1, got nil")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Solve
+// -----------------------------------------------------------------------------
+func TestSolve(t *testing.T) {
+	cube := func(x float64) float64 { return x*x*x - 2*x - 5 }
+	const root = 2.0945514815423265
+
+	for _, method := range []SolveMethod{SolveBrent, SolveNewton} {
+		x, err := Solve(cube, SolverOptions{InitialGuess: 1, Method: method})
+		if err != nil || !almostEq(x, root, 1e-10) {
+			t.Errorf("method %d: Solve got %v, %v, want %v", method, x, err, root)
+		}
+	}
+
+	// the bracket grows far from the guess
+	if x, err := Solve(func(x float64) float64 { return x - 1e6 }); err != nil || !almostEq(x, 1e6, 1e-6) {
+		t.Errorf("distant root got %v, %v", x, err)
+	}
+	// NaN outside the domain pulls the bracket back inside
+	if x, err := Solve(func(x float64) float64 { return math.Log(x) - 1 }, SolverOptions{InitialGuess: 0.01}); err != nil || !almostEq(x, math.E, 1e-10) {
+		t.Errorf("log root got %v, %v", x, err)
+	}
+
+	if x, err := SolveBracket(math.Cos, 0, 3); err != nil || !almostEq(x, math.Pi/2, 1e-12) {
+		t.Errorf("SolveBracket got %v, %v", x, err)
+	}
+
+	// goal seek: the price of an annuity-like investment returning 12%
+	start := anchor
+	income := CashFlows{{50, start.AddDate(1, 0, 0)}, {50, start.AddDate(2, 0, 0)}, {50, start.AddDate(3, 0, 0)}}
+	price, err := Solve(func(p float64) float64 {
+		flows := append(CashFlows{{-p, start}}, income...)
+		r, err := flows.IRR()
+		if err != nil {
+			return math.NaN()
+		}
+		return r.RateAnnualEffective() - 0.12
+	}, SolverOptions{InitialGuess: 100})
+	if err != nil || !almostEq(income.NPV(RateEffective{0.12, 1}, start), price, 1e-8) {
+		t.Errorf("goal-seek price got %v, %v", price, err)
+	}
+}
+
+func TestSolveErrors(t *testing.T) {
+	square := func(x float64) float64 { return x*x + 1 }
+	var solveErr ErrSolve
+
+	_, err := Solve(square)
+	if !errors.Is(err, ErrNoRootBracketed) || !errors.As(err, &solveErr) || solveErr.Method != SolveBrent {
+		t.Errorf("no root: got %v", err)
+	}
+	// the best point reported is the minimum of |f| seen
+	if !almostEq(solveErr.FX, square(solveErr.X), epsilon) || solveErr.FX > 1.1 {
+		t.Errorf("best point got %+v", solveErr)
+	}
+
+	_, err = Solve(func(float64) float64 { return 1 }, SolverOptions{Method: SolveNewton})
+	if !errors.Is(err, ErrZeroDerivative) {
+		t.Errorf("flat function: got %v, want ErrZeroDerivative", err)
+	}
+	_, err = Solve(square, SolverOptions{Method: SolveNewton, InitialGuess: 3, MaxIterations: 5})
+	if !errors.Is(err, ErrMaxIterations) || !errors.As(err, &solveErr) || solveErr.Method != SolveNewton {
+		t.Errorf("Newton without a root: got %v, want ErrMaxIterations", err)
+	}
+
+	_, err = SolveBracket(math.Cos, 0, 1)
+	if !errors.Is(err, ErrNoRootBracketed) {
+		t.Errorf("SolveBracket without sign change: got %v", err)
+	}
+}
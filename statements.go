@@ -0,0 +1,197 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// IncomeStatement holds the flows of one reporting period.
+// EBIT is operating income, NetIncome is after interest and taxes.
+type IncomeStatement struct {
+	Revenue         float64
+	COGS            float64
+	EBIT            float64
+	Depreciation    float64
+	InterestExpense float64
+	NetIncome       float64
+}
+
+// BalanceSheet holds the balances at the end of a reporting period.
+// CurrentAssets include Cash, Receivables and Inventory, TotalLiabilities
+// include CurrentLiabilities and LongTermDebt, and ShortTermDebt is the
+// interest-bearing part of CurrentLiabilities.
+type BalanceSheet struct {
+	Cash               float64
+	Receivables        float64
+	Inventory          float64
+	CurrentAssets      float64
+	TotalAssets        float64
+	CurrentLiabilities float64
+	ShortTermDebt      float64
+	LongTermDebt       float64
+	TotalLiabilities   float64
+	RetainedEarnings   float64
+	Equity             float64
+	SharesOutstanding  float64
+}
+
+// CashFlowStatement holds the cash-flows of one reporting period,
+// CapitalExpenditures and DividendsPaid as positive amounts.
+type CashFlowStatement struct {
+	OperatingCashFlow   float64
+	CapitalExpenditures float64
+	DividendsPaid       float64
+}
+
+// FinancialStatements are the statements of the period ending at Date.
+type FinancialStatements struct {
+	Date     time.Time
+	Income   IncomeStatement
+	Balance  BalanceSheet
+	CashFlow CashFlowStatement
+}
+
+// Debt returns the interest-bearing debt on the balance sheet.
+func (b BalanceSheet) Debt() float64 {
+	return b.ShortTermDebt + b.LongTermDebt
+}
+
+// FreeCashFlow returns the operating cash-flow left after capital expenditures.
+func (c CashFlowStatement) FreeCashFlow() float64 {
+	return c.OperatingCashFlow - c.CapitalExpenditures
+}
+
+// FinancialRatios are the standard ratios of one period, computed from
+// period-end balances. A ratio with a zero denominator is infinite or NaN.
+type FinancialRatios struct {
+	// liquidity
+	CurrentRatio float64
+	QuickRatio   float64
+	CashRatio    float64
+
+	// leverage
+	DebtToEquity     float64
+	DebtToAssets     float64
+	EquityMultiplier float64
+
+	// profitability
+	GrossMargin     float64
+	OperatingMargin float64
+	NetMargin       float64
+	ReturnOnAssets  float64
+	ReturnOnEquity  float64
+	AssetTurnover   float64
+
+	// coverage
+	InterestCoverage    float64
+	DebtServiceCoverage float64
+	CashFlowToDebt      float64
+	FreeCashFlowYield   float64
+}
+
+// Ratios computes the ratios of the statements.
+// Math details:
+//
+// Current = CurrentAssets / CurrentLiabilities,   Quick = (Cash + Receivables) / CurrentLiabilities,   Cash = Cash / CurrentLiabilities
+//
+// DebtToEquity = Debt / Equity,   DebtToAssets = Debt / TotalAssets,   EquityMultiplier = TotalAssets / Equity
+//
+// GrossMargin = (Revenue - COGS) / Revenue,   OperatingMargin = EBIT / Revenue,   NetMargin = NetIncome / Revenue
+//
+// ROA = NetIncome / TotalAssets,   ROE = NetIncome / Equity,   AssetTurnover = Revenue / TotalAssets
+//
+// InterestCoverage = EBIT / InterestExpense,   DebtServiceCoverage = (EBIT + Depreciation) / (InterestExpense + ShortTermDebt)
+//
+// CashFlowToDebt = OperatingCashFlow / Debt,   FreeCashFlowYield = FreeCashFlow / TotalAssets
+func (s FinancialStatements) Ratios() FinancialRatios {
+	is, bs, cf := s.Income, s.Balance, s.CashFlow
+	return FinancialRatios{
+		CurrentRatio: bs.CurrentAssets / bs.CurrentLiabilities,
+		QuickRatio:   (bs.Cash + bs.Receivables) / bs.CurrentLiabilities,
+		CashRatio:    bs.Cash / bs.CurrentLiabilities,
+
+		DebtToEquity:     bs.Debt() / bs.Equity,
+		DebtToAssets:     bs.Debt() / bs.TotalAssets,
+		EquityMultiplier: bs.TotalAssets / bs.Equity,
+
+		GrossMargin:     (is.Revenue - is.COGS) / is.Revenue,
+		OperatingMargin: is.EBIT / is.Revenue,
+		NetMargin:       is.NetIncome / is.Revenue,
+		ReturnOnAssets:  is.NetIncome / bs.TotalAssets,
+		ReturnOnEquity:  is.NetIncome / bs.Equity,
+		AssetTurnover:   is.Revenue / bs.TotalAssets,
+
+		InterestCoverage:    is.EBIT / is.InterestExpense,
+		DebtServiceCoverage: (is.EBIT + is.Depreciation) / (is.InterestExpense + bs.ShortTermDebt),
+		CashFlowToDebt:      cf.OperatingCashFlow / bs.Debt(),
+		FreeCashFlowYield:   cf.FreeCashFlow() / bs.TotalAssets,
+	}
+}
+
+// Sub returns the change of every ratio from prev to r.
+func (r FinancialRatios) Sub(prev FinancialRatios) FinancialRatios {
+	return FinancialRatios{
+		CurrentRatio:        r.CurrentRatio - prev.CurrentRatio,
+		QuickRatio:          r.QuickRatio - prev.QuickRatio,
+		CashRatio:           r.CashRatio - prev.CashRatio,
+		DebtToEquity:        r.DebtToEquity - prev.DebtToEquity,
+		DebtToAssets:        r.DebtToAssets - prev.DebtToAssets,
+		EquityMultiplier:    r.EquityMultiplier - prev.EquityMultiplier,
+		GrossMargin:         r.GrossMargin - prev.GrossMargin,
+		OperatingMargin:     r.OperatingMargin - prev.OperatingMargin,
+		NetMargin:           r.NetMargin - prev.NetMargin,
+		ReturnOnAssets:      r.ReturnOnAssets - prev.ReturnOnAssets,
+		ReturnOnEquity:      r.ReturnOnEquity - prev.ReturnOnEquity,
+		AssetTurnover:       r.AssetTurnover - prev.AssetTurnover,
+		InterestCoverage:    r.InterestCoverage - prev.InterestCoverage,
+		DebtServiceCoverage: r.DebtServiceCoverage - prev.DebtServiceCoverage,
+		CashFlowToDebt:      r.CashFlowToDebt - prev.CashFlowToDebt,
+		FreeCashFlowYield:   r.FreeCashFlowYield - prev.FreeCashFlowYield,
+	}
+}
+
+// RatioPeriod is one period of a [Ratios] analysis: the ratios of the
+// period ending at Date and their Change from the previous period,
+// all NaN for the first period.
+type RatioPeriod struct {
+	Date   time.Time
+	Ratios FinancialRatios
+	Change FinancialRatios
+}
+
+// Ratios computes the ratios of consecutive periods together with their
+// period-over-period changes. Periods must be in increasing date order.
+func Ratios(periods []FinancialStatements) ([]RatioPeriod, error) {
+	if err := checkPeriods("Ratios", periods); err != nil {
+		return nil, err
+	}
+	nan := math.NaN()
+	out := make([]RatioPeriod, len(periods))
+	for i, s := range periods {
+		out[i] = RatioPeriod{Date: s.Date, Ratios: s.Ratios()}
+		if i == 0 {
+			out[i].Change = FinancialRatios{
+				nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan,
+			}
+			continue
+		}
+		out[i].Change = out[i].Ratios.Sub(out[i-1].Ratios)
+	}
+	return out, nil
+}
+
+// checkPeriods checks that there are periods and that they are in increasing
+// date order, naming fn in the error.
+func checkPeriods(fn string, periods []FinancialStatements) error {
+	if len(periods) == 0 {
+		return fmt.Errorf("%s requires at least one period: %w", fn, ErrInsufficientData)
+	}
+	for i := 1; i < len(periods); i++ {
+		if !periods[i].Date.After(periods[i-1].Date) {
+			return errors.New(fn + " requires periods in increasing date order")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// testStatements returns two years of statements of a small manufacturer.
+func testStatements() []FinancialStatements {
+	return []FinancialStatements{
+		{
+			Date: anchor,
+			Income: IncomeStatement{
+				Revenue: 1000, COGS: 600, EBIT: 150, Depreciation: 50,
+				InterestExpense: 30, NetIncome: 90,
+			},
+			Balance: BalanceSheet{
+				Cash: 100, Receivables: 150, Inventory: 150, CurrentAssets: 400,
+				TotalAssets: 1000, CurrentLiabilities: 200, ShortTermDebt: 50,
+				LongTermDebt: 350, TotalLiabilities: 600, RetainedEarnings: 250,
+				Equity: 400, SharesOutstanding: 100,
+			},
+			CashFlow: CashFlowStatement{OperatingCashFlow: 120, CapitalExpenditures: 70, DividendsPaid: 20},
+		},
+		{
+			Date: anchor.AddDate(1, 0, 0),
+			Income: IncomeStatement{
+				Revenue: 1200, COGS: 690, EBIT: 200, Depreciation: 55,
+				InterestExpense: 25, NetIncome: 125,
+			},
+			Balance: BalanceSheet{
+				Cash: 150, Receivables: 160, Inventory: 140, CurrentAssets: 450,
+				TotalAssets: 1100, CurrentLiabilities: 180, ShortTermDebt: 30,
+				LongTermDebt: 320, TotalLiabilities: 600, RetainedEarnings: 355,
+				Equity: 500, SharesOutstanding: 100,
+			},
+			CashFlow: CashFlowStatement{OperatingCashFlow: 180, CapitalExpenditures: 80, DividendsPaid: 20},
+		},
+	}
+}
+
+// -----------------------------------------------------------------------------
+// FinancialStatements.Ratios
+// -----------------------------------------------------------------------------
+func TestFinancialRatios(t *testing.T) {
+	r := testStatements()[0].Ratios()
+	tests := []struct {
+		name      string
+		got, want float64
+	}{
+		{"current", r.CurrentRatio, 2},
+		{"quick", r.QuickRatio, 1.25},
+		{"cash", r.CashRatio, 0.5},
+		{"debt to equity", r.DebtToEquity, 1},
+		{"debt to assets", r.DebtToAssets, 0.4},
+		{"equity multiplier", r.EquityMultiplier, 2.5},
+		{"gross margin", r.GrossMargin, 0.4},
+		{"operating margin", r.OperatingMargin, 0.15},
+		{"net margin", r.NetMargin, 0.09},
+		{"ROA", r.ReturnOnAssets, 0.09},
+		{"ROE", r.ReturnOnEquity, 0.225},
+		{"asset turnover", r.AssetTurnover, 1},
+		{"interest coverage", r.InterestCoverage, 5},
+		{"debt service coverage", r.DebtServiceCoverage, 2.5},
+		{"cash flow to debt", r.CashFlowToDebt, 0.3},
+		{"free cash flow yield", r.FreeCashFlowYield, 0.05},
+	}
+	for _, tc := range tests {
+		if !almostEq(tc.got, tc.want, epsilon) {
+			t.Errorf("%s got %v, want %v", tc.name, tc.got, tc.want)
+		}
+	}
+
+	// DuPont identity: ROE = net margin * asset turnover * equity multiplier
+	if dupont := r.NetMargin * r.AssetTurnover * r.EquityMultiplier; !almostEq(dupont, r.ReturnOnEquity, epsilon) {
+		t.Errorf("DuPont ROE got %v, want %v", dupont, r.ReturnOnEquity)
+	}
+
+	// no debt: infinite coverage rather than a panic
+	var s FinancialStatements
+	s.Income.EBIT = 10
+	s.CashFlow.OperatingCashFlow = 10
+	if r := s.Ratios(); !math.IsInf(r.InterestCoverage, 1) || !math.IsInf(r.CashFlowToDebt, 1) {
+		t.Errorf("debt-free coverage got %v, %v, want +Inf", r.InterestCoverage, r.CashFlowToDebt)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Ratios
+// -----------------------------------------------------------------------------
+func TestRatios(t *testing.T) {
+	periods := testStatements()
+	analysis, err := Ratios(periods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(analysis) != 2 || !analysis[1].Date.Equal(periods[1].Date) {
+		t.Fatalf("Ratios got %d periods", len(analysis))
+	}
+	if !math.IsNaN(analysis[0].Change.CurrentRatio) || !math.IsNaN(analysis[0].Change.FreeCashFlowYield) {
+		t.Errorf("first period change got %+v, want NaN", analysis[0].Change)
+	}
+	change := analysis[1].Change
+	if !almostEq(change.CurrentRatio, 2.5-2, epsilon) {
+		t.Errorf("current ratio change got %v, want 0.5", change.CurrentRatio)
+	}
+	if !almostEq(change.DebtToEquity, 0.7-1, epsilon) {
+		t.Errorf("debt to equity change got %v, want -0.3", change.DebtToEquity)
+	}
+	if !almostEq(change.GrossMargin, 0.425-0.4, epsilon) {
+		t.Errorf("gross margin change got %v, want 0.025", change.GrossMargin)
+	}
+
+	if _, err := Ratios(nil); err == nil {
+		t.Error("Ratios(nil): expected error")
+	}
+	if _, err := Ratios([]FinancialStatements{periods[1], periods[0]}); err == nil {
+		t.Error("unordered periods: expected error")
+	}
+}
@@ -0,0 +1,164 @@
+package gofinance
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+)
+
+// Subscription is a recurring payment found by [DetectSubscriptions]:
+// Payments payments of about Amount from or to Counterparty,
+// PeriodsPerYear times a year, the First and the Last on the given dates.
+type Subscription struct {
+	Counterparty   string
+	Amount         float64
+	PeriodsPerYear int
+	First          time.Time
+	Last           time.Time
+	Payments       int
+}
+
+// subscriptionCadences are the billing frequencies recognized, per year.
+var subscriptionCadences = []int{52, 12, 4, 2, 1}
+
+// minSubscriptionPayments is the fewest payments taken as a subscription.
+const minSubscriptionPayments = 3
+
+// DetectSubscriptions finds recurring payments in raw transactions:
+// at least three payments of the same sign with the same Counterparty,
+// each Amount within amountTolerance (relative, e.g. 0.1) of the median Amount,
+// spaced at a weekly, monthly, quarterly, semiannual, or annual cadence,
+// every gap within a quarter of the cadence.
+// Subscriptions are returned ordered by Counterparty, incoming before outgoing.
+func DetectSubscriptions(ts Transactions, amountTolerance float64) []Subscription {
+	type group struct {
+		counterparty string
+		incoming     bool
+	}
+	groups := make(map[group]Transactions)
+	for _, t := range ts {
+		if t.Amount == 0 {
+			continue
+		}
+		g := group{t.Counterparty, t.Amount > 0}
+		groups[g] = append(groups[g], t)
+	}
+
+	var subs []Subscription
+	for g, txs := range groups {
+		if len(txs) < minSubscriptionPayments {
+			continue
+		}
+		slices.SortStableFunc(txs, func(a, b Transaction) int { return a.Date.Compare(b.Date) })
+
+		amounts := make([]float64, len(txs))
+		for i, t := range txs {
+			amounts[i] = t.Amount
+		}
+		amount := median(slices.Clone(amounts))
+		if slices.ContainsFunc(amounts, func(a float64) bool {
+			return math.Abs(a-amount) > amountTolerance*math.Abs(amount)
+		}) {
+			continue
+		}
+
+		gaps := make([]float64, len(txs)-1)
+		for i := range gaps {
+			gaps[i] = yearsBetween(txs[i].Date, txs[i+1].Date)
+		}
+		ppy := subscriptionCadence(gaps)
+		if ppy == 0 {
+			continue
+		}
+		subs = append(subs, Subscription{g.counterparty, amount, ppy, txs[0].Date, txs[len(txs)-1].Date, len(txs)})
+	}
+	slices.SortFunc(subs, func(a, b Subscription) int {
+		return cmp.Or(cmp.Compare(a.Counterparty, b.Counterparty), cmpIncomingFirst(a.Amount, b.Amount))
+	})
+	return subs
+}
+
+// subscriptionCadence returns the periods per year matching every gap,
+// in years, or 0 if no cadence does.
+func subscriptionCadence(gaps []float64) int {
+	for _, ppy := range subscriptionCadences {
+		period := 1 / float64(ppy)
+		if !slices.ContainsFunc(gaps, func(g float64) bool { return math.Abs(g-period) > period/4 }) {
+			return ppy
+		}
+	}
+	return 0
+}
+
+// cmpIncomingFirst orders positive amounts before negative ones.
+func cmpIncomingFirst(a, b float64) int {
+	switch {
+	case a > 0 && b < 0:
+		return -1
+	case a < 0 && b > 0:
+		return 1
+	}
+	return 0
+}
+
+// MonthlyAmount returns the subscription Amount normalized to a month.
+func (s Subscription) MonthlyAmount() float64 {
+	return s.Amount * float64(s.PeriodsPerYear) / 12
+}
+
+// Active reports whether the subscription is running at asOf:
+// it has started and its next payment is not overdue by more than
+// half a billing period.
+func (s Subscription) Active(asOf time.Time) bool {
+	return !s.First.After(asOf) && yearsBetween(s.Last, asOf) <= 1.5/float64(s.PeriodsPerYear)
+}
+
+// MRR returns the monthly recurring revenue at asOf:
+// the sum of the monthly amounts of the active subscriptions.
+func MRR(subs []Subscription, asOf time.Time) float64 {
+	mrr := 0.0
+	for _, s := range subs {
+		if s.Active(asOf) {
+			mrr += s.MonthlyAmount()
+		}
+	}
+	return mrr
+}
+
+// ARR returns the annual recurring revenue at asOf, 12 times the [MRR].
+func ARR(subs []Subscription, asOf time.Time) float64 {
+	return 12 * MRR(subs, asOf)
+}
+
+// ChurnRate returns the fraction of the subscriptions active at start that
+// are no longer active at end, 0 if none was active at start.
+func ChurnRate(subs []Subscription, start, end time.Time) float64 {
+	active, churned := 0, 0
+	for _, s := range subs {
+		if !s.Active(start) {
+			continue
+		}
+		active++
+		if !s.Active(end) {
+			churned++
+		}
+	}
+	if active == 0 {
+		return 0
+	}
+	return float64(churned) / float64(active)
+}
+
+// SubscriptionLTV returns the lifetime value of a subscriber paying
+// monthlyRevenue at the end of every month, grossMargin of which is profit,
+// who cancels with probability monthlyChurn each month, discounted at r.
+// Math details:
+//
+// LTV = \sum_{k=1}^{\infty} MonthlyRevenue * GrossMargin * (1 - MonthlyChurn)^{k-1} * DiscountFactor(k / 12)
+//
+// LTV = MonthlyRevenue * GrossMargin * d / (1 - (1 - MonthlyChurn) * d),   d = DiscountFactor(1 / 12)
+func SubscriptionLTV(monthlyRevenue, grossMargin, monthlyChurn float64, r Rate) float64 {
+	d := r.DiscountFactor(1.0 / 12)
+	return monthlyRevenue * grossMargin * d / (1 - (1-monthlyChurn)*d)
+}
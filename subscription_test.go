@@ -0,0 +1,96 @@
+package gofinance
+
+import (
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// DetectSubscriptions
+// -----------------------------------------------------------------------------
+func TestDetectSubscriptions(t *testing.T) {
+	var ts Transactions
+	for m := range 6 {
+		d := anchor.AddDate(0, m, 3)
+		ts = append(ts,
+			Transaction{Date: d, Amount: -9.99, Counterparty: "Streaming"},
+			Transaction{Date: d.AddDate(0, 0, m%2), Amount: 49 + float64(m%2), Counterparty: "Customer A"},
+			Transaction{Date: d.AddDate(0, 0, 13*m), Amount: -20, Counterparty: "Irregular"},
+		)
+	}
+	for q := range 3 {
+		ts = append(ts, Transaction{Date: anchor.AddDate(0, 3*q, 10), Amount: 300, Counterparty: "Customer B"})
+	}
+	ts = append(ts,
+		Transaction{Date: anchor, Amount: -5, Counterparty: "Once"},
+		Transaction{Date: anchor.AddDate(0, 1, 0), Amount: -500, Counterparty: "Streaming"}, // off-amount payment
+	)
+
+	subs := DetectSubscriptions(ts[:len(ts)-1], 0.05)
+	want := []struct {
+		counterparty string
+		amount       float64
+		ppy          int
+	}{
+		{"Customer A", 49, 12},
+		{"Customer B", 300, 4},
+		{"Streaming", -9.99, 12},
+	}
+	if len(subs) != len(want) {
+		t.Fatalf("DetectSubscriptions got %+v", subs)
+	}
+	for i, w := range want {
+		s := subs[i]
+		if s.Counterparty != w.counterparty || !almostEq(s.Amount, w.amount, 1.01) || s.PeriodsPerYear != w.ppy {
+			t.Errorf("subscription %d got %+v, want %+v", i, s, w)
+		}
+	}
+
+	// an off-amount payment disqualifies the series
+	if subs := DetectSubscriptions(ts, 0.05); len(subs) != 2 {
+		t.Errorf("outlier amount: got %d subscriptions, want 2", len(subs))
+	}
+}
+
+// -----------------------------------------------------------------------------
+// SaaS metrics
+// -----------------------------------------------------------------------------
+func TestSaaSMetrics(t *testing.T) {
+	day := func(m, d int) time.Time { return anchor.AddDate(0, m, d) }
+	subs := []Subscription{
+		{"a", 100, 12, day(0, 0), day(11, 0), 12},
+		{"b", 1200, 1, day(0, 0), day(12, 0), 2},
+		{"c", 50, 12, day(0, 0), day(3, 0), 4},
+		{"d", 30, 12, day(9, 0), day(11, 0), 3},
+	}
+	asOf := day(11, 10)
+	if got := MRR(subs, asOf); !almostEq(got, 100+100+30, 1e-9) {
+		t.Errorf("MRR got %f, want 230", got)
+	}
+	if got := ARR(subs, asOf); !almostEq(got, 12*230, 1e-9) {
+		t.Errorf("ARR got %f, want 2760", got)
+	}
+	// active on 2020-03-15: a, b, c; c lapses by year end
+	if got := ChurnRate(subs, day(2, 14), asOf); !almostEq(got, 1.0/3, 1e-12) {
+		t.Errorf("ChurnRate got %f, want 1/3", got)
+	}
+	if got := ChurnRate(nil, day(0, 0), asOf); got != 0 {
+		t.Errorf("ChurnRate of nothing got %f", got)
+	}
+}
+
+func TestSubscriptionLTV(t *testing.T) {
+	// without discounting LTV = margin revenue / churn
+	if got := SubscriptionLTV(100, 0.8, 0.05, RateAnnualContinuous{0}); !almostEq(got, 1600, 1e-9) {
+		t.Errorf("undiscounted LTV got %f, want 1600", got)
+	}
+	r := RateAnnualContinuous{0.12}
+	want, survive := 0.0, 1.0
+	for k := 1; k <= 2000; k++ {
+		want += 80 * survive * r.DiscountFactor(float64(k)/12)
+		survive *= 0.95
+	}
+	if got := SubscriptionLTV(100, 0.8, 0.05, r); !almostEq(got, want, 1e-9) {
+		t.Errorf("LTV got %f, want %f", got, want)
+	}
+}
@@ -0,0 +1,171 @@
+package gofinance
+
+import (
+	"errors"
+	"time"
+)
+
+// Swap represents a vanilla interest rate swap exchanging a fixed rate
+// for a floating rate on the same Notional.
+//
+// FixedRate and Spread are simple annual rates, for example 0.03 for 3%,
+// accrued over each period as a year fraction. The floating rate of each
+// period is projected off a [YieldCurve], Spread is added to it.
+// Payment dates come from [ScheduleStub] with the respective PeriodsPerYear,
+// both legs placing their odd period, if any, by Stub. Each leg accrues on
+// its own day count, FixedDayCount and FloatDayCount, actual/actual by
+// default. A non-nil Calendar moves every payment on a holiday or weekend
+// to the next business day, the periods still accruing between the
+// scheduled dates.
+//
+// PayFixed selects the side: true values the swap for the payer of the fixed leg,
+// false for the receiver.
+type Swap struct {
+	Notional            float64
+	FixedRate           float64
+	Spread              float64
+	Start               time.Time
+	End                 time.Time
+	FixedPeriodsPerYear int
+	FloatPeriodsPerYear int
+	FixedDayCount       DayCount
+	FloatDayCount       DayCount
+	Stub                StubType
+	Calendar            *BusinessCalendar
+	PayFixed            bool
+}
+
+// paymentDate returns the date a coupon scheduled on date is paid.
+func (s Swap) paymentDate(date time.Time) time.Time {
+	if s.Calendar == nil {
+		return date
+	}
+	return s.Calendar.AddBusinessDays(date, 0)
+}
+
+// FixedLeg returns the fixed coupons of the swap as positive [CashFlows].
+// Math details:
+//
+// Coupon_i = Notional * FixedRate * YearFraction(Date_{i-1}, Date_i)
+func (s Swap) FixedLeg() (CashFlows, error) {
+	dates, err := ScheduleStub(s.Start, s.End, s.FixedPeriodsPerYear, s.Stub)
+	if err != nil {
+		return nil, err
+	}
+	leg := make(CashFlows, len(dates))
+	prev := s.Start
+	for i, d := range dates {
+		leg[i] = CashFlow{s.Notional * s.FixedRate * s.FixedDayCount.YearFraction(prev, d), s.paymentDate(d)}
+		prev = d
+	}
+	return leg, nil
+}
+
+// FloatingLeg returns the projected floating coupons of the swap as positive
+// [CashFlows]. Only coupons paid after valuationDate are returned.
+// Each period's rate is the simple forward rate implied by the curve over
+// the period's FloatDayCount year fraction, so that the leg is worth the
+// Notional times DiscountFactor(Start) - DiscountFactor(End).
+// No fixings are stored, so the rate of a period already in progress
+// is projected from valuationDate to the end of the period.
+// Math details:
+//
+// Forward_i = (DiscountFactor(t_{i-1}) / DiscountFactor(t_i) - 1) / YearFraction(Date_{i-1}, Date_i)
+//
+// Coupon_i = Notional * (Forward_i + Spread) * YearFraction(Date_{i-1}, Date_i)
+func (s Swap) FloatingLeg(curve YieldCurve, valuationDate time.Time) (CashFlows, error) {
+	dates, err := ScheduleStub(s.Start, s.End, s.FloatPeriodsPerYear, s.Stub)
+	if err != nil {
+		return nil, err
+	}
+	var leg CashFlows
+	prev := s.Start
+	for _, d := range dates {
+		if d.After(valuationDate) {
+			forward := periodForward(curve, valuationDate, prev, d, s.FloatDayCount)
+			leg = append(leg, CashFlow{s.Notional * (forward + s.Spread) * s.FloatDayCount.YearFraction(prev, d), s.paymentDate(d)})
+		}
+		prev = d
+	}
+	return leg, nil
+}
+
+// futureFixedLeg returns the fixed coupons paid after valuationDate.
+func (s Swap) futureFixedLeg(valuationDate time.Time) (CashFlows, error) {
+	fixed, err := s.FixedLeg()
+	if err != nil {
+		return nil, err
+	}
+	var future CashFlows
+	for _, cf := range fixed {
+		if cf.Date.After(valuationDate) {
+			future = append(future, cf)
+		}
+	}
+	return future, nil
+}
+
+// NPV returns the value of the swap at valuationDate, from the side
+// selected by PayFixed. Coupons paid on or before valuationDate are ignored.
+// Math details:
+//
+// NPV_payer = PresentValue(FloatingLeg) - PresentValue(FixedLeg)
+//
+// NPV_receiver = -NPV_payer
+func (s Swap) NPV(curve YieldCurve, valuationDate time.Time) (float64, error) {
+	fixed, err := s.futureFixedLeg(valuationDate)
+	if err != nil {
+		return 0, err
+	}
+	floating, err := s.FloatingLeg(curve, valuationDate)
+	if err != nil {
+		return 0, err
+	}
+	npv := floating.NPVCurve(curve, valuationDate) - fixed.NPVCurve(curve, valuationDate)
+	if !s.PayFixed {
+		npv = -npv
+	}
+	return npv, nil
+}
+
+// ParRate returns the fixed rate that makes the NPV of the swap zero.
+// Math details:
+//
+// Annuity = \sum_i Notional * YearFraction_i * DiscountFactor(t_i)   over fixed dates
+//
+// ParRate = PresentValue(FloatingLeg) / Annuity
+func (s Swap) ParRate(curve YieldCurve, valuationDate time.Time) (float64, error) {
+	unit := s
+	unit.FixedRate = 1
+	annuityLeg, err := unit.futureFixedLeg(valuationDate)
+	if err != nil {
+		return 0, err
+	}
+	annuity := annuityLeg.NPVCurve(curve, valuationDate)
+	if annuity == 0 {
+		return 0, errors.New("Swap.ParRate: no fixed coupons after valuation date")
+	}
+	floating, err := s.FloatingLeg(curve, valuationDate)
+	if err != nil {
+		return 0, err
+	}
+	return floating.NPVCurve(curve, valuationDate) / annuity, nil
+}
+
+// DV01 returns the change in [Swap.NPV] when every zero rate of the curve
+// moves up by one basis point, see [YieldCurveShifted].
+// It is positive for the payer of the fixed leg and negative for the receiver.
+// Math details:
+//
+// DV01 = NPV(Curve + 0.0001) - NPV(Curve)
+func (s Swap) DV01(curve YieldCurve, valuationDate time.Time) (float64, error) {
+	base, err := s.NPV(curve, valuationDate)
+	if err != nil {
+		return 0, err
+	}
+	bumped, err := s.NPV(YieldCurveShifted{curve, 0.0001}, valuationDate)
+	if err != nil {
+		return 0, err
+	}
+	return bumped - base, nil
+}
@@ -0,0 +1,234 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Swap on a flat curve, annual legs
+// -----------------------------------------------------------------------------
+func TestSwapFlatCurve(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.04}
+	s := Swap{
+		Notional:            1_000_000,
+		FixedRate:           0.03,
+		Start:               anchor,
+		End:                 anchor.AddDate(5, 0, 0),
+		FixedPeriodsPerYear: 1,
+		FloatPeriodsPerYear: 1,
+		PayFixed:            true,
+	}
+
+	// with annual periods the floating leg telescopes to Notional * (1 - DF(5))
+	annuity := 0.0
+	for i := 1; i <= 5; i++ {
+		annuity += math.Exp(-0.04 * float64(i))
+	}
+	floatPV := 1_000_000 * (1 - math.Exp(-0.04*5))
+	wantPar := floatPV / (1_000_000 * annuity)
+
+	par, err := s.ParRate(r, anchor)
+	if err != nil {
+		t.Fatalf("ParRate error: %v", err)
+	}
+	if !almostEq(par, wantPar, 1e-10) {
+		t.Errorf("ParRate got %.10f, want %.10f", par, wantPar)
+	}
+
+	npv, err := s.NPV(r, anchor)
+	if err != nil {
+		t.Fatalf("NPV error: %v", err)
+	}
+	wantNPV := floatPV - 1_000_000*0.03*annuity
+	if !almostEq(npv, wantNPV, 1e-10) {
+		t.Errorf("payer NPV got %.6f, want %.6f", npv, wantNPV)
+	}
+
+	receiver := s
+	receiver.PayFixed = false
+	if rnpv, _ := receiver.NPV(r, anchor); !almostEq(rnpv, -npv, epsilon) {
+		t.Errorf("receiver NPV got %.6f, want %.6f", rnpv, -npv)
+	}
+
+	atPar := s
+	atPar.FixedRate = par
+	if pnpv, _ := atPar.NPV(r, anchor); math.Abs(pnpv) > 1e-6 {
+		t.Errorf("NPV at par rate got %g, want 0", pnpv)
+	}
+
+	// rates up: the payer gains, the receiver loses
+	dv01, err := s.DV01(r, anchor)
+	if err != nil {
+		t.Fatalf("DV01 error: %v", err)
+	}
+	if dv01 <= 0 {
+		t.Errorf("payer DV01 got %f, want positive", dv01)
+	}
+	if rdv01, _ := receiver.DV01(r, anchor); !almostEq(rdv01, -dv01, epsilon) {
+		t.Errorf("receiver DV01 got %f, want %f", rdv01, -dv01)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Seasoned swap on an upward-sloping curve
+// -----------------------------------------------------------------------------
+func TestSwapSeasoned(t *testing.T) {
+	curve, _ := NewYieldCurveZero([]float64{0.25, 1, 5}, []float64{0.02, 0.025, 0.035})
+	s := Swap{
+		Notional:            100,
+		FixedRate:           0.03,
+		Spread:              0.001,
+		Start:               anchor,
+		End:                 anchor.AddDate(3, 0, 0),
+		FixedPeriodsPerYear: 2,
+		FloatPeriodsPerYear: 4,
+	}
+	valuation := anchor.AddDate(0, 13, 0)
+
+	fixed, err := s.futureFixedLeg(valuation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fixed) != 4 {
+		t.Errorf("future fixed coupons got %d, want 4", len(fixed))
+	}
+	floating, err := s.FloatingLeg(curve, valuation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(floating) != 8 {
+		t.Errorf("future floating coupons got %d, want 8", len(floating))
+	}
+	for _, cf := range floating {
+		if cf.Value <= 0 {
+			t.Errorf("floating coupon %+v should be positive on this curve", cf)
+		}
+	}
+
+	par, err := s.ParRate(curve, valuation)
+	if err != nil {
+		t.Fatalf("ParRate error: %v", err)
+	}
+	s.FixedRate = par
+	if npv, _ := s.NPV(curve, valuation); math.Abs(npv) > 1e-9 {
+		t.Errorf("NPV at par rate got %g, want 0", npv)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Swap with a short last period
+// -----------------------------------------------------------------------------
+func TestSwapStub(t *testing.T) {
+	s := Swap{
+		Notional:            1_000_000,
+		FixedRate:           0.03,
+		Start:               anchor,
+		End:                 anchor.AddDate(0, 18, 0),
+		FixedPeriodsPerYear: 1,
+		FloatPeriodsPerYear: 2,
+		Stub:                StubShortLast,
+	}
+	fixed, err := s.FixedLeg()
+	if err != nil {
+		t.Fatal(err)
+	}
+	year := anchor.AddDate(1, 0, 0)
+	if len(fixed) != 2 || !fixed[0].Date.Equal(year) ||
+		!almostEq(fixed[1].Value, 1_000_000*0.03*yearsBetween(year, s.End), 1e-9) {
+		t.Errorf("fixed leg got %v", fixed)
+	}
+	floating, err := s.FloatingLeg(RateAnnualContinuous{Value: 0.04}, anchor)
+	if err != nil || len(floating) != 3 {
+		t.Errorf("floating leg got %v, %v", floating, err)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Swap with day counts and a business-day calendar
+// -----------------------------------------------------------------------------
+func TestSwapDayCountCalendar(t *testing.T) {
+	// the last payment date, 2021-01-31, is a Sunday
+	s := Swap{
+		Notional:            1_000_000,
+		FixedRate:           0.03,
+		Start:               anchor.AddDate(0, 0, 30),
+		End:                 anchor.AddDate(1, 0, 30),
+		FixedPeriodsPerYear: 2,
+		FloatPeriodsPerYear: 4,
+		FixedDayCount:       DayCountThirtyE360,
+		FloatDayCount:       DayCountActual360,
+		Calendar:            &BusinessCalendar{},
+	}
+	fixed, err := s.FixedLeg()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixed) != 2 || !almostEq(fixed[0].Value, 15_000, 1e-9) || !almostEq(fixed[1].Value, 15_000, 1e-9) {
+		t.Errorf("30E/360 fixed leg got %v, want coupons of 15000", fixed)
+	}
+	if monday := s.End.AddDate(0, 0, 1); !fixed[1].Date.Equal(monday) {
+		t.Errorf("payment on a Sunday got %v, want %v", fixed[1].Date, monday)
+	}
+	curve := RateAnnualContinuous{Value: 0.04}
+	floating, err := s.FloatingLeg(curve, anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the forward accrues on actual/360 as well, so the coupon is the
+	// growth of the notional over the period whatever the day count
+	end := s.Start.AddDate(0, 0, 90)
+	growth := curve.DiscountFactor(yearsBetween(anchor, s.Start)) / curve.DiscountFactor(yearsBetween(anchor, end))
+	if want := 1_000_000 * (growth - 1); !floating[0].Date.Equal(end) || !almostEq(floating[0].Value, want, 1e-9) {
+		t.Errorf("actual/360 floating coupon got %v, want %v on %v", floating[0], want, end)
+	}
+}
+
+func TestSwapFloatingLegParIdentity(t *testing.T) {
+	curve := YieldCurveShifted{RateAnnualContinuous{Value: 0.03}, 0.01}
+	for _, dc := range []DayCount{DayCountActualActual, DayCountActual360, DayCountThirty360, DayCountThirtyE360} {
+		s := Swap{
+			Notional:            1_000_000,
+			Start:               anchor.AddDate(0, 2, 14),
+			End:                 anchor.AddDate(5, 2, 14),
+			FixedPeriodsPerYear: 1,
+			FloatPeriodsPerYear: 4,
+			FloatDayCount:       dc,
+		}
+		floating, err := s.FloatingLeg(curve, anchor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := floating.NPVCurve(curve, anchor)
+		want := s.Notional * (curve.DiscountFactor(yearsBetween(anchor, s.Start)) - curve.DiscountFactor(yearsBetween(anchor, s.End)))
+		if !almostEq(got, want, 1e-6) {
+			t.Errorf("day count %d: floating leg PV got %.6f, want %.6f", dc, got, want)
+		}
+	}
+}
+
+func TestSwapErrors(t *testing.T) {
+	r := RateAnnualContinuous{Value: 0.04}
+	bad := Swap{Notional: 1, Start: anchor, End: anchor.AddDate(1, 0, 0), FixedPeriodsPerYear: 5, FloatPeriodsPerYear: 4}
+	if _, err := bad.NPV(r, anchor); err == nil {
+		t.Error("expected error for invalid fixed frequency")
+	}
+	if _, err := bad.ParRate(r, anchor); err == nil {
+		t.Error("expected ParRate error for invalid fixed frequency")
+	}
+	if _, err := bad.DV01(r, anchor); err == nil {
+		t.Error("expected DV01 error for invalid fixed frequency")
+	}
+	bad.FixedPeriodsPerYear, bad.FloatPeriodsPerYear = 1, 5
+	if _, err := bad.NPV(r, anchor); err == nil {
+		t.Error("expected error for invalid floating frequency")
+	}
+	if _, err := bad.ParRate(r, anchor); err == nil {
+		t.Error("expected ParRate error for invalid floating frequency")
+	}
+
+	matured := Swap{Notional: 1, Start: anchor, End: anchor.AddDate(1, 0, 0), FixedPeriodsPerYear: 1, FloatPeriodsPerYear: 1}
+	if _, err := matured.ParRate(r, anchor.AddDate(2, 0, 0)); err == nil {
+		t.Error("expected ParRate error after maturity")
+	}
+}
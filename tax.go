@@ -0,0 +1,58 @@
+package gofinance
+
+import (
+	"errors"
+	"time"
+)
+
+// AfterTax converts pre-tax operating cash-flows into after-tax cash-flows:
+// each flow is taxed at taxRate and each depreciation expense, given as a
+// positive Value dated when it is deducted, adds its tax shield.
+// Taxes are assumed paid when the flows occur, and losses are assumed to
+// offset other taxable income, so they yield a tax credit.
+// Capital expenditures and other untaxed flows should be added to the result.
+// The result is sorted by Date.
+// Math details:
+//
+// AfterTax_t = OperatingFlow_t * (1 - TaxRate) + Depreciation_t * TaxRate
+func (cfs CashFlows) AfterTax(taxRate float64, depreciation CashFlows) CashFlows {
+	out := make(CashFlows, 0, len(cfs)+len(depreciation))
+	for _, cf := range cfs {
+		out = append(out, CashFlow{cf.Value * (1 - taxRate), cf.Date})
+	}
+	for _, d := range depreciation {
+		out = append(out, CashFlow{d.Value * taxRate, d.Date})
+	}
+	out.Sort()
+	return out
+}
+
+// CashFlows dates the schedule's expenses at the end of each period,
+// the first period starting at start, periodsPerYear periods a year,
+// for use as the depreciation of [CashFlows.AfterTax].
+func (s DepreciationSchedule) CashFlows(start time.Time, periodsPerYear int) (CashFlows, error) {
+	if periodsPerYear <= 0 || 12%periodsPerYear != 0 {
+		return nil, errors.New("DepreciationSchedule.CashFlows requires periodsPerYear to divide 12")
+	}
+	cfs := make(CashFlows, len(s))
+	for i, p := range s {
+		cfs[i] = CashFlow{p.Expense, addMonths(start, 12*(i+1)/periodsPerYear)}
+	}
+	return cfs, nil
+}
+
+// WACC returns the weighted average cost of capital of a firm with the given
+// market values of equity and debt, as an annual effective [Rate] to discount
+// the firm's after-tax cash-flows with. Interest being tax deductible,
+// the cost of debt enters after tax.
+// Math details:
+//
+// WACC = E / (E + D) * CostOfEquity + D / (E + D) * CostOfDebt * (1 - TaxRate)
+func WACC(equity, debt float64, costOfEquity, costOfDebt Rate, taxRate float64) (Rate, error) {
+	if equity < 0 || debt < 0 || equity+debt == 0 {
+		return nil, errors.New("WACC requires non-negative equity and debt, not both zero")
+	}
+	v := equity + debt
+	wacc := equity/v*costOfEquity.RateAnnualEffective() + debt/v*costOfDebt.RateAnnualEffective()*(1-taxRate)
+	return RateEffective{wacc, 1}, nil
+}
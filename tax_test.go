@@ -0,0 +1,56 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// After-tax cash-flows
+// -----------------------------------------------------------------------------
+func TestAfterTax(t *testing.T) {
+	y1, y2 := anchor.AddDate(1, 0, 0), anchor.AddDate(2, 0, 0)
+	operating := CashFlows{{500, y2}, {400, y1}}
+	depreciation := CashFlows{{300, y1}, {300, y2}}
+	got := operating.AfterTax(0.25, depreciation)
+	want := CashFlows{{75, y1}, {300, y1}, {75, y2}, {375, y2}}
+	if len(got) != len(want) {
+		t.Fatalf("AfterTax got %d flows, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !got[i].Equal(want[i], 1e-12) {
+			t.Errorf("AfterTax flow %d got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDepreciationScheduleCashFlows(t *testing.T) {
+	s, _ := DepreciationStraightLine(1200, 0, 4)
+	cfs, err := s.CashFlows(anchor, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, cf := range cfs {
+		if cf.Value != 300 || !cf.Date.Equal(anchor.AddDate(0, 6*(i+1), 0)) {
+			t.Errorf("flow %d got %+v", i, cf)
+		}
+	}
+	for _, ppy := range []int{0, -1, 5} {
+		if _, err := s.CashFlows(anchor, ppy); err == nil {
+			t.Errorf("CashFlows accepted %d periods per year", ppy)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// WACC
+// -----------------------------------------------------------------------------
+func TestWACC(t *testing.T) {
+	r, err := WACC(600, 400, RateEffective{0.10, 1}, RateEffective{0.05, 1}, 0.25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.RateAnnualEffective(); !almostEq(got, 0.6*0.10+0.4*0.05*0.75, 1e-12) {
+		t.Errorf("WACC got %f, want 0.075", got)
+	}
+	if _, err := WACC(0, 0, RateEffective{0.1, 1}, RateEffective{0.05, 1}, 0.25); err == nil {
+		t.Error("WACC accepted zero capital")
+	}
+}
@@ -133,7 +133,7 @@ func parseStringToMidTime(input string) (mid time.Time, err error) {
 			}
 		}
 	}
-	err = errors.New("unsupported time format: " + input)
+	err = ErrUnsupportedTimeFormat{input}
 	return
 }
 
@@ -156,6 +156,7 @@ func parseStringToMidTime(input string) (mid time.Time, err error) {
 //   - YYYY-MM-DD HH:MM:SS.mmm, YYYY/MM/DD HH:MM:SS.mmm, YYYY.MM.DD HH:MM:SS.mmm
 //
 // The function always returns a time in UTC, so UTC location is forced.
+// Unparsable input returns an [ErrUnsupportedTimeFormat].
 func StringToTime(periods ...string) (time.Time, error) {
 	if len(periods) == 0 || len(periods) > 2 {
 		return time.Time{}, errors.New("StringToTime requires 1 or 2 period strings")
@@ -0,0 +1,207 @@
+package gofinance
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// Observation is a dated value of a [TimeSeries], a price or a return.
+type Observation struct {
+	Date  time.Time
+	Value float64
+}
+
+// TimeSeries is a sequence of dated observations, in date order for all
+// methods below; [TimeSeries.Sort] puts it in order.
+type TimeSeries []Observation
+
+// Sort sorts the observations by Date, keeping the order of equal dates.
+func (s TimeSeries) Sort() {
+	slices.SortStableFunc(s, func(a, b Observation) int { return a.Date.Compare(b.Date) })
+}
+
+// Values returns the observed values in order.
+func (s TimeSeries) Values() []float64 {
+	values := make([]float64, len(s))
+	for i, o := range s {
+		values[i] = o.Value
+	}
+	return values
+}
+
+// Dates returns the observation dates in order.
+func (s TimeSeries) Dates() []time.Time {
+	dates := make([]time.Time, len(s))
+	for i, o := range s {
+		dates[i] = o.Date
+	}
+	return dates
+}
+
+// SimpleReturns turns a price series into the simple returns between
+// consecutive prices, each dated at the end of its period.
+// Math details:
+//
+// R_t = P_t / P_{t-1} - 1
+func (s TimeSeries) SimpleReturns() TimeSeries {
+	if len(s) < 2 {
+		return nil
+	}
+	returns := make(TimeSeries, len(s)-1)
+	for i := 1; i < len(s); i++ {
+		returns[i-1] = Observation{s[i].Date, s[i].Value/s[i-1].Value - 1}
+	}
+	return returns
+}
+
+// LogReturns turns a price series into log returns, like [TimeSeries.SimpleReturns].
+// Math details:
+//
+// r_t = ln(P_t / P_{t-1})
+func (s TimeSeries) LogReturns() TimeSeries {
+	return s.SimpleReturns().ToLog()
+}
+
+// ToLog converts simple returns into log returns.
+// Math details:
+//
+// r = ln(1 + R)
+func (s TimeSeries) ToLog() TimeSeries {
+	out := slices.Clone(s)
+	for i := range out {
+		out[i].Value = math.Log1p(out[i].Value)
+	}
+	return out
+}
+
+// ToSimple converts log returns into simple returns.
+// Math details:
+//
+// R = e^r - 1
+func (s TimeSeries) ToSimple() TimeSeries {
+	out := slices.Clone(s)
+	for i := range out {
+		out[i].Value = math.Expm1(out[i].Value)
+	}
+	return out
+}
+
+// CumulativeGrowth returns the growth of 1 invested over a series of simple
+// returns, the wealth index after each period.
+// Math details:
+//
+// Growth_t = \prod_{s <= t} (1 + R_s)
+func (s TimeSeries) CumulativeGrowth() TimeSeries {
+	out := slices.Clone(s)
+	growth := 1.0
+	for i := range out {
+		growth *= 1 + out[i].Value
+		out[i].Value = growth
+	}
+	return out
+}
+
+// Aggregation combines the observations of a period when resampling.
+type Aggregation int
+
+const (
+	// AggregateLast keeps the last observation, for prices and balances.
+	AggregateLast Aggregation = iota
+	// AggregateCompound compounds simple returns.
+	AggregateCompound
+	// AggregateSum adds the values, for log returns and flows.
+	AggregateSum
+)
+
+// ResampleMonthly combines the observations of each calendar month (UTC)
+// with agg, dating the result at the month's last observation.
+// For example daily prices resample with [AggregateLast],
+// daily simple returns with [AggregateCompound].
+func (s TimeSeries) ResampleMonthly(agg Aggregation) TimeSeries {
+	var out TimeSeries
+	for i := 0; i < len(s); {
+		month := monthIndex(s[i].Date)
+		j := i
+		for j < len(s) && monthIndex(s[j].Date) == month {
+			j++
+		}
+		v := 0.0
+		switch agg {
+		case AggregateLast:
+			v = s[j-1].Value
+		case AggregateCompound:
+			growth := 1.0
+			for _, o := range s[i:j] {
+				growth *= 1 + o.Value
+			}
+			v = growth - 1
+		case AggregateSum:
+			for _, o := range s[i:j] {
+				v += o.Value
+			}
+		}
+		out = append(out, Observation{s[j-1].Date, v})
+		i = j
+	}
+	return out
+}
+
+// Rolling applies f to every window of window consecutive observations,
+// dating each result at the end of its window.
+//
+//	monthly := returns.Rolling(21, func(w TimeSeries) float64 {
+//		return w.CumulativeGrowth()[len(w)-1].Value - 1
+//	})
+func (s TimeSeries) Rolling(window int, f func(TimeSeries) float64) TimeSeries {
+	if window <= 0 || window > len(s) {
+		return nil
+	}
+	out := make(TimeSeries, len(s)-window+1)
+	for i := range out {
+		out[i] = Observation{s[i+window-1].Date, f(s[i : i+window])}
+	}
+	return out
+}
+
+// Align keeps the dates observed in every series and returns them together
+// with the values of each series on those dates, values[k][i] being series k
+// on dates[i], ready for [NewPortfolio]. Dates are matched exactly,
+// a date repeated within a series takes its last value.
+func Align(series ...TimeSeries) (dates []time.Time, values [][]float64) {
+	if len(series) == 0 {
+		return nil, nil
+	}
+	count := make(map[time.Time]int)
+	for _, s := range series {
+		seen := make(map[time.Time]bool)
+		for _, o := range s {
+			d := o.Date.UTC()
+			if !seen[d] {
+				seen[d] = true
+				count[d]++
+			}
+		}
+	}
+	for d, n := range count {
+		if n == len(series) {
+			dates = append(dates, d)
+		}
+	}
+	slices.SortFunc(dates, func(a, b time.Time) int { return a.Compare(b) })
+
+	index := make(map[time.Time]int, len(dates))
+	for i, d := range dates {
+		index[d] = i
+	}
+	values = make([][]float64, len(series))
+	for k, s := range series {
+		values[k] = make([]float64, len(dates))
+		for _, o := range s {
+			if i, ok := index[o.Date.UTC()]; ok {
+				values[k][i] = o.Value
+			}
+		}
+	}
+	return dates, values
+}
@@ -0,0 +1,104 @@
+package gofinance
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// Returns
+// -----------------------------------------------------------------------------
+func TestTimeSeriesReturns(t *testing.T) {
+	prices := TimeSeries{
+		{anchor.AddDate(0, 0, 2), 121},
+		{anchor, 100},
+		{anchor.AddDate(0, 0, 1), 110},
+	}
+	prices.Sort()
+	simple := prices.SimpleReturns()
+	if len(simple) != 2 || !almostEq(simple[0].Value, 0.1, 1e-15) || !almostEq(simple[1].Value, 0.1, 1e-15) || !simple[1].Date.Equal(anchor.AddDate(0, 0, 2)) {
+		t.Errorf("SimpleReturns got %+v", simple)
+	}
+	logs := prices.LogReturns()
+	if !almostEq(logs[0].Value, math.Log(1.1), 1e-15) {
+		t.Errorf("LogReturns got %+v", logs)
+	}
+	back := logs.ToSimple()
+	if !almostEq(back[1].Value, 0.1, 1e-15) {
+		t.Errorf("ToSimple got %+v", back)
+	}
+	growth := simple.CumulativeGrowth()
+	if !almostEq(growth[1].Value, 1.21, 1e-15) {
+		t.Errorf("CumulativeGrowth got %+v", growth)
+	}
+	if (TimeSeries{{anchor, 1}}).SimpleReturns() != nil {
+		t.Error("SimpleReturns of one price is not empty")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Resampling and rolling windows
+// -----------------------------------------------------------------------------
+func TestTimeSeriesResampleMonthly(t *testing.T) {
+	s := TimeSeries{
+		{anchor, 0.01},
+		{anchor.AddDate(0, 0, 15), 0.02},
+		{anchor.AddDate(0, 1, 3), -0.01},
+	}
+	tests := []struct {
+		agg  Aggregation
+		want []float64
+	}{
+		{AggregateLast, []float64{0.02, -0.01}},
+		{AggregateCompound, []float64{1.01*1.02 - 1, -0.01}},
+		{AggregateSum, []float64{0.03, -0.01}},
+	}
+	for _, tt := range tests {
+		got := s.ResampleMonthly(tt.agg)
+		if len(got) != 2 || !got[0].Date.Equal(anchor.AddDate(0, 0, 15)) {
+			t.Errorf("aggregation %d got %+v", tt.agg, got)
+			continue
+		}
+		for i := range tt.want {
+			if !almostEq(got[i].Value, tt.want[i], 1e-15) {
+				t.Errorf("aggregation %d month %d got %f, want %f", tt.agg, i, got[i].Value, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestTimeSeriesRolling(t *testing.T) {
+	var s TimeSeries
+	for i := range 5 {
+		s = append(s, Observation{anchor.AddDate(0, 0, i), float64(i)})
+	}
+	sum := func(w TimeSeries) float64 { return w.Values()[0] + w.Values()[len(w)-1] }
+	got := s.Rolling(3, sum)
+	if !slices.Equal(got.Values(), []float64{2, 4, 6}) || !got[0].Date.Equal(anchor.AddDate(0, 0, 2)) {
+		t.Errorf("Rolling got %+v", got)
+	}
+	if s.Rolling(6, sum) != nil {
+		t.Error("Rolling with a window longer than the series is not empty")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Align
+// -----------------------------------------------------------------------------
+func TestAlign(t *testing.T) {
+	d := func(i int) time.Time { return anchor.AddDate(0, 0, i) }
+	a := TimeSeries{{d(0), 1}, {d(1), 2}, {d(2), 3}, {d(4), 5}}
+	b := TimeSeries{{d(4), 50}, {d(1), 20}, {d(3), 40}, {d(2), 30}}
+	dates, values := Align(a, b)
+	if !slices.EqualFunc(dates, []time.Time{d(1), d(2), d(4)}, time.Time.Equal) {
+		t.Errorf("Align dates got %v", dates)
+	}
+	if !slices.Equal(values[0], []float64{2, 3, 5}) || !slices.Equal(values[1], []float64{20, 30, 50}) {
+		t.Errorf("Align values got %v", values)
+	}
+	if _, err := NewPortfolio([]float64{0.5, 0.5}, values, 252); err != nil {
+		t.Errorf("aligned series rejected by NewPortfolio: %v", err)
+	}
+}
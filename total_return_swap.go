@@ -0,0 +1,103 @@
+package gofinance
+
+import "time"
+
+// TotalReturnSwap exchanges the total return of Quantity shares, price
+// change plus dividends, for financing on their value at a floating rate
+// plus Spread, a simple annual rate. Both legs reset on the dates of
+// [Schedule] with PeriodsPerYear: each period's financing accrues on the
+// value of the shares at its start, and its price change is paid at its end
+// together with the dividends going ex in it, valued with Treatment.
+//
+// ReceiveTotalReturn selects the side: true values the swap for the
+// receiver of the total return, who pays the financing, false for the payer.
+type TotalReturnSwap struct {
+	Quantity           float64
+	Spread             float64
+	Start              time.Time
+	End                time.Time
+	PeriodsPerYear     int
+	Dividends          []Dividend
+	Treatment          DividendTreatment
+	ReceiveTotalReturn bool
+}
+
+// forwardValue returns the present value of one share delivered at date:
+// the spot less the present value of the dividends it pays until then.
+// Math details:
+//
+// ForwardValue(t) = Spot - \sum_{ExDate in (ValuationDate, t]} Amount * DiscountFactor(PayDate)
+func (s TotalReturnSwap) forwardValue(spot float64, date time.Time, curve YieldCurve, valuationDate time.Time) float64 {
+	pv := spot
+	for _, d := range s.Dividends {
+		if d.ExDate.After(valuationDate) && !d.ExDate.After(date) {
+			pv -= d.Amount * curve.DiscountFactor(yearsBetween(valuationDate, d.PayDate))
+		}
+	}
+	return pv
+}
+
+// Legs returns the present values at valuationDate of the total-return
+// leg and of the financing leg, both as positive amounts for periods
+// ending after valuationDate. The spot and the price fixed at the start of
+// the period in progress are read from prices. Like [Swap.FloatingLeg],
+// the floating rate of the period in progress is projected from valuationDate.
+// Math details:
+//
+// Fix_i = Price(t_{i-1}) if t_{i-1} <= ValuationDate, else ForwardValue(t_{i-1}) / DiscountFactor(t_{i-1})
+//
+// TotalReturn_i = Quantity * (ForwardValue(t_i) - Fix_i * DiscountFactor(t_i) + \sum_{ExDate in period} Net * DiscountFactor(t_i))
+//
+// Financing_i = Quantity * Fix_i * (Forward_i + Spread) * YearFraction(t_{i-1}, t_i) * DiscountFactor(t_i)
+func (s TotalReturnSwap) Legs(prices PriceIndex, curve YieldCurve, valuationDate time.Time) (totalReturn, financing float64, err error) {
+	dates, err := Schedule(s.Start, s.End, s.PeriodsPerYear)
+	if err != nil {
+		return 0, 0, err
+	}
+	spot, err := prices.Level(valuationDate)
+	if err != nil {
+		return 0, 0, err
+	}
+	prev := s.Start
+	for _, d := range dates {
+		if d.After(valuationDate) {
+			t1 := max(yearsBetween(valuationDate, prev), 0)
+			t2 := yearsBetween(valuationDate, d)
+			df := curve.DiscountFactor(t2)
+
+			var fix float64
+			if prev.After(valuationDate) {
+				fix = s.forwardValue(spot, prev, curve, valuationDate) / curve.DiscountFactor(t1)
+			} else if fix, err = prices.Level(prev); err != nil {
+				return 0, 0, err
+			}
+
+			dividends := dividendsIn(s.Dividends, prev, d, s.Treatment.Net)
+			totalReturn += s.Quantity * (s.forwardValue(spot, d, curve, valuationDate) - fix*df + dividends*df)
+
+			forward := ForwardRate(curve, t1, t2, ConventionSimple)
+			financing += s.Quantity * fix * (forward + s.Spread) * yearsBetween(prev, d) * df
+		}
+		prev = d
+	}
+	return totalReturn, financing, nil
+}
+
+// NPV returns the value of the swap at valuationDate, from the side
+// selected by ReceiveTotalReturn, see [TotalReturnSwap.Legs].
+// Math details:
+//
+// NPV_receiver = \sum_i TotalReturn_i - \sum_i Financing_i
+//
+// NPV_payer = -NPV_receiver
+func (s TotalReturnSwap) NPV(prices PriceIndex, curve YieldCurve, valuationDate time.Time) (float64, error) {
+	totalReturn, financing, err := s.Legs(prices, curve, valuationDate)
+	if err != nil {
+		return 0, err
+	}
+	npv := totalReturn - financing
+	if !s.ReceiveTotalReturn {
+		npv = -npv
+	}
+	return npv, nil
+}
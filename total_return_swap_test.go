@@ -0,0 +1,91 @@
+package gofinance
+
+import (
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// TotalReturnSwap
+// -----------------------------------------------------------------------------
+func TestTotalReturnSwapAtInception(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	curve := RateAnnualContinuous{0.04}
+	prices := TimeSeries{{start, 50}}
+	trs := TotalReturnSwap{
+		Quantity:       1000,
+		Start:          start,
+		End:            start.AddDate(1, 0, 0),
+		PeriodsPerYear: 4,
+		Dividends: []Dividend{
+			// dividends passed through when paid, at the end of their period
+			{ExDate: start.AddDate(0, 2, 0), PayDate: start.AddDate(0, 3, 0), Amount: 1},
+			{ExDate: start.AddDate(0, 8, 0), PayDate: start.AddDate(0, 9, 0), Amount: 1.2},
+		},
+		ReceiveTotalReturn: true,
+	}
+
+	// with no spread the total return is worth its financing
+	npv, err := trs.NPV(prices, curve, start)
+	if err != nil || !almostEq(npv, 0, 1e-9) {
+		t.Errorf("NPV at par got %f, %v, want 0", npv, err)
+	}
+
+	// a spread costs the receiver its present value on the forward share value
+	trs.Spread = 0.01
+	npv, _ = trs.NPV(prices, curve, start)
+	want := 0.0
+	dates, _ := Schedule(trs.Start, trs.End, trs.PeriodsPerYear)
+	prev := start
+	for _, d := range dates {
+		fwd := trs.forwardValue(50, prev, curve, start) / curve.DiscountFactor(yearsBetween(start, prev))
+		want -= 1000 * fwd * 0.01 * yearsBetween(prev, d) * curve.DiscountFactor(yearsBetween(start, d))
+		prev = d
+	}
+	if !almostEq(npv, want, 1e-9) {
+		t.Errorf("NPV with spread got %f, want %f", npv, want)
+	}
+
+	trs.ReceiveTotalReturn = false
+	if payer, _ := trs.NPV(prices, curve, start); !almostEq(payer, -want, 1e-9) {
+		t.Errorf("payer NPV got %f, want %f", payer, -want)
+	}
+}
+
+func TestTotalReturnSwapMidPeriod(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	valuation := start.AddDate(0, 4, 0)
+	curve := RateAnnualContinuous{0.03}
+	prices := TimeSeries{{start, 40}, {valuation, 44}}
+	trs := TotalReturnSwap{
+		Quantity:           10,
+		Spread:             0.005,
+		Start:              start,
+		End:                start.AddDate(1, 0, 0),
+		PeriodsPerYear:     1,
+		Dividends:          []Dividend{{ExDate: start.AddDate(0, 2, 0), PayDate: start.AddDate(0, 3, 0), Amount: 2}},
+		Treatment:          DividendTreatment{Withholding: 0.15},
+		ReceiveTotalReturn: true,
+	}
+	totalReturn, financing, err := trs.Legs(prices, curve, valuation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// price fixed at 40 at the start; the dividend already went ex and is paid at the end
+	t2 := yearsBetween(valuation, trs.End)
+	df := curve.DiscountFactor(t2)
+	wantTR := 10 * (44 - 40*df + 1.7*df)
+	wantFin := 10 * 40 * ((1/df-1)/t2 + 0.005) * 1 * df
+	if !almostEq(totalReturn, wantTR, 1e-9) || !almostEq(financing, wantFin, 1e-9) {
+		t.Errorf("Legs got %f, %f, want %f, %f", totalReturn, financing, wantTR, wantFin)
+	}
+
+	// without a price at the reset the fixing is missing
+	if _, err := trs.NPV(TimeSeries{{valuation, 44}}, curve, valuation); err == nil {
+		t.Error("NPV accepted a missing fixing")
+	}
+	trs.PeriodsPerYear = 5
+	if _, err := trs.NPV(prices, curve, valuation); err == nil {
+		t.Error("NPV accepted an invalid schedule")
+	}
+}
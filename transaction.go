@@ -0,0 +1,42 @@
+package gofinance
+
+import "time"
+
+// Transaction is a single booked entry of an account statement, as imported
+// from a bank or payment provider export.
+// A positive Amount is money in, a negative Amount money out, matching [CashFlow].
+type Transaction struct {
+	Date         time.Time
+	Amount       float64
+	Counterparty string
+	Description  string
+	Category     string
+}
+
+// CashFlow converts the transaction into a [CashFlow] for the analytics.
+func (t Transaction) CashFlow() CashFlow {
+	return CashFlow{t.Amount, t.Date}
+}
+
+// Transactions is a helper alias for a slice of [Transaction].
+type Transactions []Transaction
+
+// CashFlows converts every transaction into a [CashFlow], keeping the order.
+func (ts Transactions) CashFlows() CashFlows {
+	cfs := make(CashFlows, len(ts))
+	for i, t := range ts {
+		cfs[i] = t.CashFlow()
+	}
+	return cfs
+}
+
+// Statement is one account statement: a numbered period with opening and
+// closing balances and the transactions booked in between.
+type Statement struct {
+	Sequence       int
+	Start          time.Time
+	End            time.Time
+	OpeningBalance float64
+	ClosingBalance float64
+	Transactions   Transactions
+}
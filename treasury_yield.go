@@ -0,0 +1,243 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// BankDiscountFromBondEquivalent returns the bank discount rate of a bill
+// with days to maturity quoted at the bond-equivalent yield bey, the
+// inverse of [RateBankDiscount.BondEquivalentYield].
+// Math details:
+//
+// Price = 1 / (1 + BondEquivalentYield * Days / 365)   Days <= 182
+//
+// Price = 1 / ((1 + BondEquivalentYield / 2) * (1 + BondEquivalentYield * (Days / 365 - 1 / 2)))   Days > 182
+func BankDiscountFromBondEquivalent(bey, days, daysPerYear float64) RateBankDiscount {
+	t := days / 365
+	price := 1 / (1 + bey*t)
+	if days > 182 {
+		price = 1 / ((1 + bey/2) * (1 + bey*(t-0.5)))
+	}
+	return BankDiscountFromPrice(price, days, daysPerYear)
+}
+
+// BankDiscountFromRate returns the bank discount rate of a bill with days
+// to maturity priced at any [Rate], converting through the price with
+// years of 365 days.
+func BankDiscountFromRate(r Rate, days, daysPerYear float64) RateBankDiscount {
+	return BankDiscountFromPrice(r.DiscountFactor(days/365), days, daysPerYear)
+}
+
+// EffectiveYield returns the annual effective yield of a bill with days
+// to maturity, its price compounded to a 365-day year.
+// Math details:
+//
+// EffectiveYield = (1 / Price)^{365 / Days} - 1
+func (r RateBankDiscount) EffectiveYield(days float64) RateEffective {
+	return RateEffective{math.Pow(r.DiscountFactorDays(days), -365/days) - 1, 1}
+}
+
+// TreasuryNote is a US Treasury note or bond: semiannual coupons of
+// CouponRate / 2 of Face on the dates rolled back from Maturity in steps
+// of six months. The first coupon is paid on FirstCoupon, or when zero
+// on the first of those dates after Issue, and is prorated for a short
+// or long first period as Treasury does.
+//
+// Yields are quoted by the street convention of the Treasury and SIFMA
+// on quasi-coupon periods, see [TreasuryNote.StreetYield]; [Bond] is
+// the general bond accruing on actual/actual.
+type TreasuryNote struct {
+	Face        float64
+	CouponRate  float64
+	Issue       time.Time
+	FirstCoupon time.Time
+	Maturity    time.Time
+}
+
+// quasiCoupons returns the six-monthly dates rolled back from Maturity to
+// on or before from, and one beyond Maturity for payments rolled past it.
+func (n TreasuryNote) quasiCoupons(from time.Time) []time.Time {
+	var back []time.Time
+	for k := -1; ; k++ {
+		d := addMonths(n.Maturity, -6*k)
+		back = append(back, d)
+		if !d.After(from) {
+			break
+		}
+	}
+	dates := make([]time.Time, len(back))
+	for i, d := range back {
+		dates[len(back)-1-i] = d
+	}
+	return dates
+}
+
+// periods returns the quasi-coupon periods from a to b on the six-monthly
+// dates of the Maturity, see [quasiPeriods].
+func (n TreasuryNote) periods(a, b time.Time) float64 {
+	return quasiPeriods(n.Maturity, 6, a, b)
+}
+
+// quasiPeriods returns the quasi-coupon periods from a to b on the dates
+// rolled every months months from anchor, each period counted as its
+// share of actual days.
+// Math details:
+//
+// Periods = \sum_j Days([a, b] ∩ [q_{j-1}, q_j]) / Days(q_{j-1}, q_j)
+func quasiPeriods(anchor time.Time, months int, a, b time.Time) float64 {
+	k := 0
+	for addMonths(anchor, k*months).After(a) {
+		k--
+	}
+	for !addMonths(anchor, (k+1)*months).After(a) {
+		k++
+	}
+	total := 0.0
+	for q0 := addMonths(anchor, k*months); q0.Before(b); k++ {
+		q1 := addMonths(anchor, (k+1)*months)
+		lo, hi := a, b
+		if q0.After(lo) {
+			lo = q0
+		}
+		if q1.Before(hi) {
+			hi = q1
+		}
+		if hi.After(lo) {
+			total += hi.Sub(lo).Hours() / q1.Sub(q0).Hours()
+		}
+		q0 = q1
+	}
+	return total
+}
+
+// firstCoupon returns the date of the first coupon.
+func (n TreasuryNote) firstCoupon() time.Time {
+	if !n.FirstCoupon.IsZero() {
+		return n.FirstCoupon
+	}
+	q := n.quasiCoupons(n.Issue)
+	return q[1]
+}
+
+// validate checks the dates of the note.
+func (n TreasuryNote) validate() error {
+	if n.Face <= 0 || !n.Maturity.After(n.Issue) {
+		return errors.New("TreasuryNote requires a positive Face and Maturity after Issue")
+	}
+	first := n.firstCoupon()
+	if !first.After(n.Issue) || first.After(n.Maturity) {
+		return errors.New("TreasuryNote requires FirstCoupon between Issue and Maturity")
+	}
+	for _, d := range n.quasiCoupons(n.Issue) {
+		if d.Equal(first) {
+			return nil
+		}
+	}
+	return errors.New("TreasuryNote requires FirstCoupon on a coupon date of the Maturity")
+}
+
+// payments returns the scheduled coupons and the principal after settlement.
+func (n TreasuryNote) payments(settlement time.Time) CashFlows {
+	coupon := n.Face * n.CouponRate / 2
+	first := n.firstCoupon()
+	var flows CashFlows
+	for _, d := range n.quasiCoupons(n.Issue) {
+		if d.Before(first) || d.After(n.Maturity) || !d.After(settlement) {
+			continue
+		}
+		c := coupon
+		if d.Equal(first) {
+			c = coupon * n.periods(n.Issue, first)
+		}
+		flows = append(flows, CashFlow{c, d})
+	}
+	return append(flows, CashFlow{n.Face, n.Maturity})
+}
+
+// AccruedInterest returns the coupon accrued at settlement since the last
+// coupon, or since Issue before the first, over quasi-coupon periods.
+// Math details:
+//
+// AccruedInterest = Face * CouponRate / 2 * Periods(LastCoupon, Settlement)
+func (n TreasuryNote) AccruedInterest(settlement time.Time) (float64, error) {
+	if err := n.validate(); err != nil {
+		return 0, err
+	}
+	last := n.Issue
+	for _, d := range n.quasiCoupons(n.Issue) {
+		if !d.Before(n.firstCoupon()) && !d.After(settlement) {
+			last = d
+		}
+	}
+	return n.Face * n.CouponRate / 2 * n.periods(last, settlement), nil
+}
+
+// price returns the clean price at the semiannual yield with every
+// payment discounted over the quasi-coupon periods to its date, rolled
+// to a business day of calendar when it is not nil.
+func (n TreasuryNote) price(yield float64, settlement time.Time, calendar *BusinessCalendar) float64 {
+	dirty := 0.0
+	for _, cf := range n.payments(settlement) {
+		d := cf.Date
+		if calendar != nil {
+			d = calendar.AddBusinessDays(d, 0)
+		}
+		dirty += cf.Value * math.Pow(1+yield/2, -n.periods(settlement, d))
+	}
+	accrued, _ := n.AccruedInterest(settlement)
+	return dirty - accrued
+}
+
+// Price returns the clean price at settlement at a street yield.
+// Math details:
+//
+// Price = \sum_k CashFlow_k * (1 + Yield / 2)^{-Periods(Settlement, Date_k)} - AccruedInterest
+func (n TreasuryNote) Price(yield float64, settlement time.Time) (float64, error) {
+	if err := n.validate(); err != nil {
+		return 0, err
+	}
+	if !settlement.Before(n.Maturity) {
+		return 0, errors.New("TreasuryNote.Price requires settlement before Maturity")
+	}
+	return n.price(yield, settlement, nil), nil
+}
+
+// solveYield finds the yield at which the clean price is price.
+func (n TreasuryNote) solveYield(price float64, settlement time.Time, calendar *BusinessCalendar, opts []SolverOptions) (float64, error) {
+	if err := n.validate(); err != nil {
+		return 0, err
+	}
+	if !settlement.Before(n.Maturity) {
+		return 0, errors.New("TreasuryNote requires settlement before Maturity")
+	}
+	f := func(y float64) float64 { return n.price(y, settlement, calendar) - price }
+	lo, hi := -1.9, 1.0
+	for f(hi) > 0 && hi < 1000 {
+		hi *= 2
+	}
+	y, err := brent(f, lo, hi, solverOptions(opts))
+	if err != nil {
+		return 0, fmt.Errorf("TreasuryNote yield: %w", err)
+	}
+	return y, nil
+}
+
+// StreetYield returns the semiannual yield of the note at a clean price,
+// the street convention: payments are discounted on their scheduled
+// dates, even those falling on weekends or holidays. It is the note's
+// bond-equivalent yield; ToEffective(RateAnnualPercentage{y, 2}, 1) gives
+// the effective annual yield.
+func (n TreasuryNote) StreetYield(price float64, settlement time.Time, opts ...SolverOptions) (float64, error) {
+	return n.solveYield(price, settlement, nil, opts)
+}
+
+// TrueYield returns the yield of the note at a clean price with every
+// payment discounted to the business day of calendar it is actually made
+// on, slightly below the [TreasuryNote.StreetYield] when payments roll
+// forward.
+func (n TreasuryNote) TrueYield(price float64, settlement time.Time, calendar BusinessCalendar, opts ...SolverOptions) (float64, error) {
+	return n.solveYield(price, settlement, &calendar, opts)
+}
@@ -0,0 +1,89 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// Bill quotes
+// -----------------------------------------------------------------------------
+func TestBillQuoteConversions(t *testing.T) {
+	for _, days := range []float64{28, 91, 182, 183, 273, 364} {
+		d := RateBankDiscount{0.05, 360}
+		bey := d.BondEquivalentYield(days)
+		if got := BankDiscountFromBondEquivalent(bey, days, 360); !almostEq(got.Value, d.Value, 1e-12) {
+			t.Errorf("%v days: discount from BEY got %f, want %f", days, got.Value, d.Value)
+		}
+		eff := d.EffectiveYield(days)
+		if want := math.Pow(1/d.DiscountFactorDays(days), 365/days) - 1; !almostEq(eff.Value, want, epsilon) || eff.PeriodsPerYear != 1 {
+			t.Errorf("%v days: EffectiveYield got %+v, want %f", days, eff, want)
+		}
+		if got := BankDiscountFromRate(eff, days, 360); !almostEq(got.Value, d.Value, 1e-12) {
+			t.Errorf("%v days: discount from effective got %f, want %f", days, got.Value, d.Value)
+		}
+		// the effective yield is the highest quote, the discount rate the lowest
+		if !(d.Value < d.MoneyMarketYield(days).Value && d.MoneyMarketYield(days).Value < bey && bey < eff.Value+1e-12) {
+			t.Errorf("%v days: quotes out of order: discount %f, money market %f, BEY %f, effective %f", days, d.Value, d.MoneyMarketYield(days).Value, bey, eff.Value)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Treasury notes
+// -----------------------------------------------------------------------------
+func TestTreasuryNote(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	n := TreasuryNote{Face: 100, CouponRate: 0.015, Issue: date(2020, 2, 15), Maturity: date(2030, 2, 15)}
+
+	// on a coupon date a note priced at its coupon is at par
+	if p, err := n.Price(0.015, date(2020, 8, 15)); err != nil || !almostEq(p, 100, 1e-12) {
+		t.Errorf("Price at the coupon got %f, %v, want 100", p, err)
+	}
+	if y, err := n.StreetYield(100, date(2020, 8, 15)); err != nil || !almostEq(y, 0.015, 1e-12) {
+		t.Errorf("StreetYield at par got %f, %v, want 0.015", y, err)
+	}
+	// 90 of the 182 days from February 15 to August 15
+	if ai, _ := n.AccruedInterest(date(2020, 5, 15)); !almostEq(ai, 0.75*90/182, epsilon) {
+		t.Errorf("AccruedInterest got %f, want %f", ai, 0.75*90/182)
+	}
+	settle := date(2021, 11, 3)
+	p, _ := n.Price(0.021, settle)
+	if y, _ := n.StreetYield(p, settle); !almostEq(y, 0.021, 1e-12) {
+		t.Errorf("StreetYield round trip got %f, want 0.021", y)
+	}
+
+	// short and long first coupons are prorated over quasi-coupon periods
+	short := TreasuryNote{Face: 100, CouponRate: 0.015, Issue: date(2020, 3, 2), Maturity: date(2030, 2, 15)}
+	if c := short.payments(short.Issue)[0]; !c.Date.Equal(date(2020, 8, 15)) || !almostEq(c.Value, 0.75*166/182, epsilon) {
+		t.Errorf("short first coupon got %+v, want %f", c, 0.75*166/182)
+	}
+	long := TreasuryNote{Face: 100, CouponRate: 0.015, Issue: date(2020, 1, 2), FirstCoupon: date(2020, 8, 15), Maturity: date(2030, 2, 15)}
+	if c := long.payments(long.Issue)[0]; !c.Date.Equal(date(2020, 8, 15)) || !almostEq(c.Value, 0.75*(1+44.0/184), epsilon) {
+		t.Errorf("long first coupon got %+v, want %f", c, 0.75*(1+44.0/184))
+	}
+	// accrued interest runs across the quasi-coupon date of a long first period
+	if ai, _ := long.AccruedInterest(date(2020, 3, 15)); !almostEq(ai, 0.75*(44.0/184+29.0/182), epsilon) {
+		t.Errorf("long first period AccruedInterest got %f", ai)
+	}
+	p, _ = long.Price(0.02, date(2020, 1, 10))
+	if y, _ := long.StreetYield(p, date(2020, 1, 10)); !almostEq(y, 0.02, 1e-12) {
+		t.Errorf("long first period StreetYield got %f, want 0.02", y)
+	}
+
+	// payments rolled past weekends earn a lower true yield
+	street, _ := n.StreetYield(99, settle)
+	trueYield, err := n.TrueYield(99, settle, BusinessCalendar{})
+	if err != nil || !(trueYield < street) || street-trueYield > 1e-4 {
+		t.Errorf("TrueYield got %f, %v, want just below StreetYield %f", trueYield, err, street)
+	}
+
+	bad := TreasuryNote{Face: 100, CouponRate: 0.015, Issue: date(2020, 1, 2), FirstCoupon: date(2020, 7, 1), Maturity: date(2030, 2, 15)}
+	if _, err := bad.Price(0.02, date(2020, 3, 1)); err == nil {
+		t.Error("Price accepted a FirstCoupon off the coupon dates")
+	}
+	if _, err := n.StreetYield(100, n.Maturity); err == nil {
+		t.Error("StreetYield accepted settlement at maturity")
+	}
+}
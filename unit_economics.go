@@ -0,0 +1,61 @@
+package gofinance
+
+import "time"
+
+// CACPaybackMonths returns the months of gross profit needed to recover the
+// cost of acquiring a customer.
+// Math details:
+//
+// CACPayback = CAC / (MonthlyRevenue * GrossMargin)
+func CACPaybackMonths(cac, monthlyRevenue, grossMargin float64) float64 {
+	return cac / (monthlyRevenue * grossMargin)
+}
+
+// LTVToCAC returns the ratio of a customer's lifetime value, computed on
+// contribution margin with [SubscriptionLTV], to the cost of acquiring them.
+func LTVToCAC(monthlyRevenue, contributionMargin, monthlyChurn, cac float64, r Rate) float64 {
+	return SubscriptionLTV(monthlyRevenue, contributionMargin, monthlyChurn, r) / cac
+}
+
+// AcquisitionCohort is a group of customers acquired at Start for a total
+// acquisition cost CAC, bringing Revenue[k] in month k+1 after Start,
+// ContributionMargin of which is left after variable costs.
+type AcquisitionCohort struct {
+	Start              time.Time
+	CAC                float64
+	Revenue            []float64
+	ContributionMargin float64
+}
+
+// CashFlows returns the cohort's dated cash-flows: the acquisition cost at
+// Start, then the contribution of each month at its end.
+func (c AcquisitionCohort) CashFlows() CashFlows {
+	cfs := CashFlows{{-c.CAC, c.Start}}
+	for k, rev := range c.Revenue {
+		cfs = append(cfs, CashFlow{rev * c.ContributionMargin, addMonths(c.Start, k+1)})
+	}
+	return cfs
+}
+
+// PaybackCurve returns the cumulative cash position of the cohort at the
+// end of each month, starting from -CAC.
+func (c AcquisitionCohort) PaybackCurve() []float64 {
+	curve := make([]float64, len(c.Revenue))
+	cum := -c.CAC
+	for k, rev := range c.Revenue {
+		cum += rev * c.ContributionMargin
+		curve[k] = cum
+	}
+	return curve
+}
+
+// PaybackMonth returns the first month at whose end the cohort has earned
+// back its acquisition cost, and false if it has not yet.
+func (c AcquisitionCohort) PaybackMonth() (int, bool) {
+	for k, cum := range c.PaybackCurve() {
+		if cum >= 0 {
+			return k + 1, true
+		}
+	}
+	return 0, false
+}
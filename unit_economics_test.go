@@ -0,0 +1,38 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// Unit economics
+// -----------------------------------------------------------------------------
+func TestUnitEconomics(t *testing.T) {
+	if got := CACPaybackMonths(600, 50, 0.8); !almostEq(got, 15, 1e-12) {
+		t.Errorf("CACPaybackMonths got %f, want 15", got)
+	}
+	flat := RateAnnualContinuous{0}
+	if got := LTVToCAC(50, 0.8, 0.02, 500, flat); !almostEq(got, 4, 1e-9) {
+		t.Errorf("LTVToCAC got %f, want 4", got)
+	}
+}
+
+func TestAcquisitionCohort(t *testing.T) {
+	c := AcquisitionCohort{Start: anchor, CAC: 1000, Revenue: []float64{500, 400, 300, 200}, ContributionMargin: 0.5}
+	curve := c.PaybackCurve()
+	want := []float64{-750, -550, -400, -300}
+	for i := range want {
+		if !almostEq(curve[i], want[i], 1e-12) {
+			t.Errorf("PaybackCurve[%d] got %f, want %f", i, curve[i], want[i])
+		}
+	}
+	if _, ok := c.PaybackMonth(); ok {
+		t.Error("PaybackMonth reported payback of an unprofitable cohort")
+	}
+	c.ContributionMargin = 0.8
+	if m, ok := c.PaybackMonth(); !ok || m != 4 {
+		t.Errorf("PaybackMonth got %d, %v, want 4", m, ok)
+	}
+	cfs := c.CashFlows()
+	if len(cfs) != 5 || cfs[0].Value != -1000 || !cfs[4].Date.Equal(anchor.AddDate(0, 4, 0)) || !almostEq(cfs[4].Value, 160, 1e-12) {
+		t.Errorf("CashFlows got %+v", cfs)
+	}
+}
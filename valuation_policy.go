@@ -0,0 +1,78 @@
+package gofinance
+
+import "time"
+
+// WeekendRoll selects how an as-of date falling on a weekend is moved.
+type WeekendRoll int
+
+const (
+	// WeekendNoRoll keeps weekend as-of dates.
+	WeekendNoRoll WeekendRoll = iota
+	// WeekendFollowing moves Saturday and Sunday to the next Monday.
+	WeekendFollowing
+	// WeekendPreceding moves Saturday and Sunday to the previous Friday.
+	WeekendPreceding
+)
+
+// ValuationPolicy normalizes "as-of" timestamps into valuation dates,
+// so that books valued from different time zones and at different times
+// of day agree on the date passed to NPV, accruals, and performance calculations.
+//
+//	policy := ValuationPolicy{
+//		Location:    newYork,
+//		CutOff:      17 * time.Hour, // 5pm New York close
+//		WeekendRoll: WeekendFollowing,
+//	}
+//	npv := cfs.NPV(r, policy.AsOf(timestamp))
+type ValuationPolicy struct {
+	// Location is the time zone in which the as-of day is determined.
+	// nil means UTC.
+	Location *time.Location
+
+	// CutOff is the time of day, in Location, from which timestamps belong
+	// to the next day. Zero disables the cut-off.
+	CutOff time.Duration
+
+	// WeekendRoll moves as-of days falling on a weekend.
+	WeekendRoll WeekendRoll
+}
+
+// AsOf returns the valuation date for timestamp t under the policy:
+// t is moved to Location, rolled to the next day if at or past CutOff,
+// rolled off the weekend per WeekendRoll, and finally set to the midpoint
+// of the resulting day, in UTC.
+//
+// The midpoint matches the day resolution of [StringToTime], so with
+// a UTC policy AsOf(t) equals StringToTime of t's date.
+func (p ValuationPolicy) AsOf(t time.Time) time.Time {
+	loc := p.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+	y, m, d := t.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+	if p.CutOff > 0 && t.Sub(start) >= p.CutOff {
+		start = start.AddDate(0, 0, 1)
+	}
+
+	switch p.WeekendRoll {
+	case WeekendFollowing:
+		for start.Weekday() == time.Saturday || start.Weekday() == time.Sunday {
+			start = start.AddDate(0, 0, 1)
+		}
+	case WeekendPreceding:
+		for start.Weekday() == time.Saturday || start.Weekday() == time.Sunday {
+			start = start.AddDate(0, 0, -1)
+		}
+	}
+
+	end := start.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	return midOfStartEnd(start, end).UTC()
+}
+
+// Now is a convenience wrapper for [ValuationPolicy.AsOf] with [time.Now].
+func (p ValuationPolicy) Now() time.Time {
+	return p.AsOf(time.Now())
+}
@@ -0,0 +1,60 @@
+package gofinance
+
+import (
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// ValuationPolicy.AsOf
+// -----------------------------------------------------------------------------
+func TestValuationPolicyAsOf(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	newYork := time.FixedZone("EST", -5*3600)
+	day := func(s string) time.Time {
+		d, _ := StringToTime(s)
+		return d
+	}
+	// Friday 2024-03-15 20:00 UTC
+	ts := time.Date(2024, 3, 15, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		policy ValuationPolicy
+		t      time.Time
+		want   time.Time
+	}{
+		{"zero policy is the UTC day", ValuationPolicy{}, ts, day("2024-03-15")},
+		{"cut-off not reached", ValuationPolicy{CutOff: 21 * time.Hour}, ts, day("2024-03-15")},
+		{"cut-off passed rolls to Saturday", ValuationPolicy{CutOff: 17 * time.Hour}, ts, day("2024-03-16")},
+		{"cut-off then following", ValuationPolicy{CutOff: 17 * time.Hour, WeekendRoll: WeekendFollowing}, ts, day("2024-03-18")},
+		{"cut-off then preceding", ValuationPolicy{CutOff: 17 * time.Hour, WeekendRoll: WeekendPreceding}, ts, day("2024-03-15")},
+		{"Tokyo is already Saturday", ValuationPolicy{Location: tokyo, WeekendRoll: WeekendNoRoll}, ts, time.Date(2024, 3, 16, 12, 0, 0, 0, tokyo)},
+		{"New York before close", ValuationPolicy{Location: newYork, CutOff: 17 * time.Hour}, ts, time.Date(2024, 3, 15, 12, 0, 0, 0, newYork)},
+		{"Sunday following", ValuationPolicy{WeekendRoll: WeekendFollowing}, ts.AddDate(0, 0, 2), day("2024-03-18")},
+	}
+	for _, tc := range tests {
+		got := tc.policy.AsOf(tc.t)
+		// the midpoint of a day is half a nanosecond shy of noon
+		if d := got.Sub(tc.want); d < -time.Nanosecond || d > time.Nanosecond {
+			t.Errorf("%s: AsOf got %v, want %v", tc.name, got, tc.want)
+		}
+		if got.Location() != time.UTC {
+			t.Errorf("%s: AsOf returned location %v, want UTC", tc.name, got.Location())
+		}
+	}
+
+	// exact agreement with StringToTime under UTC
+	if got := (ValuationPolicy{}).AsOf(ts); !got.Equal(day("2024-03-15")) {
+		t.Errorf("AsOf got %v, want exactly %v", got, day("2024-03-15"))
+	}
+}
+
+func TestValuationPolicyNow(t *testing.T) {
+	p := ValuationPolicy{}
+	got := p.Now()
+	want := p.AsOf(time.Now())
+	if d := want.Sub(got); d != 0 && d != 24*time.Hour { // midnight may pass in between
+		t.Errorf("Now got %v, want %v", got, want)
+	}
+}
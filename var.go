@@ -0,0 +1,149 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// tailCount returns how many of n sorted outcomes fall in the
+// 1 - confidence tail, at least one.
+func tailCount(n int, confidence float64) int {
+	return max(1, int(math.Ceil(float64(n)*(1-confidence)-1e-9)))
+}
+
+// validateVaR checks a sample and a confidence level.
+func validateVaR(pnl []float64, confidence float64) error {
+	if len(pnl) == 0 {
+		return fmt.Errorf("VaR requires a non-empty profit and loss sample: %w", ErrInsufficientData)
+	}
+	if confidence <= 0 || confidence >= 1 {
+		return errors.New("VaR requires a confidence between 0 and 1")
+	}
+	return nil
+}
+
+// HistoricalVaR returns the Value-at-Risk at confidence (e.g. 0.99) of a
+// sample of profits and losses, historical or simulated, as a positive loss:
+// the k-th worst outcome, k = ceil(n * (1 - confidence)).
+// For a return series the VaR is a fraction of the position value.
+func HistoricalVaR(pnl []float64, confidence float64) (float64, error) {
+	if err := validateVaR(pnl, confidence); err != nil {
+		return 0, err
+	}
+	sorted := slices.Clone(pnl)
+	slices.Sort(sorted)
+	return -sorted[tailCount(len(sorted), confidence)-1], nil
+}
+
+// HistoricalES returns the Expected Shortfall (CVaR) at confidence of a
+// sample of profits and losses: the average loss over the outcomes at or
+// beyond the [HistoricalVaR].
+func HistoricalES(pnl []float64, confidence float64) (float64, error) {
+	if err := validateVaR(pnl, confidence); err != nil {
+		return 0, err
+	}
+	sorted := slices.Clone(pnl)
+	slices.Sort(sorted)
+	return -mean(sorted[:tailCount(len(sorted), confidence)]), nil
+}
+
+// ParametricVaR returns the Value-at-Risk at confidence of normally
+// distributed profits and losses with the given mean and standard deviation.
+// Math details:
+//
+// VaR = -(Mean + N^{-1}(1 - Confidence) * StdDev)
+func ParametricVaR(mean, stdDev, confidence float64) float64 {
+	return -(mean + normInv(1-confidence)*stdDev)
+}
+
+// ParametricES returns the Expected Shortfall at confidence of normally
+// distributed profits and losses.
+// Math details:
+//
+// ES = -Mean + StdDev * \phi(N^{-1}(Confidence)) / (1 - Confidence),   \phi = standard normal density
+func ParametricES(mean, stdDev, confidence float64) float64 {
+	z := normInv(confidence)
+	return -mean + stdDev*math.Exp(-z*z/2)/math.Sqrt(2*math.Pi)/(1-confidence)
+}
+
+// ScaleToHorizon scales a one-period risk measure of zero-mean, independent
+// outcomes to horizon periods with the square-root-of-time rule.
+// Math details:
+//
+// Scaled = Value * \sqrt{Horizon}
+func ScaleToHorizon(value, horizon float64) float64 {
+	return value * math.Sqrt(horizon)
+}
+
+// ParametricVaR returns the variance-covariance Value-at-Risk of holding the
+// portfolio worth value over horizon periods, from the mean and the standard
+// deviation of its per-period returns, both scaled to the horizon.
+// Math details:
+//
+// VaR = Value * -(Mean * Horizon + N^{-1}(1 - Confidence) * StdDev * \sqrt{Horizon})
+func (p Portfolio) ParametricVaR(value, confidence, horizon float64) float64 {
+	series := p.Series()
+	return value * ParametricVaR(mean(series)*horizon, math.Sqrt(covariance(series, series)*horizon), confidence)
+}
+
+// cholesky returns the lower-triangular l with l l^T = m
+// for a symmetric positive definite m.
+func cholesky(m [][]float64) ([][]float64, error) {
+	n := len(m)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, n)
+		for j := 0; j <= i; j++ {
+			sum := m[i][j]
+			for k := range j {
+				sum -= l[i][k] * l[j][k]
+			}
+			if i == j {
+				if sum <= 0 {
+					return nil, errors.New("cholesky: matrix is not positive definite")
+				}
+				l[i][i] = math.Sqrt(sum)
+			} else {
+				l[i][j] = sum / l[j][j]
+			}
+		}
+	}
+	return l, nil
+}
+
+// MonteCarloPnL simulates paths profits and losses of positions with the
+// given exposures, the amounts invested in each asset, whose returns are
+// jointly normal with the given means and covariance. Pass the result to
+// [HistoricalVaR] and [HistoricalES]. The same seed gives the same sample.
+// Math details:
+//
+// PnL = \sum_i Exposure_i * R_i,   R = Means + L * Z,   L * L^T = Covariance,   Z ~ N(0, I)
+func MonteCarloPnL(exposures, means []float64, cov [][]float64, paths int, seed uint64) ([]float64, error) {
+	n := len(exposures)
+	if n == 0 || len(means) != n || len(cov) != n || paths <= 0 {
+		return nil, errors.New("MonteCarloPnL requires matching exposures, means, covariance, and positive paths")
+	}
+	l, err := cholesky(cov)
+	if err != nil {
+		return nil, err
+	}
+	rng := rand.New(rand.NewPCG(seed, seed))
+	pnl := make([]float64, paths)
+	z := make([]float64, n)
+	for p := range pnl {
+		for i := range z {
+			z[i] = rng.NormFloat64()
+		}
+		for i := range n {
+			r := means[i]
+			for k := 0; k <= i; k++ {
+				r += l[i][k] * z[k]
+			}
+			pnl[p] += exposures[i] * r
+		}
+	}
+	return pnl, nil
+}
@@ -0,0 +1,75 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Historical VaR and ES
+// -----------------------------------------------------------------------------
+func TestHistoricalVaR(t *testing.T) {
+	pnl := make([]float64, 100)
+	for i := range pnl {
+		pnl[i] = float64(i - 10) // -10 ... 89
+	}
+	v, err := HistoricalVaR(pnl, 0.95)
+	if err != nil || v != 6 {
+		t.Errorf("HistoricalVaR got %f, %v, want 6", v, err)
+	}
+	es, _ := HistoricalES(pnl, 0.95)
+	if !almostEq(es, 8, 1e-12) {
+		t.Errorf("HistoricalES got %f, want 8", es)
+	}
+	if _, err := HistoricalVaR(nil, 0.95); err == nil {
+		t.Error("HistoricalVaR accepted an empty sample")
+	}
+	if _, err := HistoricalES(pnl, 1); err == nil {
+		t.Error("HistoricalES accepted confidence 1")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Parametric VaR and ES
+// -----------------------------------------------------------------------------
+func TestParametricVaR(t *testing.T) {
+	if got := ParametricVaR(0, 1, 0.99); !almostEq(got, 2.326348, 1e-6) {
+		t.Errorf("ParametricVaR got %f, want 2.326348", got)
+	}
+	if got := ParametricES(0, 1, 0.975); !almostEq(got, 2.337803, 1e-6) {
+		t.Errorf("ParametricES got %f, want 2.337803", got)
+	}
+	if got := ScaleToHorizon(2, 25); got != 10 {
+		t.Errorf("ScaleToHorizon got %f, want 10", got)
+	}
+
+	p, _ := NewPortfolio([]float64{1}, [][]float64{{0.01, -0.01, 0.02, -0.02}}, 252)
+	sd := math.Sqrt(covariance(p.Series(), p.Series()))
+	if got := p.ParametricVaR(1e6, 0.99, 10); !almostEq(got, 1e6*normInv(0.99)*sd*math.Sqrt(10), 1e-6) {
+		t.Errorf("Portfolio.ParametricVaR got %f", got)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Monte Carlo VaR
+// -----------------------------------------------------------------------------
+func TestMonteCarloVaR(t *testing.T) {
+	cov := [][]float64{{0.0004, 0.0001}, {0.0001, 0.0009}}
+	exposures := []float64{1e6, 5e5}
+	pnl, err := MonteCarloPnL(exposures, []float64{0, 0}, cov, 200000, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sd := math.Sqrt(quadraticForm(cov, exposures))
+	v, _ := HistoricalVaR(pnl, 0.99)
+	if want := ParametricVaR(0, sd, 0.99); math.Abs(v-want) > 0.02*want {
+		t.Errorf("Monte Carlo VaR got %f, want about %f", v, want)
+	}
+	again, _ := MonteCarloPnL(exposures, []float64{0, 0}, cov, 200000, 7)
+	if again[123] != pnl[123] {
+		t.Error("MonteCarloPnL not reproducible with the same seed")
+	}
+	if _, err := MonteCarloPnL(exposures, []float64{0, 0}, [][]float64{{1, 2}, {2, 1}}, 10, 1); err == nil {
+		t.Error("MonteCarloPnL accepted an indefinite covariance")
+	}
+}
@@ -0,0 +1,151 @@
+package gofinance
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+	"time"
+)
+
+// OptionQuote is the price of a call and of a put at the same Strike
+// and expiry, as in a listed option strip.
+type OptionQuote struct {
+	Strike float64
+	Call   float64
+	Put    float64
+}
+
+// FairVariance returns the fair variance strike, an annual variance, of a
+// variance swap expiring in years, replicated from a strip of out-of-the-money
+// options on an underlying with the given forward price, as in the VIX.
+// Puts are used below the forward and calls above it, both at the strike
+// just below the forward. The strip needs at least two strikes and should
+// reach far into both tails, as truncating it understates the variance.
+// Math details:
+//
+// K_0 = highest strike <= Forward,   Q_i = Put_i below K_0, Call_i above, (Put_0 + Call_0) / 2 at K_0
+//
+// \Delta K_i = (K_{i+1} - K_{i-1}) / 2, one-sided at the ends of the strip
+//
+// FairVariance = 2 / Years * \sum_i \Delta K_i / K_i^2 * Q_i / DiscountFactor(Years) - 1 / Years * (Forward / K_0 - 1)^2
+func FairVariance(forward float64, options []OptionQuote, r Rate, years float64) (float64, error) {
+	if len(options) < 2 || years <= 0 || forward <= 0 {
+		return 0, errors.New("FairVariance requires two or more options, positive years and forward")
+	}
+	strip := slices.Clone(options)
+	slices.SortFunc(strip, func(a, b OptionQuote) int { return cmp.Compare(a.Strike, b.Strike) })
+	if strip[0].Strike <= 0 || strip[0].Strike > forward {
+		return 0, errors.New("FairVariance requires positive strikes starting at or below the forward")
+	}
+	k0 := 0
+	for i, o := range strip {
+		if o.Strike <= forward {
+			k0 = i
+		}
+	}
+
+	df := r.DiscountFactor(years)
+	n := len(strip)
+	sum := 0.0
+	for i, o := range strip {
+		var dk float64
+		switch i {
+		case 0:
+			dk = strip[1].Strike - o.Strike
+		case n - 1:
+			dk = o.Strike - strip[n-2].Strike
+		default:
+			dk = (strip[i+1].Strike - strip[i-1].Strike) / 2
+		}
+		q := o.Put
+		if i == k0 {
+			q = (o.Put + o.Call) / 2
+		} else if i > k0 {
+			q = o.Call
+		}
+		sum += dk / (o.Strike * o.Strike) * q / df
+	}
+	d := forward/strip[k0].Strike - 1
+	return 2/years*sum - d*d/years, nil
+}
+
+// FairVarianceFlat returns the fair variance strike when options of every
+// strike trade at the same implied volatility, its square.
+func FairVarianceFlat(volatility float64) float64 {
+	return volatility * volatility
+}
+
+// VolatilityStrike approximates the fair strike of a volatility swap from
+// the fair variance strike and the variance of the realized variance,
+// which lowers it below the square root by the convexity of the latter.
+// Math details:
+//
+// VolatilityStrike = \sqrt{FairVariance} - VarianceOfVariance / (8 * FairVariance^{3/2})
+func VolatilityStrike(fairVariance, varianceOfVariance float64) float64 {
+	return math.Sqrt(fairVariance) - varianceOfVariance/(8*math.Pow(fairVariance, 1.5))
+}
+
+// RealizedVariance returns the annualised realized variance of a price
+// series with ObservationsPerYear prices a year, using the zero-mean
+// convention of variance swap contracts.
+// Math details:
+//
+// RealizedVariance = ObservationsPerYear / n * \sum_t ln(P_t / P_{t-1})^2
+func RealizedVariance(prices TimeSeries, observationsPerYear float64) float64 {
+	returns := prices.LogReturns()
+	if len(returns) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, r := range returns {
+		sum += r.Value * r.Value
+	}
+	return observationsPerYear * sum / float64(len(returns))
+}
+
+// VarianceSwap pays, at End, VarianceNotional times the difference between
+// the realized variance of the underlying from Start to End and Strike^2,
+// Strike being quoted in volatility, for example 0.2.
+// The variance notional is set from the VegaNotional, the payoff for one
+// point of volatility above the strike. Returns are observed
+// ObservationsPerYear times a year, usually 252.
+type VarianceSwap struct {
+	VegaNotional        float64
+	Strike              float64
+	Start               time.Time
+	End                 time.Time
+	ObservationsPerYear float64
+}
+
+// VarianceNotional returns the payoff per unit of variance.
+// Math details:
+//
+// VarianceNotional = VegaNotional / (2 * Strike)
+func (s VarianceSwap) VarianceNotional() float64 {
+	return s.VegaNotional / (2 * s.Strike)
+}
+
+// Value returns the value of a seasoned swap at valuationDate for its buyer,
+// the receiver of realized variance. prices are the observations from Start
+// to valuationDate, and remainingVariance is the fair variance of the
+// rest of the term, for example from [FairVariance]. The expected variance
+// over the term weights the realized and the remaining variance by their
+// number of observations.
+// Math details:
+//
+// N = round(YearFraction(Start, End) * ObservationsPerYear),   n = number of returns observed
+//
+// ExpectedVariance = n / N * RealizedVariance + (N - n) / N * RemainingVariance
+//
+// Value = VarianceNotional * (ExpectedVariance - Strike^2) * DiscountFactor(YearFraction(ValuationDate, End))
+func (s VarianceSwap) Value(prices TimeSeries, remainingVariance float64, r Rate, valuationDate time.Time) (float64, error) {
+	total := math.Round(yearsBetween(s.Start, s.End) * s.ObservationsPerYear)
+	observed := float64(max(len(prices)-1, 0))
+	if total <= 0 || observed > total {
+		return 0, errors.New("VarianceSwap.Value requires a term with more observations than observed")
+	}
+	expected := observed/total*RealizedVariance(prices, s.ObservationsPerYear) + (total-observed)/total*remainingVariance
+	df := r.DiscountFactor(yearsBetween(valuationDate, s.End))
+	return s.VarianceNotional() * (expected - s.Strike*s.Strike) * df, nil
+}
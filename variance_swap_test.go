@@ -0,0 +1,82 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Fair strikes
+// -----------------------------------------------------------------------------
+func TestFairVarianceReplicatesFlatVolatility(t *testing.T) {
+	r, q := RateAnnualContinuous{0.03}, RateAnnualContinuous{0.01}
+	spot, vol, years := 100.0, 0.2, 1.0
+	forward := ForwardPrice(spot, r, q, years)
+
+	var strip []OptionQuote
+	for k := 10.0; k <= 400; k += 0.5 {
+		strip = append(strip, OptionQuote{k, BlackScholesCall(spot, k, vol, r, q, years), BlackScholesPut(spot, k, vol, r, q, years)})
+	}
+	got, err := FairVariance(forward, strip, r, years)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := FairVarianceFlat(vol); !almostEq(got, want, 1e-5) {
+		t.Errorf("FairVariance got %.8f, want %.8f", got, want)
+	}
+
+	if _, err := FairVariance(forward, strip[:1], r, years); err == nil {
+		t.Error("FairVariance accepted a single option")
+	}
+	if _, err := FairVariance(forward, strip[len(strip)-10:], r, years); err == nil {
+		t.Error("FairVariance accepted a strip starting above the forward")
+	}
+}
+
+func TestVolatilityStrike(t *testing.T) {
+	if got := VolatilityStrike(0.04, 0); !almostEq(got, 0.2, epsilon) {
+		t.Errorf("VolatilityStrike without convexity got %f, want 0.2", got)
+	}
+	if got, want := VolatilityStrike(0.04, 0.0001), 0.2-0.0001/(8*0.008); !almostEq(got, want, epsilon) {
+		t.Errorf("VolatilityStrike got %f, want %f", got, want)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Seasoned variance swap
+// -----------------------------------------------------------------------------
+func TestVarianceSwapValue(t *testing.T) {
+	// log returns of ±1% a day realize 252 * 0.0001 = 2.52% variance
+	prices := TimeSeries{{anchor, 100}}
+	for i := 1; i <= 63; i++ {
+		step := 0.01
+		if i%2 == 0 {
+			step = -0.01
+		}
+		prices = append(prices, Observation{anchor.AddDate(0, 0, i), prices[i-1].Value * math.Exp(step)})
+	}
+	if got := RealizedVariance(prices, 252); !almostEq(got, 0.0252, 1e-12) {
+		t.Errorf("RealizedVariance got %f, want 0.0252", got)
+	}
+
+	s := VarianceSwap{VegaNotional: 100000, Strike: 0.2, Start: anchor, End: anchor.AddDate(1, 0, 0), ObservationsPerYear: 252}
+	if got := s.VarianceNotional(); !almostEq(got, 250000, epsilon) {
+		t.Errorf("VarianceNotional got %f, want 250000", got)
+	}
+
+	r := RateAnnualContinuous{0.02}
+	valuation := prices[len(prices)-1].Date
+	got, err := s.Value(prices, 0.05, r, valuation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := 63.0/252*0.0252 + 189.0/252*0.05
+	want := 250000 * (expected - 0.04) * r.DiscountFactor(yearsBetween(valuation, s.End))
+	if !almostEq(got, want, 1e-9) {
+		t.Errorf("Value got %f, want %f", got, want)
+	}
+
+	if _, err := (VarianceSwap{Strike: 0.2, Start: anchor, End: anchor, ObservationsPerYear: 252}).Value(prices, 0.05, r, valuation); err == nil {
+		t.Error("Value accepted a swap without observations")
+	}
+}
@@ -0,0 +1,137 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Bar is the open, high, low, and close price of one period, for example
+// a trading day, dated at the period.
+type Bar struct {
+	Date  time.Time
+	Open  float64
+	High  float64
+	Low   float64
+	Close float64
+}
+
+// Bars is a helper alias for a slice of [Bar], in date order.
+type Bars []Bar
+
+// Closes returns the closing prices as a [TimeSeries],
+// for example for [RealizedVariance].
+func (b Bars) Closes() TimeSeries {
+	closes := make(TimeSeries, len(b))
+	for i, bar := range b {
+		closes[i] = Observation{bar.Date, bar.Close}
+	}
+	return closes
+}
+
+// VolatilityEstimator selects how [Bars.Volatility] estimates volatility.
+type VolatilityEstimator int
+
+const (
+	// EstimatorCloseToClose is the sample standard deviation of log returns
+	// between closes.
+	EstimatorCloseToClose VolatilityEstimator = iota
+	// EstimatorParkinson uses the high-low range of each bar, efficient for
+	// driftless prices trading continuously.
+	EstimatorParkinson
+	// EstimatorGarmanKlass adds the open-close move to the high-low range.
+	EstimatorGarmanKlass
+	// EstimatorRogersSatchell uses the range relative to open and close,
+	// unbiased under drift.
+	EstimatorRogersSatchell
+	// EstimatorYangZhang combines overnight, open-to-close, and
+	// Rogers-Satchell variances, handling both drift and opening jumps.
+	EstimatorYangZhang
+)
+
+// Volatility returns the annualised volatility of the bars with the
+// selected estimator, periodsPerYear being the number of bars a year,
+// for example 252 for trading days. Estimators that need the previous
+// close, close-to-close and Yang-Zhang, use every bar but the first as a
+// period and need three bars or more for a sample variance of two
+// periods; the others need two bars or more. All prices must be positive.
+// Math details:
+//
+// CloseToClose = Var_sample(ln(C_t / C_{t-1}))
+//
+// Parkinson = 1 / (4 * ln2 * n) * \sum_t ln(H_t / L_t)^2
+//
+// GarmanKlass = 1 / n * \sum_t (ln(H_t / L_t)^2 / 2 - (2 * ln2 - 1) * ln(C_t / O_t)^2)
+//
+// RogersSatchell = 1 / n * \sum_t (ln(H_t / C_t) * ln(H_t / O_t) + ln(L_t / C_t) * ln(L_t / O_t))
+//
+// YangZhang = Var_sample(ln(O_t / C_{t-1})) + k * Var_sample(ln(C_t / O_t)) + (1 - k) * RogersSatchell,   k = 0.34 / (1.34 + (n + 1) / (n - 1))
+//
+// Volatility = \sqrt{PeriodsPerYear * Variance}
+func (b Bars) Volatility(estimator VolatilityEstimator, periodsPerYear float64) (float64, error) {
+	if len(b) < 2 {
+		return 0, fmt.Errorf("Bars.Volatility requires two or more bars: %w", ErrInsufficientData)
+	}
+	for _, bar := range b {
+		if bar.Open <= 0 || bar.High <= 0 || bar.Low <= 0 || bar.Close <= 0 {
+			return 0, errors.New("Bars.Volatility requires positive prices")
+		}
+	}
+	if len(b) < 3 && (estimator == EstimatorCloseToClose || estimator == EstimatorYangZhang) {
+		return 0, fmt.Errorf("Bars.Volatility requires three or more bars for close-to-close and Yang-Zhang: %w", ErrInsufficientData)
+	}
+	var variance float64
+	switch estimator {
+	case EstimatorCloseToClose:
+		returns := make([]float64, len(b)-1)
+		for i := 1; i < len(b); i++ {
+			returns[i-1] = math.Log(b[i].Close / b[i-1].Close)
+		}
+		variance = sampleVariance(returns)
+	case EstimatorParkinson:
+		for _, bar := range b {
+			hl := math.Log(bar.High / bar.Low)
+			variance += hl * hl
+		}
+		variance /= 4 * math.Ln2 * float64(len(b))
+	case EstimatorGarmanKlass:
+		for _, bar := range b {
+			hl, co := math.Log(bar.High/bar.Low), math.Log(bar.Close/bar.Open)
+			variance += hl*hl/2 - (2*math.Ln2-1)*co*co
+		}
+		variance /= float64(len(b))
+	case EstimatorRogersSatchell:
+		variance = rogersSatchell(b)
+	case EstimatorYangZhang:
+		n := len(b) - 1
+		overnight, openClose := make([]float64, n), make([]float64, n)
+		for i := 1; i < len(b); i++ {
+			overnight[i-1] = math.Log(b[i].Open / b[i-1].Close)
+			openClose[i-1] = math.Log(b[i].Close / b[i].Open)
+		}
+		k := 0.34 / (1.34 + float64(n+1)/float64(n-1))
+		variance = sampleVariance(overnight) + k*sampleVariance(openClose) + (1-k)*rogersSatchell(b[1:])
+	default:
+		return 0, errors.New("Bars.Volatility: unknown estimator")
+	}
+	return math.Sqrt(periodsPerYear * variance), nil
+}
+
+// rogersSatchell returns the Rogers-Satchell variance per bar.
+func rogersSatchell(b Bars) float64 {
+	sum := 0.0
+	for _, bar := range b {
+		sum += math.Log(bar.High/bar.Close)*math.Log(bar.High/bar.Open) +
+			math.Log(bar.Low/bar.Close)*math.Log(bar.Low/bar.Open)
+	}
+	return sum / float64(len(b))
+}
+
+// sampleVariance returns the sample variance of xs, zero for fewer than two.
+func sampleVariance(xs []float64) float64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	return covariance(xs, xs)
+}
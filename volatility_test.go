@@ -0,0 +1,91 @@
+package gofinance
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// simulatedBars returns daily bars of a driftless geometric Brownian motion
+// with annual volatility vol, sampled steps times a day.
+func simulatedBars(days, steps int, vol float64, seed uint64) Bars {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	sd := vol / math.Sqrt(252*float64(steps))
+	price := 100.0
+	bars := make(Bars, days)
+	for d := range bars {
+		bar := Bar{anchor.AddDate(0, 0, d), price, price, price, price}
+		for range steps {
+			price *= math.Exp(sd*rng.NormFloat64() - sd*sd/2)
+			bar.High = max(bar.High, price)
+			bar.Low = min(bar.Low, price)
+		}
+		bar.Close = price
+		bars[d] = bar
+	}
+	return bars
+}
+
+// -----------------------------------------------------------------------------
+// Volatility estimators
+// -----------------------------------------------------------------------------
+func TestBarsVolatilityRecoversSimulated(t *testing.T) {
+	bars := simulatedBars(2000, 500, 0.3, 7)
+	for _, estimator := range []VolatilityEstimator{
+		EstimatorCloseToClose, EstimatorParkinson, EstimatorGarmanKlass, EstimatorRogersSatchell, EstimatorYangZhang,
+	} {
+		got, err := bars.Volatility(estimator, 252)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(got-0.3) > 0.015 {
+			t.Errorf("estimator %d got %f, want about 0.3", estimator, got)
+		}
+	}
+}
+
+func TestBarsVolatilityFormulas(t *testing.T) {
+	bars := Bars{
+		{anchor, 100, 110, 95, 105},
+		{anchor.AddDate(0, 0, 1), 104, 108, 100, 102},
+	}
+	hl0, hl1 := math.Log(110.0/95), math.Log(108.0/100)
+	if got, _ := bars.Volatility(EstimatorParkinson, 1); !almostEq(got, math.Sqrt((hl0*hl0+hl1*hl1)/(8*math.Ln2)), epsilon) {
+		t.Errorf("Parkinson got %f", got)
+	}
+	rs0 := math.Log(110.0/105)*math.Log(110.0/100) + math.Log(95.0/105)*math.Log(95.0/100)
+	rs1 := math.Log(108.0/102)*math.Log(108.0/104) + math.Log(100.0/102)*math.Log(100.0/104)
+	if got, _ := bars.Volatility(EstimatorRogersSatchell, 1); !almostEq(got, math.Sqrt((rs0+rs1)/2), epsilon) {
+		t.Errorf("RogersSatchell got %f", got)
+	}
+	// a single period has no sample variance
+	for _, estimator := range []VolatilityEstimator{EstimatorCloseToClose, EstimatorYangZhang} {
+		if got, err := bars.Volatility(estimator, 1); err == nil {
+			t.Errorf("estimator %d of two bars got %f, want an error", estimator, got)
+		}
+	}
+	three := append(bars, Bar{anchor.AddDate(0, 0, 2), 103, 107, 101, 106})
+	r1, r2 := math.Log(102.0/105), math.Log(106.0/102)
+	if got, err := three.Volatility(EstimatorCloseToClose, 1); err != nil || !almostEq(got, math.Abs(r1-r2)/math.Sqrt2, epsilon) {
+		t.Errorf("CloseToClose of three bars got %f, %v", got, err)
+	}
+}
+
+func TestBarsVolatilityErrors(t *testing.T) {
+	good := Bar{anchor, 1, 1, 1, 1}
+	if _, err := (Bars{good}).Volatility(EstimatorParkinson, 252); err == nil {
+		t.Error("Volatility accepted a single bar")
+	}
+	if _, err := (Bars{good, {anchor, 1, 1, 0, 1}}).Volatility(EstimatorParkinson, 252); err == nil {
+		t.Error("Volatility accepted a zero price")
+	}
+	if _, err := (Bars{good, good}).Volatility(VolatilityEstimator(99), 252); err == nil {
+		t.Error("Volatility accepted an unknown estimator")
+	}
+	if got, err := (Bars{good, good}).Volatility(EstimatorGarmanKlass, 252); err != nil || got != 0 {
+		t.Errorf("flat bars got %f, %v", got, err)
+	}
+	if closes := (Bars{good, good}).Closes(); len(closes) != 2 || closes[1].Value != 1 {
+		t.Errorf("Closes got %+v", closes)
+	}
+}
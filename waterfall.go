@@ -0,0 +1,184 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// WaterfallTier is one step of a distribution [Waterfall]: GPShare of the
+// cash flowing through the tier goes to the sponsor (GP), the rest to the
+// investors (LP). A tier sets at most one limit, the last tier none:
+//   - IRR: until the LP earns this internal rate of return,
+//   - Multiple: until LP distributions reach this multiple of contributions,
+//   - CatchUp: until the GP holds this share of the cumulative profits.
+//
+// Return of capital is a Multiple of 1 with no GPShare, a preferred return
+// an IRR tier with no GPShare, and carried interest the GPShare of the
+// tiers after the catch-up.
+type WaterfallTier struct {
+	Name     string
+	IRR      Rate
+	Multiple float64
+	CatchUp  float64
+	GPShare  float64
+}
+
+// Waterfall distributes deal cash-flows between investors and sponsor
+// through Tiers in order, the LP having contributed the positive amounts
+// of Contributions. Hurdles are tested cumulatively on every
+// distribution date, counting the contributions made up to that date.
+type Waterfall struct {
+	Contributions CashFlows
+	Tiers         []WaterfallTier
+}
+
+// TierAllocation is the cash a [WaterfallTier] paid to each party.
+type TierAllocation struct {
+	Name string
+	LP   float64
+	GP   float64
+}
+
+// WaterfallResult holds the cash-flows of each party and the totals of
+// every tier. LP cash-flows include the contributions as negative values,
+// so that their IRR is the investors' return.
+type WaterfallResult struct {
+	LP    CashFlows
+	GP    CashFlows
+	Tiers []TierAllocation
+}
+
+// Distribute runs distributions, positive amounts of cash available to
+// the equity, through the waterfall in date order.
+// Math details:
+//
+// IRR hurdle h at date t:  LPNeed = \sum_{t_c <= t} C * (1+h)^{t-t_c} - \sum LPDist * (1+h)^{t-t_d}
+//
+// Multiple hurdle m:  LPNeed = m * \sum_{t_c <= t} C - \sum LPDist,   TierCash = LPNeed / (1 - GPShare)
+//
+// Catch-up to share k:  TierCash = (k * (LPDist + GPDist - C) - GPDist) / (GPShare - k)
+func (w Waterfall) Distribute(distributions CashFlows) (WaterfallResult, error) {
+	if len(w.Contributions) == 0 {
+		return WaterfallResult{}, fmt.Errorf("Waterfall.Distribute: %w", ErrEmptyCashFlows)
+	}
+	if err := w.validate(); err != nil {
+		return WaterfallResult{}, err
+	}
+	for _, cf := range w.Contributions {
+		if cf.Value <= 0 {
+			return WaterfallResult{}, errors.New("Waterfall.Distribute requires positive contributions")
+		}
+	}
+	for _, cf := range distributions {
+		if cf.Value < 0 {
+			return WaterfallResult{}, errors.New("Waterfall.Distribute requires non-negative distributions")
+		}
+	}
+
+	result := WaterfallResult{Tiers: make([]TierAllocation, len(w.Tiers))}
+	for i, tier := range w.Tiers {
+		result.Tiers[i].Name = tier.Name
+	}
+	for _, cf := range w.Contributions {
+		result.LP = append(result.LP, CashFlow{-cf.Value, cf.Date})
+	}
+	result.LP = result.LP.SortedCopy()
+
+	var received CashFlows // LP distributions so far
+	lpTotal, gpTotal := 0.0, 0.0
+	for _, cf := range distributions.SortedCopy() {
+		remaining := cf.Value
+		lp, gp := 0.0, 0.0
+		for i, tier := range w.Tiers {
+			if remaining <= 0 {
+				break
+			}
+			limit := w.tierCapacity(tier, cf.Date, received, lpTotal+lp, gpTotal+gp)
+			amount := math.Min(remaining, limit)
+			if amount <= 0 {
+				continue
+			}
+			toGP := amount * tier.GPShare
+			result.Tiers[i].LP += amount - toGP
+			result.Tiers[i].GP += toGP
+			lp += amount - toGP
+			gp += toGP
+			remaining -= amount
+			received = append(received, CashFlow{amount - toGP, cf.Date})
+		}
+		lpTotal += lp
+		gpTotal += gp
+		if lp > 0 {
+			result.LP = append(result.LP, CashFlow{lp, cf.Date})
+		}
+		if gp > 0 {
+			result.GP = append(result.GP, CashFlow{gp, cf.Date})
+		}
+	}
+	result.LP = result.LP.SortedCopy()
+	return result, nil
+}
+
+// validate checks the tier limits, the last tier taking the residual.
+func (w Waterfall) validate() error {
+	if len(w.Tiers) == 0 {
+		return errors.New("Waterfall.Distribute requires at least one tier")
+	}
+	for i, tier := range w.Tiers {
+		if tier.GPShare < 0 || tier.GPShare > 1 {
+			return errors.New("Waterfall.Distribute requires GP shares between 0 and 1")
+		}
+		limits := 0
+		if tier.IRR != nil {
+			limits++
+		}
+		if tier.Multiple != 0 {
+			limits++
+		}
+		if tier.CatchUp != 0 {
+			limits++
+			if tier.CatchUp < 0 || tier.CatchUp >= tier.GPShare {
+				return errors.New("Waterfall.Distribute requires a catch-up share below the tier's GP share")
+			}
+		}
+		if limits > 1 {
+			return errors.New("Waterfall.Distribute requires at most one limit per tier")
+		}
+		if (tier.IRR != nil || tier.Multiple != 0) && tier.GPShare == 1 {
+			return errors.New("Waterfall.Distribute requires LP hurdle tiers to pay the LP")
+		}
+		if last := i == len(w.Tiers)-1; last != (limits == 0) {
+			return errors.New("Waterfall.Distribute requires an unlimited last tier only")
+		}
+	}
+	return nil
+}
+
+// tierCapacity is the cash the tier can still take on date, given the LP
+// distributions received and the cumulative totals of each party.
+func (w Waterfall) tierCapacity(tier WaterfallTier, date time.Time, received CashFlows, lp, gp float64) float64 {
+	contributed, need := 0.0, 0.0
+	for _, c := range w.Contributions {
+		if date.Before(c.Date) {
+			continue
+		}
+		contributed += c.Value
+		if tier.IRR != nil {
+			need += c.Value / tier.IRR.DiscountFactor(yearsBetween(c.Date, date))
+		}
+	}
+	switch {
+	case tier.IRR != nil:
+		for _, d := range received {
+			need -= d.Value / tier.IRR.DiscountFactor(yearsBetween(d.Date, date))
+		}
+		return need / (1 - tier.GPShare)
+	case tier.Multiple != 0:
+		return (tier.Multiple*contributed - lp) / (1 - tier.GPShare)
+	case tier.CatchUp != 0:
+		return (tier.CatchUp*(lp+gp-contributed) - gp) / (tier.GPShare - tier.CatchUp)
+	}
+	return math.Inf(1)
+}
@@ -0,0 +1,135 @@
+package gofinance
+
+import (
+	"errors"
+	"testing"
+)
+
+// testWaterfall is an American-style promote: return of capital, an 8 %
+// preferred return, a full GP catch-up to 20 % of profits, then 80/20.
+func testWaterfall() Waterfall {
+	return Waterfall{
+		Contributions: CashFlows{{100, anchor}},
+		Tiers: []WaterfallTier{
+			{Name: "return of capital", Multiple: 1},
+			{Name: "preferred return", IRR: RateEffective{0.08, 1}},
+			{Name: "catch-up", CatchUp: 0.2, GPShare: 1},
+			{Name: "carried interest", GPShare: 0.2},
+		},
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Waterfall.Distribute
+// -----------------------------------------------------------------------------
+func TestWaterfallDistribute(t *testing.T) {
+	w := testWaterfall()
+	year := anchor.AddDate(1, 0, 0)
+	result, err := w.Distribute(CashFlows{{150, year}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pref := 100/RateEffective{0.08, 1}.DiscountFactor(yearsBetween(anchor, year)) - 100
+	want := []TierAllocation{
+		{"return of capital", 100, 0},
+		{"preferred return", pref, 0},
+		{"catch-up", 0, pref / 4},
+		{"carried interest", 0.8 * (50 - pref*1.25), 0.2 * (50 - pref*1.25)},
+	}
+	for i, w := range want {
+		got := result.Tiers[i]
+		if got.Name != w.Name || !almostEq(got.LP, w.LP, 1e-9) || !almostEq(got.GP, w.GP, 1e-9) {
+			t.Errorf("tier %d got %+v, want %+v", i, got, w)
+		}
+	}
+	// the full catch-up leaves the GP with exactly 20 % of the profit
+	if len(result.GP) != 1 || !almostEq(result.GP[0].Value, 10, 1e-9) {
+		t.Errorf("GP got %v, want 10", result.GP)
+	}
+	if len(result.LP) != 2 || result.LP[0].Value != -100 || !almostEq(result.LP[1].Value, 140, 1e-9) {
+		t.Errorf("LP got %v, want -100 then 140", result.LP)
+	}
+
+	// a small distribution stops within the preferred return
+	result, _ = w.Distribute(CashFlows{{104, year}})
+	if len(result.GP) != 0 || !almostEq(result.Tiers[1].LP, 4, 1e-9) {
+		t.Errorf("small distribution got %+v", result)
+	}
+
+	if _, err := (Waterfall{Tiers: w.Tiers}).Distribute(CashFlows{{1, year}}); !errors.Is(err, ErrEmptyCashFlows) {
+		t.Errorf("no contributions got %v, want ErrEmptyCashFlows", err)
+	}
+	if _, err := w.Distribute(CashFlows{{-1, year}}); err == nil {
+		t.Error("negative distribution: expected error")
+	}
+}
+
+func TestWaterfallIRRHurdles(t *testing.T) {
+	w := Waterfall{
+		Contributions: CashFlows{{60, anchor}, {40, anchor.AddDate(0, 6, 0)}},
+		Tiers: []WaterfallTier{
+			{Name: "8 % pref", IRR: RateEffective{0.08, 1}},
+			{Name: "to 15 %", IRR: RateEffective{0.15, 1}, GPShare: 0.2},
+			{Name: "above 15 %", GPShare: 0.3},
+		},
+	}
+	first, second := anchor.AddDate(1, 0, 0), anchor.AddDate(3, 0, 0)
+	result, err := w.Distribute(CashFlows{{200, second}, {50, first}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the first distribution falls short of the pref, the hurdles are then
+	// met exactly, the LP earning 15 % up to the last tier
+	if result.Tiers[0].GP != 0 || !almostEq(result.Tiers[1].GP, result.Tiers[1].LP/4, 1e-9) {
+		t.Errorf("tiers got %+v", result.Tiers)
+	}
+	for i, hurdle := range []Rate{RateEffective{0.08, 1}, RateEffective{0.15, 1}} {
+		lp := append(CashFlows{}, w.Contributions...)
+		for j := range lp {
+			lp[j].Value = -lp[j].Value
+		}
+		lp = append(lp, CashFlow{50, first})
+		through := -50.0
+		for _, tier := range result.Tiers[:i+1] {
+			through += tier.LP
+		}
+		lp = append(lp, CashFlow{through, second})
+		if npv := lp.NPV(hurdle, second); !almostEq(npv, 0, 1e-9) {
+			t.Errorf("LP NPV at hurdle %d got %v, want 0", i, npv)
+		}
+	}
+
+	// no cash is lost and every party gets its own stream
+	total := 0.0
+	for _, tier := range result.Tiers {
+		total += tier.LP + tier.GP
+	}
+	if !almostEq(total, 250, 1e-9) || len(result.GP) != 1 || !result.GP[0].Date.Equal(second) {
+		t.Errorf("total %v, GP %v", total, result.GP)
+	}
+	if len(result.LP) != 4 || result.LP[2].Value != 50 {
+		t.Errorf("LP got %v", result.LP)
+	}
+}
+
+func TestWaterfallValidation(t *testing.T) {
+	contributions := CashFlows{{100, anchor}}
+	for name, tiers := range map[string][]WaterfallTier{
+		"no tiers":         nil,
+		"capped last tier": {{Multiple: 1}},
+		"open middle tier": {{GPShare: 0.2}, {GPShare: 0.2}},
+		"two limits":       {{Multiple: 1, IRR: RateEffective{0.08, 1}}, {}},
+		"catch-up above":   {{CatchUp: 0.2, GPShare: 0.1}, {}},
+		"share above one":  {{GPShare: 1.5}},
+		"GP-only hurdle":   {{Multiple: 2, GPShare: 1}, {}},
+	} {
+		if _, err := (Waterfall{contributions, tiers}).Distribute(CashFlows{{10, anchor}}); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+	if _, err := (Waterfall{CashFlows{{-100, anchor}}, testWaterfall().Tiers}).Distribute(nil); err == nil {
+		t.Error("negative contribution: expected error")
+	}
+}
@@ -0,0 +1,203 @@
+package gofinance
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// WithdrawalState is what a [WithdrawalRule] sees at the start of Year,
+// counted from 0: the Balance before the withdrawal, the Initial balance,
+// and the Previous year's withdrawal, zero in the first year.
+type WithdrawalState struct {
+	Year     int
+	Balance  float64
+	Initial  float64
+	Previous float64
+}
+
+// WithdrawalRule returns the amount to withdraw at the start of a year of
+// retirement. Any func(WithdrawalState) float64 can be used, the
+// constructors below cover the common fixed real, fixed percent, and
+// guardrails rules.
+type WithdrawalRule func(s WithdrawalState) float64
+
+// WithdrawFixedReal withdraws rate of the initial balance every year,
+// constant in real terms: the classic 4% rule.
+//
+// Withdrawal = Rate * Initial
+func WithdrawFixedReal(rate float64) WithdrawalRule {
+	return func(s WithdrawalState) float64 {
+		return rate * s.Initial
+	}
+}
+
+// WithdrawFixedPercent withdraws rate of the current balance every year,
+// never depleting it but letting spending follow the markets.
+//
+// Withdrawal = Rate * Balance
+func WithdrawFixedPercent(rate float64) WithdrawalRule {
+	return func(s WithdrawalState) float64 {
+		return rate * s.Balance
+	}
+}
+
+// WithdrawGuardrails starts at rate of the initial balance and keeps the
+// previous withdrawal unless the current withdrawal rate leaves a band of
+// ± band around rate: above it spending is cut by adjustment, below it
+// spending is raised by adjustment (Guyton-Klinger).
+// For example WithdrawGuardrails(0.05, 0.2, 0.1) cuts spending by 10% when
+// it exceeds 6% of the balance and raises it by 10% below 4%.
+//
+// Withdrawal = Previous * (1 - Adjustment)   if Previous / Balance > Rate * (1 + Band)
+//
+// Withdrawal = Previous * (1 + Adjustment)   if Previous / Balance < Rate * (1 - Band)
+func WithdrawGuardrails(rate, band, adjustment float64) WithdrawalRule {
+	return func(s WithdrawalState) float64 {
+		if s.Year == 0 {
+			return rate * s.Initial
+		}
+		w := s.Previous
+		switch current := w / s.Balance; {
+		case current > rate*(1+band):
+			w *= 1 - adjustment
+		case current < rate*(1-band):
+			w *= 1 + adjustment
+		}
+		return w
+	}
+}
+
+// Decumulation is a retirement spending plan: withdrawals by Rule at the
+// start of each of Years years from an Initial balance, which earns the
+// year's return for the rest of it. Returns are real, after inflation,
+// so all amounts are in today's money.
+type Decumulation struct {
+	Initial float64
+	Rule    WithdrawalRule
+	Years   int
+}
+
+// DecumulationPath is the outcome of a plan over one sequence of returns:
+// the Withdrawals made, the Balances at the end of each year, and whether
+// the balance was Depleted before the rule's withdrawals were all paid.
+type DecumulationPath struct {
+	Withdrawals []float64
+	Balances    []float64
+	Depleted    bool
+}
+
+// Terminal returns the balance at the end of the plan.
+func (p DecumulationPath) Terminal() float64 {
+	return p.Balances[len(p.Balances)-1]
+}
+
+// validate checks the plan.
+func (d Decumulation) validate() error {
+	switch {
+	case d.Rule == nil:
+		return errors.New("Decumulation: Rule is nil")
+	case d.Years <= 0 || d.Initial <= 0:
+		return errors.New("Decumulation: Years and Initial must be positive")
+	}
+	return nil
+}
+
+// Run applies the plan to one sequence of at least Years annual returns.
+// A withdrawal the balance cannot cover takes what is left and marks the
+// path Depleted.
+// Math details:
+//
+// Balance_t = (Balance_{t-1} - Withdrawal_t) * (1 + R_t)
+func (d Decumulation) Run(returns []float64) (DecumulationPath, error) {
+	if err := d.validate(); err != nil {
+		return DecumulationPath{}, err
+	}
+	if len(returns) < d.Years {
+		return DecumulationPath{}, errors.New("Decumulation.Run requires a return for every year")
+	}
+	path := DecumulationPath{Withdrawals: make([]float64, d.Years), Balances: make([]float64, d.Years)}
+	balance, previous := d.Initial, 0.0
+	for t := range d.Years {
+		w := math.Max(d.Rule(WithdrawalState{t, balance, d.Initial, previous}), 0)
+		if w > balance {
+			w, path.Depleted = balance, true
+		}
+		balance = (balance - w) * (1 + returns[t])
+		path.Withdrawals[t], path.Balances[t] = w, balance
+		previous = w
+	}
+	return path, nil
+}
+
+// DecumulationSummary summarizes a plan over many sequences of returns:
+// the Success probability of never depleting the balance, and the Median,
+// 5th and 95th percentile terminal balances.
+type DecumulationSummary struct {
+	Success float64
+	Median  float64
+	Low     float64
+	High    float64
+}
+
+// Simulate runs the plan over every scenario, a sequence of annual real
+// returns from [HistoricalScenarios], [SimulatedScenarios], or elsewhere.
+func (d Decumulation) Simulate(scenarios [][]float64) (DecumulationSummary, error) {
+	if len(scenarios) == 0 {
+		return DecumulationSummary{}, errors.New("Decumulation.Simulate requires scenarios")
+	}
+	terminal := make([]float64, len(scenarios))
+	successes := 0
+	for i, returns := range scenarios {
+		path, err := d.Run(returns)
+		if err != nil {
+			return DecumulationSummary{}, err
+		}
+		if !path.Depleted {
+			successes++
+		}
+		terminal[i] = path.Terminal()
+	}
+	slices.Sort(terminal)
+	return DecumulationSummary{
+		Success: float64(successes) / float64(len(scenarios)),
+		Median:  quantileSorted(terminal, 0.5),
+		Low:     quantileSorted(terminal, 0.05),
+		High:    quantileSorted(terminal, 0.95),
+	}, nil
+}
+
+// HistoricalScenarios returns every run of years consecutive returns of a
+// historical series, the rolling retirement periods of the Trinity study.
+func HistoricalScenarios(returns []float64, years int) ([][]float64, error) {
+	if years <= 0 || years > len(returns) {
+		return nil, errors.New("HistoricalScenarios requires between one year and the length of the history")
+	}
+	scenarios := make([][]float64, len(returns)-years+1)
+	for i := range scenarios {
+		scenarios[i] = returns[i : i+years]
+	}
+	return scenarios, nil
+}
+
+// SimulatedScenarios returns paths sequences of years lognormal annual
+// returns with the given expected log return and volatility.
+// The same seed gives the same scenarios.
+// Math details:
+//
+// R_t = e^{ExpectedLog + Volatility * Z_t} - 1
+func SimulatedScenarios(expectedLog, volatility float64, years, paths int, seed uint64) ([][]float64, error) {
+	if years <= 0 || paths <= 0 {
+		return nil, errors.New("SimulatedScenarios requires positive years and paths")
+	}
+	rng := rand.New(rand.NewPCG(seed, seed))
+	scenarios := make([][]float64, paths)
+	for p := range scenarios {
+		scenarios[p] = make([]float64, years)
+		for t := range scenarios[p] {
+			scenarios[p][t] = math.Expm1(expectedLog + volatility*rng.NormFloat64())
+		}
+	}
+	return scenarios, nil
+}
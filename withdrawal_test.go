@@ -0,0 +1,116 @@
+package gofinance
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Withdrawal rules
+// -----------------------------------------------------------------------------
+func TestWithdrawalRules(t *testing.T) {
+	s := WithdrawalState{Year: 3, Balance: 800, Initial: 1000, Previous: 40}
+	tests := []struct {
+		name string
+		rule WithdrawalRule
+		s    WithdrawalState
+		want float64
+	}{
+		{"fixed real", WithdrawFixedReal(0.04), s, 40},
+		{"fixed percent", WithdrawFixedPercent(0.04), s, 32},
+		{"guardrails first year", WithdrawGuardrails(0.04, 0.2, 0.1), WithdrawalState{Balance: 1000, Initial: 1000}, 40},
+		{"guardrails within band", WithdrawGuardrails(0.04, 0.2, 0.1), WithdrawalState{3, 900, 1000, 40}, 40}, // 4.4% is inside 3.2%-4.8%
+		{"guardrails cut", WithdrawGuardrails(0.04, 0.2, 0.1), WithdrawalState{3, 700, 1000, 40}, 36},         // 5.7% > 4.8%
+		{"guardrails raise", WithdrawGuardrails(0.04, 0.2, 0.1), WithdrawalState{3, 1500, 1000, 40}, 44},      // 2.7% < 3.2%
+	}
+	for _, tt := range tests {
+		if got := tt.rule(tt.s); !almostEq(got, tt.want, epsilon) {
+			t.Errorf("%s: got %f, want %f", tt.name, got, tt.want)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Decumulation
+// -----------------------------------------------------------------------------
+func TestDecumulationRun(t *testing.T) {
+	d := Decumulation{Initial: 1000, Rule: WithdrawFixedReal(0.04), Years: 3}
+	path, err := d.Run([]float64{0.10, -0.20, 0.05})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b1 := 960 * 1.1
+	b2 := (b1 - 40) * 0.8
+	b3 := (b2 - 40) * 1.05
+	for i, want := range []float64{b1, b2, b3} {
+		if !almostEq(path.Balances[i], want, epsilon) || path.Withdrawals[i] != 40 {
+			t.Errorf("year %d got balance %f and withdrawal %f, want %f and 40", i, path.Balances[i], path.Withdrawals[i], want)
+		}
+	}
+	if path.Depleted || !almostEq(path.Terminal(), b3, epsilon) {
+		t.Errorf("got Depleted %v and Terminal %f", path.Depleted, path.Terminal())
+	}
+
+	// spending half the initial balance a year runs out in the second year
+	d.Rule = WithdrawFixedReal(0.5)
+	path, _ = d.Run([]float64{0, 0, 0})
+	if !path.Depleted || path.Withdrawals[1] != 500 || path.Withdrawals[2] != 0 || path.Terminal() != 0 {
+		t.Errorf("depleted path got %+v", path)
+	}
+
+	if _, err := d.Run([]float64{0.1}); err == nil {
+		t.Error("Run accepted too few returns")
+	}
+	if _, err := (Decumulation{Initial: 1000, Years: 3}).Run([]float64{0, 0, 0}); err == nil {
+		t.Error("Run accepted a nil Rule")
+	}
+}
+
+func TestDecumulationSimulate(t *testing.T) {
+	history := []float64{0.2, -0.1, 0.05, 0.3, -0.35, 0.1, 0.08, 0.15, -0.05, 0.12}
+	scenarios, err := HistoricalScenarios(history, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scenarios) != 6 || scenarios[5][4] != 0.12 {
+		t.Fatalf("HistoricalScenarios got %v", scenarios)
+	}
+	if _, err := HistoricalScenarios(history, 11); err == nil {
+		t.Error("HistoricalScenarios accepted a period longer than the history")
+	}
+
+	// fixed percent never depletes
+	d := Decumulation{Initial: 1000, Rule: WithdrawFixedPercent(0.3), Years: 5}
+	s, err := d.Simulate(scenarios)
+	if err != nil || s.Success != 1 {
+		t.Errorf("fixed percent Success got %f, %v, want 1", s.Success, err)
+	}
+	// the order of returns matters: bad early years deplete a fixed real plan
+	d.Rule = WithdrawFixedReal(0.24)
+	s, _ = d.Simulate([][]float64{{-0.3, -0.3, 0.3, 0.3, 0.3}, {0.3, 0.3, 0.3, -0.3, -0.3}})
+	if s.Success != 0.5 {
+		t.Errorf("sequence risk Success got %f, want 0.5", s.Success)
+	}
+
+	sims, err := SimulatedScenarios(0.03, 0.15, 30, 5000, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d = Decumulation{Initial: 1000, Rule: WithdrawFixedReal(0.04), Years: 30}
+	safe, _ := d.Simulate(sims)
+	d.Rule = WithdrawFixedReal(0.07)
+	risky, _ := d.Simulate(sims)
+	if !(safe.Success > risky.Success && risky.Success > 0 && safe.Success < 1) {
+		t.Errorf("Success got %f at 4%% and %f at 7%%", safe.Success, risky.Success)
+	}
+	if !(safe.Low <= safe.Median && safe.Median <= safe.High) || safe.Median <= risky.Median {
+		t.Errorf("terminal wealth got %+v at 4%% and %+v at 7%%", safe, risky)
+	}
+	again, _ := SimulatedScenarios(0.03, 0.15, 30, 5000, 7)
+	if again[4999][29] != sims[4999][29] || math.IsNaN(sims[0][0]) {
+		t.Error("SimulatedScenarios is not reproducible")
+	}
+	if _, err := d.Simulate(nil); err == nil {
+		t.Error("Simulate accepted no scenarios")
+	}
+}
@@ -0,0 +1,95 @@
+package gofinance
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WorkingCapital holds the financials of one period ending at Date for
+// working capital analysis: Revenue and cost of goods sold (COGS) over
+// the period, and the Receivables, Inventory and Payables balances at its
+// end, or averaged over it. Days is the length of the period, 365 if zero.
+type WorkingCapital struct {
+	Date        time.Time
+	Days        float64
+	Revenue     float64
+	COGS        float64
+	Receivables float64
+	Inventory   float64
+	Payables    float64
+}
+
+// days returns the length of the period, defaulting to a year.
+func (w WorkingCapital) days() float64 {
+	if w.Days == 0 {
+		return 365
+	}
+	return w.Days
+}
+
+// DSO returns the days sales outstanding, how long customers take to pay.
+// Math details:
+//
+// DSO = Receivables / Revenue * Days
+func (w WorkingCapital) DSO() float64 {
+	return w.Receivables / w.Revenue * w.days()
+}
+
+// DIO returns the days inventory outstanding, how long goods are held
+// before being sold.
+// Math details:
+//
+// DIO = Inventory / COGS * Days
+func (w WorkingCapital) DIO() float64 {
+	return w.Inventory / w.COGS * w.days()
+}
+
+// DPO returns the days payables outstanding, how long the firm takes to
+// pay its suppliers.
+// Math details:
+//
+// DPO = Payables / COGS * Days
+func (w WorkingCapital) DPO() float64 {
+	return w.Payables / w.COGS * w.days()
+}
+
+// CashConversionCycle returns the days between paying suppliers and
+// collecting from customers, negative when suppliers finance operations.
+// Math details:
+//
+// CCC = DSO + DIO - DPO
+func (w WorkingCapital) CashConversionCycle() float64 {
+	return w.DSO() + w.DIO() - w.DPO()
+}
+
+// NetWorkingCapital returns the operating working capital tied up in
+// the business.
+// Math details:
+//
+// NWC = Receivables + Inventory - Payables
+func (w WorkingCapital) NetWorkingCapital() float64 {
+	return w.Receivables + w.Inventory - w.Payables
+}
+
+// WorkingCapitalCashFlows turns consecutive periods into the cash-flows
+// from changes in net working capital, one dated at the end of every
+// period after the first, ready to add to the free cash-flows of a [DCF]:
+// an increase in working capital consumes cash.
+// Math details:
+//
+// CF_t = -(NWC_t - NWC_{t-1})
+func WorkingCapitalCashFlows(periods []WorkingCapital) (CashFlows, error) {
+	if len(periods) < 2 {
+		return nil, fmt.Errorf("WorkingCapitalCashFlows requires at least two periods: %w", ErrInsufficientData)
+	}
+	flows := make(CashFlows, len(periods)-1)
+	for i := 1; i < len(periods); i++ {
+		if !periods[i].Date.After(periods[i-1].Date) {
+			return nil, errors.New("WorkingCapitalCashFlows requires periods in increasing date order")
+		}
+		change := periods[i].NetWorkingCapital() - periods[i-1].NetWorkingCapital()
+		flows[i-1] = CashFlow{-change, periods[i].Date}
+	}
+	return flows, nil
+}
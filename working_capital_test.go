@@ -0,0 +1,66 @@
+package gofinance
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+// Working capital days
+// -----------------------------------------------------------------------------
+func TestWorkingCapitalDays(t *testing.T) {
+	w := WorkingCapital{
+		Revenue:     730000,
+		COGS:        365000,
+		Receivables: 80000,
+		Inventory:   50000,
+		Payables:    30000,
+	}
+	tests := []struct {
+		name      string
+		got, want float64
+	}{
+		{"DSO", w.DSO(), 40},
+		{"DIO", w.DIO(), 50},
+		{"DPO", w.DPO(), 30},
+		{"CCC", w.CashConversionCycle(), 60},
+		{"NWC", w.NetWorkingCapital(), 100000},
+	}
+	for _, tc := range tests {
+		if !almostEq(tc.got, tc.want, 1e-9) {
+			t.Errorf("%s got %v, want %v", tc.name, tc.got, tc.want)
+		}
+	}
+
+	// a quarter: same balances on a quarter of the flows over 90 days
+	q := w
+	q.Days, q.Revenue, q.COGS = 90, 180000, 90000
+	if got := q.DSO(); !almostEq(got, 40, 1e-9) {
+		t.Errorf("quarterly DSO got %v, want 40", got)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// WorkingCapitalCashFlows
+// -----------------------------------------------------------------------------
+func TestWorkingCapitalCashFlows(t *testing.T) {
+	periods := []WorkingCapital{
+		{Date: anchor, Receivables: 100, Inventory: 50, Payables: 40},
+		{Date: anchor.AddDate(1, 0, 0), Receivables: 120, Inventory: 60, Payables: 45},
+		{Date: anchor.AddDate(2, 0, 0), Receivables: 110, Inventory: 55, Payables: 50},
+	}
+	flows, err := WorkingCapitalCashFlows(periods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := CashFlows{{-25, anchor.AddDate(1, 0, 0)}, {20, anchor.AddDate(2, 0, 0)}}
+	for i := range want {
+		if !flows[i].Equal(want[i], epsilon) {
+			t.Errorf("flow %d got %+v, want %+v", i, flows[i], want[i])
+		}
+	}
+
+	if _, err := WorkingCapitalCashFlows(periods[:1]); err == nil {
+		t.Error("single period: expected error")
+	}
+	if _, err := WorkingCapitalCashFlows([]WorkingCapital{periods[1], periods[0]}); err == nil {
+		t.Error("unordered periods: expected error")
+	}
+}
@@ -0,0 +1,57 @@
+package gofinance
+
+import (
+	"testing"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// ExposureProfile
+// -----------------------------------------------------------------------------
+func TestNewExposureProfile(t *testing.T) {
+	dates := []time.Time{anchor.AddDate(1, 0, 0), anchor.AddDate(2, 0, 0)}
+	paths := [][]float64{
+		{10, -20},
+		{-30, 40},
+	}
+	p, err := NewExposureProfile(dates, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantPos, wantNeg := []float64{5, 20}, []float64{15, 10}
+	for i := range dates {
+		if p.Positive[i].Value != wantPos[i] || p.Negative[i].Value != wantNeg[i] || !p.Positive[i].Date.Equal(dates[i]) {
+			t.Errorf("date %d got EPE %+v, ENE %+v", i, p.Positive[i], p.Negative[i])
+		}
+	}
+	if _, err := NewExposureProfile(dates, [][]float64{{1}}); err == nil {
+		t.Error("NewExposureProfile accepted a short path")
+	}
+	if _, err := NewExposureProfile(nil, nil); err == nil {
+		t.Error("NewExposureProfile accepted no dates")
+	}
+}
+
+func TestExposureProfileXVA(t *testing.T) {
+	curve := RateAnnualContinuous{0.03}
+	d1, d2 := anchor.AddDate(1, 0, 0), anchor.AddDate(2, 0, 0)
+	p := ExposureProfile{
+		Positive: TimeSeries{{d2, 200}, {d1, 100}},
+		Negative: TimeSeries{{d1, 50}, {d2, 0}},
+	}
+	t1, t2 := yearsBetween(anchor, d1), yearsBetween(anchor, d2)
+	epe := 100*t1*curve.DiscountFactor(t1) + 200*(t2-t1)*curve.DiscountFactor(t2)
+	ene := 50 * t1 * curve.DiscountFactor(t1)
+
+	if got, want := p.FVA(0.01, 0.005, curve, anchor), -0.01*epe+0.005*ene; !almostEq(got, want, epsilon) {
+		t.Errorf("FVA got %f, want %f", got, want)
+	}
+
+	capital := p.RegulatoryCapital(1.4, 1, 0.08)
+	if !almostEq(capital[0].Value, 200*0.112, epsilon) {
+		t.Errorf("RegulatoryCapital got %+v", capital)
+	}
+	if got, want := KVA(capital, 0.1, curve, anchor), -0.1*0.112*epe; !almostEq(got, want, epsilon) {
+		t.Errorf("KVA got %f, want %f", got, want)
+	}
+}